@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // AccountCreditedDrawdown is the account which is credited in a drawdown
@@ -35,6 +38,9 @@ func NewAccountCreditedDrawdown() *AccountCreditedDrawdown {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (creditDD *AccountCreditedDrawdown) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 15 {
+		return NewTagWrongLengthErr(15, utf8.RuneCountInString(record))
+	}
 	creditDD.tag = record[:6]
 	creditDD.DrawdownCreditAccountNumber = creditDD.parseStringField(record[6:15])
 	return nil
@@ -66,25 +72,29 @@ func (creditDD *AccountCreditedDrawdown) String() string {
 // Validate performs WIRE format rule checks on AccountCreditedDrawdown and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (creditDD *AccountCreditedDrawdown) Validate() error {
-	if err := creditDD.fieldInclusion(); err != nil {
-		return err
-	}
+	return creditDD.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on AccountCreditedDrawdown and returns every error found
+func (creditDD *AccountCreditedDrawdown) validateAll() base.ErrorList {
+	errs := creditDD.fieldInclusion()
 	if creditDD.tag != TagAccountCreditedDrawdown {
-		return fieldError("tag", ErrValidTagForType, creditDD.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, creditDD.tag))
 	}
-	if err := creditDD.isNumeric(creditDD.DrawdownCreditAccountNumber); err != nil {
-		return fieldError("DrawdownCreditAccountNumber", err, creditDD.DrawdownCreditAccountNumber)
+	if err := creditDD.isRoutingNumber(creditDD.DrawdownCreditAccountNumber); err != nil {
+		errs.Add(fieldError("DrawdownCreditAccountNumber", err, creditDD.DrawdownCreditAccountNumber))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (creditDD *AccountCreditedDrawdown) fieldInclusion() error {
+func (creditDD *AccountCreditedDrawdown) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if creditDD.DrawdownCreditAccountNumber == "" {
-		return fieldError("DrawdownCreditAccountNumber", ErrFieldRequired)
+		errs.Add(fieldError("DrawdownCreditAccountNumber", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // DrawdownCreditAccountNumberField gets a string of the DrawdownCreditAccountNumber field
@@ -10,7 +10,7 @@ import (
 // mockAccountCreditedDrawdown creates a AccountCreditedDrawdown
 func mockAccountCreditedDrawdown() *AccountCreditedDrawdown {
 	creditDD := NewAccountCreditedDrawdown()
-	creditDD.DrawdownCreditAccountNumber = "123456789"
+	creditDD.DrawdownCreditAccountNumber = "123456780"
 	return creditDD
 }
 
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // AccountDebitedDrawdown is the account which is debited in a drawdown
@@ -40,6 +43,9 @@ func NewAccountDebitedDrawdown() *AccountDebitedDrawdown {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (debitDD *AccountDebitedDrawdown) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 7 {
+		return NewTagWrongLengthErr(7, utf8.RuneCountInString(record))
+	}
 	debitDD.tag = record[:6]
 	debitDD.IdentificationCode = debitDD.parseStringField(record[6:7])
 
@@ -93,53 +99,57 @@ func (debitDD *AccountDebitedDrawdown) String() string {
 // Validate performs WIRE format rule checks on AccountDebitedDrawdown and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (debitDD *AccountDebitedDrawdown) Validate() error {
-	if err := debitDD.fieldInclusion(); err != nil {
-		return err
-	}
+	return debitDD.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on AccountDebitedDrawdown and returns every error found
+func (debitDD *AccountDebitedDrawdown) validateAll() base.ErrorList {
+	errs := debitDD.fieldInclusion()
 	if debitDD.tag != TagAccountDebitedDrawdown {
-		return fieldError("tag", ErrValidTagForType, debitDD.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, debitDD.tag))
 	}
 	if err := debitDD.isIdentificationCode(debitDD.IdentificationCode); err != nil {
-		return fieldError("IdentificationCode", err, debitDD.IdentificationCode)
+		errs.Add(fieldError("IdentificationCode", err, debitDD.IdentificationCode))
 	}
 	// Can only be these Identification Codes
 	switch debitDD.IdentificationCode {
 	case
 		DemandDepositAccountNumber:
 	default:
-		return fieldError("IdentificationCode", ErrIdentificationCode, debitDD.IdentificationCode)
+		errs.Add(fieldError("IdentificationCode", ErrIdentificationCode, debitDD.IdentificationCode))
 	}
 	if err := debitDD.isAlphanumeric(debitDD.Identifier); err != nil {
-		return fieldError("Identifier", err, debitDD.Identifier)
+		errs.Add(fieldError("Identifier", err, debitDD.Identifier))
 	}
 	if err := debitDD.isAlphanumeric(debitDD.Name); err != nil {
-		return fieldError("Name", err, debitDD.Name)
+		errs.Add(fieldError("Name", err, debitDD.Name))
 	}
 	if err := debitDD.isAlphanumeric(debitDD.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, debitDD.Address.AddressLineOne)
+		errs.Add(fieldError("AddressLineOne", err, debitDD.Address.AddressLineOne))
 	}
 	if err := debitDD.isAlphanumeric(debitDD.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, debitDD.Address.AddressLineTwo)
+		errs.Add(fieldError("AddressLineTwo", err, debitDD.Address.AddressLineTwo))
 	}
 	if err := debitDD.isAlphanumeric(debitDD.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, debitDD.Address.AddressLineThree)
+		errs.Add(fieldError("AddressLineThree", err, debitDD.Address.AddressLineThree))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (debitDD *AccountDebitedDrawdown) fieldInclusion() error {
+func (debitDD *AccountDebitedDrawdown) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if debitDD.IdentificationCode == "" {
-		return fieldError("IdentificationCode", ErrFieldRequired)
+		errs.Add(fieldError("IdentificationCode", ErrFieldRequired))
 	}
 	if debitDD.Identifier == "" {
-		return fieldError("Identifier", ErrFieldRequired)
+		errs.Add(fieldError("Identifier", ErrFieldRequired))
 	}
 	if debitDD.Name == "" {
-		return fieldError("Name", ErrFieldRequired)
+		errs.Add(fieldError("Name", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
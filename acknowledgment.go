@@ -0,0 +1,85 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// AcknowledgmentStatus is the outcome of a message sent to the Fedwire Funds Service
+type AcknowledgmentStatus string
+
+const (
+	// AcknowledgmentAccepted is a message processed successfully, with or without accounting
+	AcknowledgmentAccepted AcknowledgmentStatus = "accepted"
+	// AcknowledgmentRejected is a message rejected due to an error condition
+	AcknowledgmentRejected AcknowledgmentStatus = "rejected"
+	// AcknowledgmentIntercepted is a message in process or intercepted, which may yet be accepted or rejected
+	AcknowledgmentIntercepted AcknowledgmentStatus = "intercepted"
+)
+
+// Acknowledgment interprets the MessageDisposition {1100} and ErrorWire {1130} of a message received from the
+// Fedwire Funds Service in response to a message sent to it
+type Acknowledgment struct {
+	// Status is whether the message was accepted, rejected or intercepted
+	Status AcknowledgmentStatus `json:"status"`
+	// IMAD is the IMAD of the message
+	IMAD string `json:"imad,omitempty"`
+	// MessageStatusIndicator is the MessageStatusIndicator of the MessageDisposition
+	MessageStatusIndicator string `json:"messageStatusIndicator,omitempty"`
+	// Error describes the ErrorWire of the message, when it has one
+	Error *ErrorWireCode `json:"error,omitempty"`
+}
+
+// NewAcknowledgment returns the Acknowledgment of fwm, describing its ErrorWire by NewErrorCatalog
+func NewAcknowledgment(fwm *FEDWireMessage) (Acknowledgment, error) {
+	return NewErrorCatalog().Acknowledgment(fwm)
+}
+
+// Acknowledgment returns the Acknowledgment of fwm, describing its ErrorWire by c. A message with an ErrorWire is
+// rejected unless its ErrorCategory is ErrorCategoryIntercepted, and a message without one has the status of its
+// MessageStatusIndicator.
+func (c *ErrorCatalog) Acknowledgment(fwm *FEDWireMessage) (Acknowledgment, error) {
+	var ack Acknowledgment
+	if fwm.InputMessageAccountabilityData != nil {
+		ack.IMAD = fwm.InputMessageAccountabilityData.IMAD()
+	}
+	if fwm.MessageDisposition != nil {
+		ack.MessageStatusIndicator = fwm.MessageDisposition.MessageStatusIndicator
+	}
+	if fwm.ErrorWire != nil {
+		code := c.Lookup(fwm.ErrorWire)
+		ack.Error = &code
+		ack.Status = AcknowledgmentRejected
+		if code.Category == ErrorCategoryIntercepted {
+			ack.Status = AcknowledgmentIntercepted
+		}
+		return ack, nil
+	}
+	if fwm.MessageDisposition == nil {
+		return Acknowledgment{}, fieldError("MessageDisposition", ErrFieldRequired)
+	}
+	switch ack.MessageStatusIndicator {
+	case MessageStatusSuccessfulValue, MessageStatusSuccessfulNonValue:
+		ack.Status = AcknowledgmentAccepted
+	case MessageStatusRejected:
+		ack.Status = AcknowledgmentRejected
+	case MessageStatusInProcess:
+		ack.Status = AcknowledgmentIntercepted
+	default:
+		return Acknowledgment{}, fieldError("MessageDisposition.MessageStatusIndicator", ErrInvalidProperty,
+			ack.MessageStatusIndicator)
+	}
+	return ack, nil
+}
+
+// Tag returns the tag in error, such as {1520}, when the ErrorWire of the message identifies one
+func (ack Acknowledgment) Tag() string {
+	if ack.Error == nil {
+		return ""
+	}
+	return ack.Error.Tag
+}
+
+// Retryable returns true if a rejected message may be accepted when sent again later without correcting it
+func (ack Acknowledgment) Retryable() bool {
+	return ack.Status == AcknowledgmentRejected && ack.Error != nil && ack.Error.Retryable
+}
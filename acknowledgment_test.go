@@ -0,0 +1,93 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestAcknowledgment_reject interprets the ErrorWire of a rejected message
+func TestAcknowledgment_reject(t *testing.T) {
+	f, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-Reject.txt"))
+	require.NoError(t, err)
+	defer f.Close()
+	file, err := NewReader(f).Read()
+	require.NoError(t, err)
+	require.Len(t, file.FEDWireMessages, 1)
+
+	ack, err := NewAcknowledgment(&file.FEDWireMessages[0])
+	require.NoError(t, err)
+	require.Equal(t, AcknowledgmentRejected, ack.Status)
+	require.Equal(t, "20210902MMQFMC2U000001", ack.IMAD)
+	require.Equal(t, TagInputMessageAccountabilityData, ack.Tag())
+	require.Equal(t, "1", ack.Error.Category)
+	require.Equal(t, "XYZ", ack.Error.Code)
+	require.False(t, ack.Retryable())
+}
+
+func TestAcknowledgment_errorWire(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.MessageDisposition = mockMessageDisposition()
+	fwm.MessageDisposition.MessageStatusIndicator = MessageStatusRejected
+	fwm.ErrorWire = mockErrorWire()
+	fwm.ErrorWire.ErrorCategory = ErrorCategoryAccountability
+	fwm.ErrorWire.ErrorCode = "024"
+	fwm.ErrorWire.ErrorDescription = "INVLD CYCLE DT/MISSING/INVLD"
+
+	ack, err := NewAcknowledgment(&fwm)
+	require.NoError(t, err)
+	require.Equal(t, AcknowledgmentRejected, ack.Status)
+	require.Equal(t, MessageStatusRejected, ack.MessageStatusIndicator)
+	require.Equal(t, TagInputMessageAccountabilityData, ack.Tag())
+	require.NotEmpty(t, ack.Error.Remediation)
+
+	fwm.ErrorWire.ErrorCategory = ErrorCategoryCutoffHour
+	fwm.ErrorWire.ErrorCode = "ABC"
+	fwm.ErrorWire.ErrorDescription = "CUTOFF HOUR"
+	ack, err = NewAcknowledgment(&fwm)
+	require.NoError(t, err)
+	require.Equal(t, AcknowledgmentRejected, ack.Status)
+	require.Equal(t, "CUTOFF HOUR", ack.Error.Description)
+	require.Empty(t, ack.Tag())
+	require.True(t, ack.Retryable())
+
+	fwm.MessageDisposition.MessageStatusIndicator = MessageStatusInProcess
+	fwm.ErrorWire.ErrorCategory = ErrorCategoryIntercepted
+	ack, err = NewAcknowledgment(&fwm)
+	require.NoError(t, err)
+	require.Equal(t, AcknowledgmentIntercepted, ack.Status)
+	require.False(t, ack.Retryable())
+}
+
+func TestAcknowledgment_messageStatusIndicator(t *testing.T) {
+	fwm := mockCustomerTransferData()
+
+	_, err := NewAcknowledgment(&fwm)
+	require.EqualError(t, err, fieldError("MessageDisposition", ErrFieldRequired).Error())
+
+	cases := map[string]AcknowledgmentStatus{
+		MessageStatusInProcess:          AcknowledgmentIntercepted,
+		MessageStatusSuccessfulValue:    AcknowledgmentAccepted,
+		MessageStatusRejected:           AcknowledgmentRejected,
+		MessageStatusSuccessfulNonValue: AcknowledgmentAccepted,
+	}
+	fwm.MessageDisposition = mockMessageDisposition()
+	for indicator, status := range cases {
+		fwm.MessageDisposition.MessageStatusIndicator = indicator
+		ack, err := NewAcknowledgment(&fwm)
+		require.NoError(t, err)
+		require.Equal(t, status, ack.Status, indicator)
+		require.Nil(t, ack.Error)
+		require.Empty(t, ack.Tag())
+	}
+
+	fwm.MessageDisposition.MessageStatusIndicator = "9"
+	_, err = NewAcknowledgment(&fwm)
+	require.EqualError(t, err, fieldError("MessageDisposition.MessageStatusIndicator", ErrInvalidProperty, "9").Error())
+}
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // ActualAmountPaid is the actual amount paid
@@ -35,13 +38,12 @@ func NewActualAmountPaid() *ActualAmountPaid {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (aap *ActualAmountPaid) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 9 {
+		return NewTagWrongLengthErr(9, utf8.RuneCountInString(record))
+	}
 	aap.tag = record[:6]
 	aap.RemittanceAmount.CurrencyCode = aap.parseStringField(record[6:9])
-	if delim := strings.IndexByte(record, '*'); delim > 0 {
-		aap.RemittanceAmount.Amount = aap.parseStringField(record[9:delim])
-	} else {
-		aap.RemittanceAmount.Amount = aap.parseStringField(record[9:])
-	}
+	aap.RemittanceAmount.Amount = aap.parseVariableStringField(record[9:])
 
 	return nil
 }
@@ -74,32 +76,36 @@ func (aap *ActualAmountPaid) String() string {
 // The first error encountered is returned and stops that parsing.
 // Currency Code and Amount are mandatory for each set of remittance data.
 func (aap *ActualAmountPaid) Validate() error {
-	if err := aap.fieldInclusion(); err != nil {
-		return err
-	}
+	return aap.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on ActualAmountPaid and returns every error found
+func (aap *ActualAmountPaid) validateAll() base.ErrorList {
+	errs := aap.fieldInclusion()
 	if aap.tag != TagActualAmountPaid {
-		return fieldError("tag", ErrValidTagForType, aap.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, aap.tag))
 	}
 	if err := aap.isCurrencyCode(aap.RemittanceAmount.CurrencyCode); err != nil {
-		return fieldError("CurrencyCode", err, aap.RemittanceAmount.CurrencyCode)
+		errs.Add(fieldError("CurrencyCode", err, aap.RemittanceAmount.CurrencyCode))
 	}
 	if err := aap.isAmount(aap.RemittanceAmount.Amount); err != nil {
-		return fieldError("Amount", err, aap.RemittanceAmount.Amount)
+		errs.Add(fieldError("Amount", err, aap.RemittanceAmount.Amount))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (aap *ActualAmountPaid) fieldInclusion() error {
+func (aap *ActualAmountPaid) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if aap.RemittanceAmount.Amount == "" {
-		return fieldError("Amount", ErrFieldRequired)
+		errs.Add(fieldError("Amount", ErrFieldRequired))
 	}
 	if aap.RemittanceAmount.CurrencyCode == "" {
-		return fieldError("CurrencyCode", ErrFieldRequired)
+		errs.Add(fieldError("CurrencyCode", ErrFieldRequired))
 
 	}
-	return nil
+	return errs
 }
 
 // CurrencyCodeField gets a string of the CurrencyCode field
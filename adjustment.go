@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // Adjustment is adjustment
@@ -41,6 +44,9 @@ func NewAdjustment() *Adjustment {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (adj *Adjustment) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 15 {
+		return NewTagWrongLengthErr(15, utf8.RuneCountInString(record))
+	}
 	adj.tag = record[:6]
 	adj.AdjustmentReasonCode = adj.parseStringField(record[6:8])
 	adj.CreditDebitIndicator = adj.parseStringField(record[8:12])
@@ -87,43 +93,47 @@ func (adj *Adjustment) String() string {
 // The first error encountered is returned and stops that parsing.
 // Adjustment Reason, Credit Debit Indicator, Currency Code and Amount are mandatory.
 func (adj *Adjustment) Validate() error {
-	if err := adj.fieldInclusion(); err != nil {
-		return err
-	}
+	return adj.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on Adjustment and returns every error found
+func (adj *Adjustment) validateAll() base.ErrorList {
+	errs := adj.fieldInclusion()
 	if adj.tag != TagAdjustment {
-		return fieldError("tag", ErrValidTagForType, adj.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, adj.tag))
 	}
 	if err := adj.isAdjustmentReasonCode(adj.AdjustmentReasonCode); err != nil {
-		return fieldError("AdjustmentReasonCode", err, adj.AdjustmentReasonCode)
+		errs.Add(fieldError("AdjustmentReasonCode", err, adj.AdjustmentReasonCode))
 	}
 	if err := adj.isCreditDebitIndicator(adj.CreditDebitIndicator); err != nil {
-		return fieldError("CreditDebitIndicator", err, adj.CreditDebitIndicator)
+		errs.Add(fieldError("CreditDebitIndicator", err, adj.CreditDebitIndicator))
 	}
 	if err := adj.isCurrencyCode(adj.RemittanceAmount.CurrencyCode); err != nil {
-		return fieldError("CurrencyCode", err, adj.RemittanceAmount.CurrencyCode)
+		errs.Add(fieldError("CurrencyCode", err, adj.RemittanceAmount.CurrencyCode))
 	}
 	if err := adj.isAmount(adj.RemittanceAmount.Amount); err != nil {
-		return fieldError("Amount", err, adj.RemittanceAmount.Amount)
+		errs.Add(fieldError("Amount", err, adj.RemittanceAmount.Amount))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (adj *Adjustment) fieldInclusion() error {
+func (adj *Adjustment) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if adj.AdjustmentReasonCode == "" {
-		return fieldError("AdjustmentReasonCode", ErrFieldRequired)
+		errs.Add(fieldError("AdjustmentReasonCode", ErrFieldRequired))
 	}
 	if adj.CreditDebitIndicator == "" {
-		return fieldError("CreditDebitIndicator", ErrFieldRequired)
+		errs.Add(fieldError("CreditDebitIndicator", ErrFieldRequired))
 	}
 	if adj.RemittanceAmount.Amount == "" {
-		return fieldError("Amount", ErrFieldRequired)
+		errs.Add(fieldError("Amount", ErrFieldRequired))
 	}
 	if adj.RemittanceAmount.CurrencyCode == "" {
-		return fieldError("CurrencyCode", ErrFieldRequired)
+		errs.Add(fieldError("CurrencyCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // AdjustmentReasonCodeField gets a string of the AdjustmentReasonCode field
@@ -6,9 +6,16 @@ package wire
 
 import (
 	"encoding/json"
+	"strconv"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
+// maxAmountCents is the largest Amount, a penny less than $10 billion
+const maxAmountCents = 999999999999
+
 // Amount (up to a penny less than $10 billion) {2000}
 type Amount struct {
 	// tag
@@ -35,6 +42,9 @@ func NewAmount() *Amount {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (a *Amount) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 18 {
+		return NewTagWrongLengthErr(18, utf8.RuneCountInString(record))
+	}
 	a.tag = record[:6]
 	a.Amount = a.parseStringField(record[6:18])
 	return nil
@@ -66,28 +76,54 @@ func (a *Amount) String() string {
 // Validate performs WIRE format rule checks on Amount and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (a *Amount) Validate() error {
-	if err := a.fieldInclusion(); err != nil {
-		return err
-	}
+	return a.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on Amount and returns every error found
+func (a *Amount) validateAll() base.ErrorList {
+	errs := a.fieldInclusion()
 	if a.tag != TagAmount {
-		return fieldError("tag", ErrValidTagForType, a.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, a.tag))
 	}
 	if err := a.isAmountImplied(a.Amount); err != nil {
-		return fieldError("Amount", err, a.Amount)
+		errs.Add(fieldError("Amount", err, a.Amount))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (a *Amount) fieldInclusion() error {
+func (a *Amount) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if a.Amount == "" {
-		return fieldError("Amount", ErrFieldRequired)
+		errs.Add(fieldError("Amount", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // AmountField gets a string of entry addenda batch count zero padded
 func (a *Amount) AmountField() string {
 	return a.numericStringField(a.Amount, 12)
 }
+
+// Money returns the Amount in US Dollars
+func (a *Amount) Money() (Money, error) {
+	s := strings.TrimSpace(a.Amount)
+	if s == "" || !isDigits(s) {
+		return Money{}, ErrNonAmount
+	}
+	cents, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || cents > maxAmountCents {
+		return Money{}, ErrAmountRange
+	}
+	return USD(cents), nil
+}
+
+// SetCents sets the Amount to cents, zero padded to 12 digits with an implied decimal point
+func (a *Amount) SetCents(cents int64) error {
+	if cents < 0 || cents > maxAmountCents {
+		return ErrAmountRange
+	}
+	a.Amount = a.numericStringField(strconv.FormatInt(cents, 10), 12)
+	return nil
+}
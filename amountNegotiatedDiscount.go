@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // AmountNegotiatedDiscount is the amount negotiated discount
@@ -35,13 +38,12 @@ func NewAmountNegotiatedDiscount() *AmountNegotiatedDiscount {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (nd *AmountNegotiatedDiscount) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 9 {
+		return NewTagWrongLengthErr(9, utf8.RuneCountInString(record))
+	}
 	nd.tag = record[:6]
 	nd.RemittanceAmount.CurrencyCode = nd.parseStringField(record[6:9])
-	if delim := strings.IndexByte(record, '*'); delim > 0 {
-		nd.RemittanceAmount.Amount = nd.parseStringField(record[9:delim])
-	} else {
-		nd.RemittanceAmount.Amount = nd.parseStringField(record[9:])
-	}
+	nd.RemittanceAmount.Amount = nd.parseVariableStringField(record[9:])
 	return nil
 }
 
@@ -72,31 +74,35 @@ func (nd *AmountNegotiatedDiscount) String() string {
 // Validate performs WIRE format rule checks on AmountNegotiatedDiscount and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (nd *AmountNegotiatedDiscount) Validate() error {
-	if err := nd.fieldInclusion(); err != nil {
-		return err
-	}
+	return nd.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on AmountNegotiatedDiscount and returns every error found
+func (nd *AmountNegotiatedDiscount) validateAll() base.ErrorList {
+	errs := nd.fieldInclusion()
 	if nd.tag != TagAmountNegotiatedDiscount {
-		return fieldError("tag", ErrValidTagForType, nd.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, nd.tag))
 	}
 	if err := nd.isCurrencyCode(nd.RemittanceAmount.CurrencyCode); err != nil {
-		return fieldError("CurrencyCode", err, nd.RemittanceAmount.CurrencyCode)
+		errs.Add(fieldError("CurrencyCode", err, nd.RemittanceAmount.CurrencyCode))
 	}
 	if err := nd.isAmount(nd.RemittanceAmount.Amount); err != nil {
-		return fieldError("Amount", err, nd.RemittanceAmount.Amount)
+		errs.Add(fieldError("Amount", err, nd.RemittanceAmount.Amount))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (nd *AmountNegotiatedDiscount) fieldInclusion() error {
+func (nd *AmountNegotiatedDiscount) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if nd.RemittanceAmount.Amount == "" {
-		return fieldError("Amount", ErrFieldRequired)
+		errs.Add(fieldError("Amount", ErrFieldRequired))
 	}
 	if nd.RemittanceAmount.CurrencyCode == "" {
-		return fieldError("CurrencyCode", ErrFieldRequired)
+		errs.Add(fieldError("CurrencyCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // CurrencyCodeField gets a string of the CurrencyCode field
@@ -3,6 +3,7 @@ package wire
 import (
 	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/require"
 )
@@ -67,3 +68,41 @@ func TestAmountTagError(t *testing.T) {
 
 	require.EqualError(t, err, fieldError("tag", ErrValidTagForType, a.tag).Error())
 }
+
+// TestParseAmountWrongLength parses a truncated Amount
+func TestParseAmountWrongLength(t *testing.T) {
+	var line = "{2000}00000"
+	r := NewReader(strings.NewReader(line))
+	r.line = line
+
+	err := r.parseAmount()
+
+	require.EqualError(t, err, r.parseError(NewTagWrongLengthErr(18, utf8.RuneCountInString(r.line))).Error())
+
+	// the length is counted in characters, not bytes
+	line = "{2000}ééééé"
+	r.line = line
+	err = r.parseAmount()
+	require.EqualError(t, err, r.parseError(NewTagWrongLengthErr(18, 11)).Error())
+}
+
+// TestAmountMoney validates Amount converts to and from cents
+func TestAmountMoney(t *testing.T) {
+	a := mockAmount()
+
+	m, err := a.Money()
+	require.NoError(t, err)
+	require.Equal(t, USD(1234567), m)
+
+	require.NoError(t, a.SetCents(99))
+	require.Equal(t, "000000000099", a.Amount)
+	require.NoError(t, a.SetCents(maxAmountCents))
+	require.Equal(t, "999999999999", a.Amount)
+
+	require.Equal(t, ErrAmountRange, a.SetCents(-1))
+	require.Equal(t, ErrAmountRange, a.SetCents(maxAmountCents+1))
+
+	a.Amount = "12,34"
+	_, err = a.Money()
+	require.Equal(t, ErrNonAmount, err)
+}
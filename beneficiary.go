@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // Beneficiary is the beneficiary of the wire
@@ -35,6 +38,9 @@ func NewBeneficiary() *Beneficiary {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (ben *Beneficiary) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 7 {
+		return NewTagWrongLengthErr(7, utf8.RuneCountInString(record))
+	}
 	ben.tag = record[:6]
 	ben.Personal.IdentificationCode = ben.parseStringField(record[6:7])
 
@@ -89,44 +95,62 @@ func (ben *Beneficiary) String() string {
 // The first error encountered is returned and stops that parsing.
 // If ID Code is present, Identifier is mandatory and vice versa.
 func (ben *Beneficiary) Validate() error {
-	if err := ben.fieldInclusion(); err != nil {
-		return err
-	}
+	return ben.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on Beneficiary and returns every error found
+func (ben *Beneficiary) validateAll() base.ErrorList {
+	errs := ben.fieldInclusion()
 	if ben.tag != TagBeneficiary {
-		return fieldError("tag", ErrValidTagForType, ben.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ben.tag))
 	}
 	// Can be any Identification Code
 	if err := ben.isIdentificationCode(ben.Personal.IdentificationCode); err != nil {
-		return fieldError("IdentificationCode", err, ben.Personal.IdentificationCode)
+		errs.Add(fieldError("IdentificationCode", err, ben.Personal.IdentificationCode))
 	}
 	if err := ben.isAlphanumeric(ben.Personal.Identifier); err != nil {
-		return fieldError("Identifier", err, ben.Personal.Identifier)
+		errs.Add(fieldError("Identifier", err, ben.Personal.Identifier))
+	}
+	switch ben.Personal.IdentificationCode {
+	case FEDRoutingNumber:
+		if err := ben.isRoutingNumber(ben.Personal.Identifier); err != nil {
+			errs.Add(fieldError("Identifier", err, ben.Personal.Identifier))
+		}
+	case SWIFTBankIdentifierCode:
+		if err := ben.isBIC(ben.Personal.Identifier); err != nil {
+			errs.Add(fieldError("Identifier", err, ben.Personal.Identifier))
+		}
+	case SWIFTBICORBEIANDAccountNumber:
+		if err := ben.isBICAndAccountNumber(ben.Personal.Identifier); err != nil {
+			errs.Add(fieldError("Identifier", err, ben.Personal.Identifier))
+		}
 	}
 	if err := ben.isAlphanumeric(ben.Personal.Name); err != nil {
-		return fieldError("Name", err, ben.Personal.Name)
+		errs.Add(fieldError("Name", err, ben.Personal.Name))
 	}
 	if err := ben.isAlphanumeric(ben.Personal.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, ben.Personal.Address.AddressLineOne)
+		errs.Add(fieldError("AddressLineOne", err, ben.Personal.Address.AddressLineOne))
 	}
 	if err := ben.isAlphanumeric(ben.Personal.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, ben.Personal.Address.AddressLineTwo)
+		errs.Add(fieldError("AddressLineTwo", err, ben.Personal.Address.AddressLineTwo))
 	}
 	if err := ben.isAlphanumeric(ben.Personal.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, ben.Personal.Address.AddressLineThree)
+		errs.Add(fieldError("AddressLineThree", err, ben.Personal.Address.AddressLineThree))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ben *Beneficiary) fieldInclusion() error {
+func (ben *Beneficiary) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if ben.Personal.IdentificationCode != "" && ben.Personal.Identifier == "" {
-		return fieldError("Identifier", ErrFieldRequired)
+		errs.Add(fieldError("Identifier", ErrFieldRequired))
 	}
 	if ben.Personal.IdentificationCode == "" && ben.Personal.Identifier != "" {
-		return fieldError("IdentificationCode", ErrFieldRequired)
+		errs.Add(fieldError("IdentificationCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
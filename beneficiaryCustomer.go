@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // BeneficiaryCustomer is the beneficiary customer
@@ -35,6 +38,9 @@ func NewBeneficiaryCustomer() *BeneficiaryCustomer {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (bc *BeneficiaryCustomer) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	bc.tag = record[:6]
 
 	optionalFields := strings.Split(record[6:], "*")
@@ -90,40 +96,45 @@ func (bc *BeneficiaryCustomer) String() string {
 // Validate performs WIRE format rule checks on BeneficiaryCustomer and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (bc *BeneficiaryCustomer) Validate() error {
-	if err := bc.fieldInclusion(); err != nil {
-		return err
-	}
+	return bc.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on BeneficiaryCustomer and returns every error found
+func (bc *BeneficiaryCustomer) validateAll() base.ErrorList {
+	errs := bc.fieldInclusion()
 	if bc.tag != TagBeneficiaryCustomer {
-		return fieldError("tag", ErrValidTagForType, bc.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, bc.tag))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, bc.CoverPayment.SwiftFieldTag)
+		errs.Add(fieldError("SwiftFieldTag", err, bc.CoverPayment.SwiftFieldTag))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, bc.CoverPayment.SwiftLineOne)
+		errs.Add(fieldError("SwiftLineOne", err, bc.CoverPayment.SwiftLineOne))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, bc.CoverPayment.SwiftLineTwo)
+		errs.Add(fieldError("SwiftLineTwo", err, bc.CoverPayment.SwiftLineTwo))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, bc.CoverPayment.SwiftLineThree)
+		errs.Add(fieldError("SwiftLineThree", err, bc.CoverPayment.SwiftLineThree))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, bc.CoverPayment.SwiftLineFour)
+		errs.Add(fieldError("SwiftLineFour", err, bc.CoverPayment.SwiftLineFour))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineFive); err != nil {
-		return fieldError("SwiftLineFive", err, bc.CoverPayment.SwiftLineFive)
+		errs.Add(fieldError("SwiftLineFive", err, bc.CoverPayment.SwiftLineFive))
 	}
-	return nil
+	errs = append(errs, bc.validateCoverPaymentParty(bc.CoverPayment)...)
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (bc *BeneficiaryCustomer) fieldInclusion() error {
+func (bc *BeneficiaryCustomer) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if bc.CoverPayment.SwiftLineSix != "" {
-		return fieldError("SwiftLineSix", ErrInvalidProperty, bc.CoverPayment.SwiftLineSix)
+		errs.Add(fieldError("SwiftLineSix", ErrInvalidProperty, bc.CoverPayment.SwiftLineSix))
 	}
-	return nil
+	return errs
 }
 
 // SwiftFieldTagField gets a string of the SwiftFieldTag field
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // BeneficiaryFI is the financial institution of the beneficiary
@@ -35,6 +38,9 @@ func NewBeneficiaryFI() *BeneficiaryFI {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (bfi *BeneficiaryFI) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 7 {
+		return NewTagWrongLengthErr(7, utf8.RuneCountInString(record))
+	}
 	bfi.tag = record[:6]
 	bfi.FinancialInstitution.IdentificationCode = bfi.parseStringField(record[6:7])
 
@@ -88,14 +94,17 @@ func (bfi *BeneficiaryFI) String() string {
 // Validate performs WIRE format rule checks on BeneficiaryFI and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (bfi *BeneficiaryFI) Validate() error {
-	if err := bfi.fieldInclusion(); err != nil {
-		return err
-	}
+	return bfi.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on BeneficiaryFI and returns every error found
+func (bfi *BeneficiaryFI) validateAll() base.ErrorList {
+	errs := bfi.fieldInclusion()
 	if bfi.tag != TagBeneficiaryFI {
-		return fieldError("tag", ErrValidTagForType, bfi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, bfi.tag))
 	}
 	if err := bfi.isIdentificationCode(bfi.FinancialInstitution.IdentificationCode); err != nil {
-		return fieldError("IdentificationCode", err, bfi.FinancialInstitution.IdentificationCode)
+		errs.Add(fieldError("IdentificationCode", err, bfi.FinancialInstitution.IdentificationCode))
 	}
 	// Can only be these Identification Codes
 	switch bfi.FinancialInstitution.IdentificationCode {
@@ -107,36 +116,47 @@ func (bfi *BeneficiaryFI) Validate() error {
 		FEDRoutingNumber,
 		CHIPSIdentifier:
 	default:
-		return fieldError("IdentificationCode", ErrIdentificationCode, bfi.FinancialInstitution.IdentificationCode)
+		errs.Add(fieldError("IdentificationCode", ErrIdentificationCode, bfi.FinancialInstitution.IdentificationCode))
 	}
 	if err := bfi.isAlphanumeric(bfi.FinancialInstitution.Identifier); err != nil {
-		return fieldError("Identifier", err, bfi.FinancialInstitution.Identifier)
+		errs.Add(fieldError("Identifier", err, bfi.FinancialInstitution.Identifier))
+	}
+	switch bfi.FinancialInstitution.IdentificationCode {
+	case FEDRoutingNumber:
+		if err := bfi.isRoutingNumber(bfi.FinancialInstitution.Identifier); err != nil {
+			errs.Add(fieldError("Identifier", err, bfi.FinancialInstitution.Identifier))
+		}
+	case SWIFTBankIdentifierCode:
+		if err := bfi.isBIC(bfi.FinancialInstitution.Identifier); err != nil {
+			errs.Add(fieldError("Identifier", err, bfi.FinancialInstitution.Identifier))
+		}
 	}
 	if err := bfi.isAlphanumeric(bfi.FinancialInstitution.Name); err != nil {
-		return fieldError("Name", err, bfi.FinancialInstitution.Name)
+		errs.Add(fieldError("Name", err, bfi.FinancialInstitution.Name))
 	}
 	if err := bfi.isAlphanumeric(bfi.FinancialInstitution.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, bfi.FinancialInstitution.Address.AddressLineOne)
+		errs.Add(fieldError("AddressLineOne", err, bfi.FinancialInstitution.Address.AddressLineOne))
 	}
 	if err := bfi.isAlphanumeric(bfi.FinancialInstitution.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, bfi.FinancialInstitution.Address.AddressLineTwo)
+		errs.Add(fieldError("AddressLineTwo", err, bfi.FinancialInstitution.Address.AddressLineTwo))
 	}
 	if err := bfi.isAlphanumeric(bfi.FinancialInstitution.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, bfi.FinancialInstitution.Address.AddressLineThree)
+		errs.Add(fieldError("AddressLineThree", err, bfi.FinancialInstitution.Address.AddressLineThree))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (bfi *BeneficiaryFI) fieldInclusion() error {
+func (bfi *BeneficiaryFI) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if bfi.FinancialInstitution.IdentificationCode != "" && bfi.FinancialInstitution.Identifier == "" {
-		return fieldError("Identifier", ErrFieldRequired)
+		errs.Add(fieldError("Identifier", ErrFieldRequired))
 	}
 	if bfi.FinancialInstitution.IdentificationCode == "" && bfi.FinancialInstitution.Identifier != "" {
-		return fieldError("IdentificationCode", ErrFieldRequired)
+		errs.Add(fieldError("IdentificationCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
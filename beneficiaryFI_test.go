@@ -56,6 +56,21 @@ func TestBeneficiaryFIIdentifierAlphaNumeric(t *testing.T) {
 	require.EqualError(t, err, fieldError("Identifier", ErrNonAlphanumeric, bfi.FinancialInstitution.Identifier).Error())
 }
 
+// TestBeneficiaryFIIdentifierRoutingNumber validates a BeneficiaryFI FEDRoutingNumber Identifier has a valid check digit
+func TestBeneficiaryFIIdentifierRoutingNumber(t *testing.T) {
+	bfi := mockBeneficiaryFI()
+	bfi.FinancialInstitution.IdentificationCode = FEDRoutingNumber
+	bfi.FinancialInstitution.Identifier = "231380104"
+
+	require.NoError(t, bfi.Validate())
+
+	bfi.FinancialInstitution.Identifier = "123456789"
+
+	err := bfi.Validate()
+
+	require.EqualError(t, err, fieldError("Identifier", ErrRoutingNumberCheckDigit, bfi.FinancialInstitution.Identifier).Error())
+}
+
 // TestBeneficiaryFINameAlphaNumeric validates BeneficiaryFI Name is alphanumeric
 func TestBeneficiaryFINameAlphaNumeric(t *testing.T) {
 	bfi := mockBeneficiaryFI()
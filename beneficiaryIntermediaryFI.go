@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // BeneficiaryIntermediaryFI {4000}
@@ -35,6 +38,9 @@ func NewBeneficiaryIntermediaryFI() *BeneficiaryIntermediaryFI {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (bifi *BeneficiaryIntermediaryFI) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 7 {
+		return NewTagWrongLengthErr(7, utf8.RuneCountInString(record))
+	}
 	bifi.tag = record[:6]
 	bifi.FinancialInstitution.IdentificationCode = bifi.parseStringField(record[6:7])
 
@@ -89,16 +95,20 @@ func (bifi *BeneficiaryIntermediaryFI) String() string {
 // The first error encountered is returned and stops that parsing.
 // If ID Code is present, Identifier is mandatory and vice versa.
 func (bifi *BeneficiaryIntermediaryFI) Validate() error {
+	return bifi.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on BeneficiaryIntermediaryFI and returns every error found
+func (bifi *BeneficiaryIntermediaryFI) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if bifi.tag != TagBeneficiaryIntermediaryFI {
-		return fieldError("tag", ErrValidTagForType, bifi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, bifi.tag))
 	}
 
-	if err := bifi.fieldInclusion(); err != nil {
-		return err
-	}
+	errs = append(errs, bifi.fieldInclusion()...)
 
 	if err := bifi.isIdentificationCode(bifi.FinancialInstitution.IdentificationCode); err != nil {
-		return fieldError("IdentificationCode", err, bifi.FinancialInstitution.IdentificationCode)
+		errs.Add(fieldError("IdentificationCode", err, bifi.FinancialInstitution.IdentificationCode))
 	}
 
 	// Can only be these Identification Codes
@@ -106,37 +116,43 @@ func (bifi *BeneficiaryIntermediaryFI) Validate() error {
 	case
 		"", "B", "C", "D", "F", "U":
 	default:
-		return fieldError("IdentificationCode", ErrIdentificationCode, bifi.FinancialInstitution.IdentificationCode)
+		errs.Add(fieldError("IdentificationCode", ErrIdentificationCode, bifi.FinancialInstitution.IdentificationCode))
 	}
 	if err := bifi.isAlphanumeric(bifi.FinancialInstitution.Identifier); err != nil {
-		return fieldError("Identifier", err, bifi.FinancialInstitution.Identifier)
+		errs.Add(fieldError("Identifier", err, bifi.FinancialInstitution.Identifier))
+	}
+	if bifi.FinancialInstitution.IdentificationCode == FEDRoutingNumber {
+		if err := bifi.isRoutingNumber(bifi.FinancialInstitution.Identifier); err != nil {
+			errs.Add(fieldError("Identifier", err, bifi.FinancialInstitution.Identifier))
+		}
 	}
 
 	if err := bifi.isAlphanumeric(bifi.FinancialInstitution.Name); err != nil {
-		return fieldError("Name", err, bifi.FinancialInstitution.Name)
+		errs.Add(fieldError("Name", err, bifi.FinancialInstitution.Name))
 	}
 	if err := bifi.isAlphanumeric(bifi.FinancialInstitution.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, bifi.FinancialInstitution.Address.AddressLineOne)
+		errs.Add(fieldError("AddressLineOne", err, bifi.FinancialInstitution.Address.AddressLineOne))
 	}
 	if err := bifi.isAlphanumeric(bifi.FinancialInstitution.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, bifi.FinancialInstitution.Address.AddressLineTwo)
+		errs.Add(fieldError("AddressLineTwo", err, bifi.FinancialInstitution.Address.AddressLineTwo))
 	}
 	if err := bifi.isAlphanumeric(bifi.FinancialInstitution.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, bifi.FinancialInstitution.Address.AddressLineThree)
+		errs.Add(fieldError("AddressLineThree", err, bifi.FinancialInstitution.Address.AddressLineThree))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (bifi *BeneficiaryIntermediaryFI) fieldInclusion() error {
+func (bifi *BeneficiaryIntermediaryFI) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if bifi.FinancialInstitution.IdentificationCode != "" && bifi.FinancialInstitution.Identifier == "" {
-		return fieldError("BeneficiaryIntermediaryFI.FinancialInstitution.Identifier", ErrFieldRequired)
+		errs.Add(fieldError("BeneficiaryIntermediaryFI.FinancialInstitution.Identifier", ErrFieldRequired))
 	}
 	if bifi.FinancialInstitution.IdentificationCode == "" && bifi.FinancialInstitution.Identifier != "" {
-		return fieldError("BeneficiaryIntermediaryFI.FinancialInstitution.IdentificationCode", ErrFieldRequired)
+		errs.Add(fieldError("BeneficiaryIntermediaryFI.FinancialInstitution.IdentificationCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // BeneficiaryReference is a reference for the beneficiary
@@ -35,13 +38,12 @@ func NewBeneficiaryReference() *BeneficiaryReference {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (br *BeneficiaryReference) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	br.tag = record[:6]
 
-	if delim := strings.IndexByte(record, '*'); delim > 0 {
-		br.BeneficiaryReference = br.parseStringField(record[6:delim])
-	} else {
-		br.BeneficiaryReference = br.parseStringField(record[6:])
-	}
+	br.BeneficiaryReference = br.parseVariableStringField(record[6:])
 	return nil
 }
 
@@ -71,13 +73,19 @@ func (br *BeneficiaryReference) String() string {
 // Validate performs WIRE format rule checks on BeneficiaryReference and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (br *BeneficiaryReference) Validate() error {
+	return br.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on BeneficiaryReference and returns every error found
+func (br *BeneficiaryReference) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if br.tag != TagBeneficiaryReference {
-		return fieldError("tag", ErrValidTagForType, br.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, br.tag))
 	}
 	if err := br.isAlphanumeric(br.BeneficiaryReference); err != nil {
-		return fieldError("BeneficiaryReference", err, br.BeneficiaryReference)
+		errs.Add(fieldError("BeneficiaryReference", err, br.BeneficiaryReference))
 	}
-	return nil
+	return errs
 }
 
 // BeneficiaryReferenceField gets a string of the BeneficiaryReference field
@@ -53,6 +53,24 @@ func TestBeneficiaryIdentifierAlphaNumeric(t *testing.T) {
 	require.EqualError(t, err, fieldError("Identifier", ErrNonAlphanumeric, ben.Personal.Identifier).Error())
 }
 
+// TestBeneficiaryIdentifierSWIFT validates Beneficiary SWIFT Identifiers are a BIC and account number
+func TestBeneficiaryIdentifierSWIFT(t *testing.T) {
+	ben := mockBeneficiary()
+	ben.Personal.IdentificationCode = SWIFTBICORBEIANDAccountNumber
+	ben.Personal.Identifier = "DEUTDEFF/DE89370400440532013000"
+
+	require.NoError(t, ben.Validate())
+
+	ben.Personal.Identifier = "DEUTDEFF/DE89370400440532013001"
+
+	require.EqualError(t, ben.Validate(), fieldError("Identifier", ErrIBAN, ben.Personal.Identifier).Error())
+
+	ben.Personal.IdentificationCode = SWIFTBankIdentifierCode
+	ben.Personal.Identifier = "DEUTDEF"
+
+	require.EqualError(t, ben.Validate(), fieldError("Identifier", ErrBIC, ben.Personal.Identifier).Error())
+}
+
 // TestBeneficiaryNameAlphaNumeric validates Beneficiary Name is alphanumeric
 func TestBeneficiaryNameAlphaNumeric(t *testing.T) {
 	ben := mockBeneficiary()
@@ -139,3 +157,17 @@ func TestBeneficiaryTagError(t *testing.T) {
 
 	require.EqualError(t, err, fieldError("tag", ErrValidTagForType, ben.tag).Error())
 }
+
+// TestBeneficiaryValidateAll validates every Beneficiary error is collected
+func TestBeneficiaryValidateAll(t *testing.T) {
+	ben := mockBeneficiary()
+	ben.Personal.Name = "®"
+	ben.Personal.Address.AddressLineOne = "®"
+
+	errs := ben.validateAll()
+
+	require.Len(t, errs, 2)
+	require.EqualError(t, errs[0], fieldError("Name", ErrNonAlphanumeric, ben.Personal.Name).Error())
+	require.EqualError(t, errs[1], fieldError("AddressLineOne", ErrNonAlphanumeric, ben.Personal.Address.AddressLineOne).Error())
+	require.EqualError(t, ben.Validate(), errs[0].Error())
+}
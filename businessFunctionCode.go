@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // BusinessFunctionCode {3600}
@@ -37,10 +40,13 @@ func NewBusinessFunctionCode() *BusinessFunctionCode {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (bfc *BusinessFunctionCode) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 9 {
+		return NewTagWrongLengthErr(9, utf8.RuneCountInString(record))
+	}
 	bfc.tag = record[:6]
 	bfc.BusinessFunctionCode = bfc.parseStringField(record[6:9])
-	if delim := strings.IndexByte(record, '*'); delim > 0 {
-		bfc.TransactionTypeCode = bfc.parseStringField(record[9:delim])
+	if delim := strings.IndexByte(record[9:], '*'); delim >= 0 {
+		bfc.TransactionTypeCode = bfc.parseStringField(record[9 : 9+delim])
 	}
 	return nil
 }
@@ -74,30 +80,34 @@ func (bfc *BusinessFunctionCode) String() string {
 // Validate performs WIRE format rule checks on BusinessFunctionCode and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (bfc *BusinessFunctionCode) Validate() error {
-	if err := bfc.fieldInclusion(); err != nil {
-		return err
-	}
+	return bfc.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on BusinessFunctionCode and returns every error found
+func (bfc *BusinessFunctionCode) validateAll() base.ErrorList {
+	errs := bfc.fieldInclusion()
 	if bfc.tag != TagBusinessFunctionCode {
-		return fieldError("tag", ErrValidTagForType, bfc.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, bfc.tag))
 	}
 	if err := bfc.isBusinessFunctionCode(bfc.BusinessFunctionCode); err != nil {
-		return fieldError("BusinessFunctionCode", err, bfc.BusinessFunctionCode)
+		errs.Add(fieldError("BusinessFunctionCode", err, bfc.BusinessFunctionCode))
 	}
 	if err := bfc.isTransactionTypeCode(bfc.TransactionTypeCode); err != nil {
-		return fieldError("TransactionTypeCode", err, bfc.TransactionTypeCode)
+		errs.Add(fieldError("TransactionTypeCode", err, bfc.TransactionTypeCode))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (bfc *BusinessFunctionCode) fieldInclusion() error {
+func (bfc *BusinessFunctionCode) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 
 	// only BusinessFunctionCode is required
 	if bfc.BusinessFunctionCode == "" {
-		return fieldError("BusinessFunctionCode", ErrFieldRequired, bfc.BusinessFunctionCode)
+		errs.Add(fieldError("BusinessFunctionCode", ErrFieldRequired, bfc.BusinessFunctionCode))
 	}
-	return nil
+	return errs
 }
 
 // BusinessFunctionCodeField gets a string of the BusinessFunctionCode field
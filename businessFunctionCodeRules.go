@@ -0,0 +1,376 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/moov-io/base"
+)
+
+// BusinessFunctionCodeRule lists the tags which are mandatory, optional and prohibited in a FEDWireMessage for a
+// BusinessFunctionCode, from section 15 (Business Function Code Reference) of the Format Reference Guide.
+// Every FEDWireMessage tag appears in exactly one of Mandatory, Optional or Prohibited.
+type BusinessFunctionCodeRule struct {
+	// BusinessFunctionCode is the BusinessFunctionCode the rule applies to
+	BusinessFunctionCode string `json:"businessFunctionCode"`
+	// TypeSubTypes are the TypeCode and SubTypeCode pairs permitted, such as 1000
+	TypeSubTypes []string `json:"typeSubTypes"`
+	// TransactionTypeCodes are the BusinessFunctionCode Element 02 values permitted other than blanks
+	TransactionTypeCodes []string `json:"transactionTypeCodes"`
+	// ProhibitedTransactionTypeCodes are the BusinessFunctionCode Element 02 values not permitted. A rule with
+	// ProhibitedTransactionTypeCodes permits any other value, rather than only its TransactionTypeCodes.
+	ProhibitedTransactionTypeCodes []string `json:"prohibitedTransactionTypeCodes,omitempty"`
+	// Mandatory are the tags which must be present
+	Mandatory []string `json:"mandatory"`
+	// MandatoryOneOf are groups of optional tags of which at least one must be present
+	MandatoryOneOf [][]string `json:"mandatoryOneOf,omitempty"`
+	// MandatoryForReversal are the optional tags which must be present when the SubTypeCode is
+	// ReversalTransfer or ReversalPriorDayTransfer
+	MandatoryForReversal []string `json:"mandatoryForReversal,omitempty"`
+	// Optional are the tags which may be present
+	Optional []string `json:"optional"`
+	// Prohibited are the tags which must not be present
+	Prohibited []string `json:"prohibited"`
+	// ProhibitedIdentificationCodes are the Beneficiary and Originator IdentificationCodes not permitted
+	ProhibitedIdentificationCodes []string `json:"prohibitedIdentificationCodes,omitempty"`
+	// LocalInstruments are the rules for the {7xxx} and {8xxx} tags of each LocalInstrumentCode, and of a message
+	// without a LocalInstrument, which are otherwise Optional
+	LocalInstruments []LocalInstrumentRule `json:"localInstruments,omitempty"`
+}
+
+// mandatoryTags are the tags every FEDWireMessage requires, checked by mandatoryFields
+var mandatoryTags = associatedTags{
+	TagSenderSupplied,
+	TagTypeSubType,
+	TagInputMessageAccountabilityData,
+	TagAmount,
+	TagSenderDepositoryInstitution,
+	TagReceiverDepositoryInstitution,
+	TagBusinessFunctionCode,
+}
+
+// sharedProhibitedTags are the tags prohibited for CheckSameDaySettlement, DepositSendersAccount, FEDFundsReturned,
+// FEDFundsSold, DrawdownResponse, BankDrawDownRequest and CustomerCorporateDrawdownRequest
+var sharedProhibitedTags = joinTags(associatedTags{
+	TagLocalInstrument,
+	TagPaymentNotification,
+	TagCharges,
+	TagInstructedAmount,
+	TagExchangeRate,
+	TagOriginatorOptionF,
+	TagServiceMessage,
+}, coverPaymentTags, remittanceTags)
+
+// drawdownTags are the tags of a drawdown request
+var drawdownTags = associatedTags{
+	TagAccountDebitedDrawdown,
+	TagAccountCreditedDrawdown,
+	TagFIDrawdownDebitAccountAdvice,
+}
+
+// businessFunctionCodeRules models the tags permitted for each BusinessFunctionCode. Tags which are neither
+// Mandatory nor Prohibited for a BusinessFunctionCode are Optional with it.
+var businessFunctionCodeRules = []BusinessFunctionCodeRule{
+	newBusinessFunctionCodeRule(BusinessFunctionCodeRule{
+		BusinessFunctionCode: BankTransfer,
+		TypeSubTypes:         btrTypeSubTypes,
+		MandatoryForReversal: []string{TagPreviousMessageIdentifier},
+		Prohibited: joinTags(associatedTags{
+			TagLocalInstrument,
+			TagPaymentNotification,
+			TagCharges,
+			TagInstructedAmount,
+			TagExchangeRate,
+			TagAccountDebitedDrawdown,
+			TagOriginatorOptionF,
+			TagAccountCreditedDrawdown,
+			TagFIDrawdownDebitAccountAdvice,
+			TagServiceMessage,
+		}, coverPaymentTags, remittanceTags),
+		ProhibitedIdentificationCodes: []string{SWIFTBICORBEIANDAccountNumber},
+	}),
+	newBusinessFunctionCodeRule(BusinessFunctionCodeRule{
+		BusinessFunctionCode: CustomerTransfer,
+		TypeSubTypes:         ctrTypeSubTypes,
+		// an optional Transaction Type Code is permitted, other than COV
+		ProhibitedTransactionTypeCodes: []string{"COV"},
+		Mandatory:                      []string{TagBeneficiary, TagOriginator},
+		MandatoryForReversal:           []string{TagPreviousMessageIdentifier},
+		Prohibited: joinTags(associatedTags{
+			TagLocalInstrument,
+			TagPaymentNotification,
+			TagAccountDebitedDrawdown,
+			TagOriginatorOptionF,
+			TagAccountCreditedDrawdown,
+			TagFIDrawdownDebitAccountAdvice,
+			TagServiceMessage,
+		}, coverPaymentTags, remittanceTags),
+	}),
+	newBusinessFunctionCodeRule(BusinessFunctionCodeRule{
+		BusinessFunctionCode: CustomerTransferPlus,
+		TypeSubTypes:         ctpTypeSubTypes,
+		Mandatory:            []string{TagBeneficiary},
+		MandatoryOneOf:       [][]string{{TagOriginator, TagOriginatorOptionF}},
+		MandatoryForReversal: []string{TagPreviousMessageIdentifier},
+		Prohibited:           drawdownTags,
+		LocalInstruments:     localInstrumentRules,
+	}),
+	newBusinessFunctionCodeRule(BusinessFunctionCodeRule{
+		BusinessFunctionCode:          CheckSameDaySettlement,
+		TypeSubTypes:                  cksTypeSubTypes,
+		Prohibited:                    joinTags(sharedProhibitedTags, drawdownTags),
+		ProhibitedIdentificationCodes: []string{SWIFTBICORBEIANDAccountNumber},
+	}),
+	newBusinessFunctionCodeRule(BusinessFunctionCodeRule{
+		BusinessFunctionCode:          DepositSendersAccount,
+		TypeSubTypes:                  depTypeSubTypes,
+		Prohibited:                    joinTags(sharedProhibitedTags, drawdownTags),
+		ProhibitedIdentificationCodes: []string{SWIFTBICORBEIANDAccountNumber},
+	}),
+	newBusinessFunctionCodeRule(BusinessFunctionCodeRule{
+		BusinessFunctionCode:          FEDFundsReturned,
+		TypeSubTypes:                  ffrTypeSubTypes,
+		Prohibited:                    joinTags(sharedProhibitedTags, drawdownTags),
+		ProhibitedIdentificationCodes: []string{SWIFTBICORBEIANDAccountNumber},
+	}),
+	newBusinessFunctionCodeRule(BusinessFunctionCodeRule{
+		BusinessFunctionCode:          FEDFundsSold,
+		TypeSubTypes:                  ffsTypeSubTypes,
+		Prohibited:                    joinTags(sharedProhibitedTags, drawdownTags),
+		ProhibitedIdentificationCodes: []string{SWIFTBICORBEIANDAccountNumber},
+	}),
+	newBusinessFunctionCodeRule(BusinessFunctionCodeRule{
+		BusinessFunctionCode:          DrawdownResponse,
+		TypeSubTypes:                  drwTypeSubTypes,
+		Mandatory:                     []string{TagBeneficiary, TagOriginator},
+		Prohibited:                    sharedProhibitedTags,
+		ProhibitedIdentificationCodes: []string{SWIFTBICORBEIANDAccountNumber},
+	}),
+	newBusinessFunctionCodeRule(BusinessFunctionCodeRule{
+		BusinessFunctionCode:          BankDrawDownRequest,
+		TypeSubTypes:                  drbTypeSubTypes,
+		Mandatory:                     []string{TagAccountDebitedDrawdown, TagAccountCreditedDrawdown},
+		Prohibited:                    sharedProhibitedTags,
+		ProhibitedIdentificationCodes: []string{SWIFTBICORBEIANDAccountNumber},
+	}),
+	newBusinessFunctionCodeRule(BusinessFunctionCodeRule{
+		BusinessFunctionCode:          CustomerCorporateDrawdownRequest,
+		TypeSubTypes:                  drcTypeSubTypes,
+		Mandatory:                     []string{TagBeneficiary, TagAccountDebitedDrawdown, TagAccountCreditedDrawdown},
+		Prohibited:                    sharedProhibitedTags,
+		ProhibitedIdentificationCodes: []string{SWIFTBICORBEIANDAccountNumber},
+	}),
+	newBusinessFunctionCodeRule(BusinessFunctionCodeRule{
+		BusinessFunctionCode: BFCServiceMessage,
+		TypeSubTypes:         svcTypeSubTypes,
+		Prohibited: joinTags(associatedTags{
+			TagLocalInstrument,
+			TagPaymentNotification,
+			TagCharges,
+			TagInstructedAmount,
+			TagExchangeRate,
+			TagOriginatorOptionF,
+		}, coverPaymentTags, remittanceTags),
+		ProhibitedIdentificationCodes: []string{SWIFTBICORBEIANDAccountNumber},
+	}),
+}
+
+// BusinessFunctionCodeRules returns the rules for each BusinessFunctionCode, such as for showing which tags apply
+// to a message. The rules marshal to JSON.
+func BusinessFunctionCodeRules() []BusinessFunctionCodeRule {
+	rules := make([]BusinessFunctionCodeRule, len(businessFunctionCodeRules))
+	copy(rules, businessFunctionCodeRules)
+	return rules
+}
+
+// newBusinessFunctionCodeRule returns rule with the tags every message requires added to Mandatory and each tag
+// it does not list added to Optional
+func newBusinessFunctionCodeRule(rule BusinessFunctionCodeRule) BusinessFunctionCodeRule {
+	rule.Mandatory = joinTags(mandatoryTags, rule.Mandatory)
+	if rule.TransactionTypeCodes == nil {
+		rule.TransactionTypeCodes = []string{}
+	}
+	listed := joinTags(rule.Mandatory, rule.Prohibited)
+	for _, tag := range fedWireMessageTagList() {
+		if !listed.Contains(tag) {
+			rule.Optional = append(rule.Optional, tag)
+		}
+	}
+	return rule
+}
+
+// businessFunctionCodeRule returns the BusinessFunctionCodeRule for code
+func businessFunctionCodeRule(code string) (BusinessFunctionCodeRule, bool) {
+	for _, rule := range businessFunctionCodeRules {
+		if rule.BusinessFunctionCode == code {
+			return rule, true
+		}
+	}
+	return BusinessFunctionCodeRule{}, false
+}
+
+// businessFunctionCodeRule returns the BusinessFunctionCodeRule for the BusinessFunctionCode of fwm
+func (fwm *FEDWireMessage) businessFunctionCodeRule() (BusinessFunctionCodeRule, bool) {
+	if fwm.BusinessFunctionCode == nil {
+		return BusinessFunctionCodeRule{}, false
+	}
+	return businessFunctionCodeRule(fwm.BusinessFunctionCode.BusinessFunctionCode)
+}
+
+// checkBusinessFunctionCodeRule checks the TypeSubType and tags of fwm against the rule for its BusinessFunctionCode
+func (fwm *FEDWireMessage) checkBusinessFunctionCodeRule() error {
+	rule, ok := fwm.businessFunctionCodeRule()
+	if !ok {
+		// an invalid BusinessFunctionCode is reported by BusinessFunctionCode.Validate
+		return nil
+	}
+	var errs base.ErrorList
+	if fwm.TypeSubType != nil {
+		typeSubType := fwm.TypeSubType.TypeCode + fwm.TypeSubType.SubTypeCode
+		if !associatedTypeSubTypes(rule.TypeSubTypes).Contains(typeSubType) {
+			errs.Add(fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
+				rule.BusinessFunctionCode)))
+		}
+	}
+	addErrors(&errs, fwm.checkMandatoryTags())
+	addErrors(&errs, fwm.checkProhibitedTags())
+	if rule.LocalInstruments != nil {
+		addErrors(&errs, fwm.checkMandatoryLocalInstrumentTags())
+		addErrors(&errs, fwm.checkLocalInstrumentTags())
+	}
+	return errorList(errs)
+}
+
+// checkMandatoryTags checks for the tags required by the BusinessFunctionCode of fwm in addition to the standard
+// mandatoryFields
+func (fwm *FEDWireMessage) checkMandatoryTags() error {
+	rule, ok := fwm.businessFunctionCodeRule()
+	if !ok {
+		return nil
+	}
+	var errs base.ErrorList
+	for _, tag := range rule.Mandatory {
+		if !mandatoryTags.Contains(tag) && !fwm.hasTag(tag) {
+			errs.Add(fieldError(fedWireMessageFieldName(tag), ErrFieldRequired))
+		}
+	}
+	for _, tags := range rule.MandatoryOneOf {
+		if !fwm.hasAnyTag(tags) {
+			names := make([]string, len(tags))
+			for i, tag := range tags {
+				names[i] = fedWireMessageFieldName(tag)
+			}
+			errs.Add(fieldError(strings.Join(names, " OR "), ErrFieldRequired))
+		}
+	}
+	if fwm.TypeSubType != nil {
+		switch fwm.TypeSubType.SubTypeCode {
+		case ReversalTransfer, ReversalPriorDayTransfer:
+			for _, tag := range rule.MandatoryForReversal {
+				if !fwm.hasTag(tag) {
+					errs.Add(fieldError(fedWireMessageFieldName(tag), ErrFieldRequired))
+				}
+			}
+		}
+	}
+	return errorList(errs)
+}
+
+// checkProhibitedTags ensures there are no tags present in the message that are incompatible with its
+// BusinessFunctionCode, nor a BusinessFunctionCode Element 02 or Beneficiary or Originator IdentificationCode
+// which is not permitted with it.
+func (fwm *FEDWireMessage) checkProhibitedTags() error {
+	rule, ok := fwm.businessFunctionCodeRule()
+	if !ok {
+		return nil
+	}
+	var errs base.ErrorList
+	if code := strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode); code != "" && !rule.permitsTransactionTypeCode(code) {
+		errs.Add(fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode))
+	}
+	identificationCodes := associatedTags(rule.ProhibitedIdentificationCodes)
+	if fwm.Beneficiary != nil && identificationCodes.Contains(fwm.Beneficiary.Personal.IdentificationCode) {
+		errs.Add(fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty, fwm.Beneficiary.Personal.IdentificationCode))
+	}
+	if fwm.Originator != nil && identificationCodes.Contains(fwm.Originator.Personal.IdentificationCode) {
+		errs.Add(fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty, fwm.Originator.Personal.IdentificationCode))
+	}
+	for _, tag := range rule.Prohibited {
+		if v := fwm.tagValue(tag); !v.IsNil() {
+			errs.Add(fieldError(fedWireMessageFieldName(tag), ErrInvalidProperty, v.Interface()))
+		}
+	}
+	return errorList(errs)
+}
+
+// permitsTransactionTypeCode returns true if code is a BusinessFunctionCode Element 02 permitted by rule
+func (rule BusinessFunctionCodeRule) permitsTransactionTypeCode(code string) bool {
+	if rule.ProhibitedTransactionTypeCodes != nil {
+		return !associatedTags(rule.ProhibitedTransactionTypeCodes).Contains(code)
+	}
+	return associatedTags(rule.TransactionTypeCodes).Contains(code)
+}
+
+// joinTags returns the tags of each list, in order
+func joinTags(lists ...[]string) associatedTags {
+	var tags associatedTags
+	for _, list := range lists {
+		tags = append(tags, list...)
+	}
+	return tags
+}
+
+// fedWireMessageTagList returns the tag of each FEDWireMessage field in tag order
+func fedWireMessageTagList() []string {
+	tags := make([]string, 0, len(fedWireMessageTags))
+	for _, tag := range fedWireMessageTags {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// fedWireMessageFieldName returns the name of the FEDWireMessage field holding tag
+func fedWireMessageFieldName(tag string) string {
+	for name, t := range fedWireMessageTags {
+		if t == tag {
+			return name
+		}
+	}
+	return tag
+}
+
+// tagValue returns the FEDWireMessage field holding tag
+func (fwm *FEDWireMessage) tagValue(tag string) reflect.Value {
+	return reflect.ValueOf(fwm).Elem().FieldByName(fedWireMessageFieldName(tag))
+}
+
+// hasTag returns true if tag is present in fwm
+func (fwm *FEDWireMessage) hasTag(tag string) bool {
+	return !fwm.tagValue(tag).IsNil()
+}
+
+// requireTags returns an error for the first of tags which is not present in fwm
+func (fwm *FEDWireMessage) requireTags(tags ...string) error {
+	for _, tag := range tags {
+		if !fwm.hasTag(tag) {
+			return fieldError(fedWireMessageFieldName(tag), ErrFieldRequired)
+		}
+	}
+	return nil
+}
+
+// hasAnyTag returns true if any of tags is present in fwm
+func (fwm *FEDWireMessage) hasAnyTag(tags []string) bool {
+	for _, tag := range tags {
+		if fwm.hasTag(tag) {
+			return true
+		}
+	}
+	return false
+}
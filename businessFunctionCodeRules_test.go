@@ -0,0 +1,132 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBusinessFunctionCodeRules validates each rule lists every FEDWireMessage tag exactly once
+func TestBusinessFunctionCodeRules(t *testing.T) {
+	rules := BusinessFunctionCodeRules()
+	require.Len(t, rules, 11)
+	for _, rule := range rules {
+		require.NotEmpty(t, rule.TypeSubTypes, rule.BusinessFunctionCode)
+		listed := joinTags(rule.Mandatory, rule.Optional, rule.Prohibited)
+		for _, tag := range fedWireMessageTagList() {
+			count := 0
+			for _, l := range listed {
+				if l == tag {
+					count++
+				}
+			}
+			require.Equal(t, 1, count, "%s: %s", rule.BusinessFunctionCode, tag)
+		}
+		require.Len(t, listed, len(fedWireMessageTags), rule.BusinessFunctionCode)
+		for _, tags := range rule.MandatoryOneOf {
+			for _, tag := range tags {
+				require.Contains(t, rule.Optional, tag, rule.BusinessFunctionCode)
+			}
+		}
+		for _, tag := range rule.MandatoryForReversal {
+			require.Contains(t, rule.Optional, tag, rule.BusinessFunctionCode)
+		}
+	}
+}
+
+// TestBusinessFunctionCodeRulesCopy validates BusinessFunctionCodeRules cannot alter the rules used for validation
+func TestBusinessFunctionCodeRulesCopy(t *testing.T) {
+	rules := BusinessFunctionCodeRules()
+	rules[0] = BusinessFunctionCodeRule{}
+
+	rule, ok := businessFunctionCodeRule(BankTransfer)
+	require.True(t, ok)
+	require.Equal(t, BankTransfer, rule.BusinessFunctionCode)
+}
+
+// TestBusinessFunctionCodeRulesJSON marshals the rules
+func TestBusinessFunctionCodeRulesJSON(t *testing.T) {
+	bs, err := json.Marshal(BusinessFunctionCodeRules())
+	require.NoError(t, err)
+
+	var rules []BusinessFunctionCodeRule
+	require.NoError(t, json.Unmarshal(bs, &rules))
+	require.Equal(t, BusinessFunctionCodeRules(), rules)
+	require.Contains(t, string(bs), `"businessFunctionCode":"CTP"`)
+	require.Contains(t, string(bs), `"localInstrumentCode":"COVS"`)
+}
+
+// TestCustomerTransferProhibitedTags validates the CustomerTransfer rule rejects the tags it prohibits
+func TestCustomerTransferProhibitedTags(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.TransactionTypeCode = ""
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	require.NoError(t, fwm.checkBusinessFunctionCodeRule())
+
+	fwm.RemittanceFreeText = mockRemittanceFreeText()
+
+	err := fwm.checkBusinessFunctionCodeRule()
+
+	require.EqualError(t, err, fieldError("RemittanceFreeText", ErrInvalidProperty, fwm.RemittanceFreeText).Error())
+}
+
+// TestCustomerTransferPlusWithoutLocalInstrument validates a CustomerTransferPlus without a LocalInstrument does not
+// permit the {8xxx} tags
+func TestCustomerTransferPlusWithoutLocalInstrument(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	fwm.BusinessFunctionCode.TransactionTypeCode = ""
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	require.NoError(t, fwm.verify())
+
+	fwm.UnstructuredAddenda = mockUnstructuredAddenda()
+	fwm.RemittanceOriginator = mockRemittanceOriginator()
+
+	err := fwm.verify()
+
+	require.EqualError(t, err, fieldError("UnstructuredAddenda", ErrNotPermitted, TagUnstructuredAddenda).Error())
+	errs, ok := fwm.checkLocalInstrumentTags().(base.ErrorList)
+	require.True(t, ok)
+	require.Len(t, errs, 2)
+}
+
+// TestCustomerTransferTransactionTypeCode validates the CustomerTransfer rule permits an optional Transaction Type
+// Code other than COV, while CustomerTransferPlus permits none
+func TestCustomerTransferTransactionTypeCode(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.BusinessFunctionCode.TransactionTypeCode = "ABC"
+	require.NoError(t, fwm.checkProhibitedTags())
+
+	fwm.BusinessFunctionCode.TransactionTypeCode = "COV"
+
+	err := fwm.checkProhibitedTags()
+
+	require.EqualError(t, err, fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, "COV").Error())
+
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	fwm.BusinessFunctionCode.TransactionTypeCode = "ABC"
+
+	err = fwm.checkProhibitedTags()
+
+	require.EqualError(t, err, fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, "ABC").Error())
+}
+
+// TestCheckBusinessFunctionCodeRuleMissing validates a message without a known BusinessFunctionCode is not checked
+func TestCheckBusinessFunctionCodeRuleMissing(t *testing.T) {
+	fwm := new(FEDWireMessage)
+	require.NoError(t, fwm.checkBusinessFunctionCodeRule())
+
+	fwm.BusinessFunctionCode = mockBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = "ZZZ"
+	require.NoError(t, fwm.checkBusinessFunctionCodeRule())
+}
@@ -0,0 +1,203 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// camt056Format names the camt.056 message in ConversionWarnings
+const camt056Format = "camt.056"
+
+// camt056Tags are the tags of a FEDWireMessage which ToCamt056 translates
+var camt056Tags = []string{
+	TagSenderSupplied, TagTypeSubType, TagInputMessageAccountabilityData, TagAmount, TagSenderDepositoryInstitution,
+	TagReceiverDepositoryInstitution, TagBusinessFunctionCode, TagSenderReference, TagPreviousMessageIdentifier,
+	TagLocalInstrument, TagBeneficiaryFI, TagBeneficiary, TagBeneficiaryReference, TagOriginator, TagOriginatorOptionF,
+	TagOriginatorFI, TagServiceMessage,
+}
+
+// camt056AdditionalInfoWidths are the widths of the AddtlInf of a camt.056 CancellationReason, at most two
+// Max105Text
+var camt056AdditionalInfoWidths = []int{105, 105}
+
+// Camt056Document is a Fedwire Funds Service FIToFIPaymentCancellationRequest, camt.056.001.08, the request for
+// reversal of a transfer
+type Camt056Document struct {
+	XMLName             xml.Name       `xml:"urn:iso:std:iso:20022:tech:xsd:camt.056.001.08 Document"`
+	CancellationRequest Camt056Message `xml:"FIToFIPmtCxlReq"`
+}
+
+// Camt056Message is a FIToFIPaymentCancellationRequestV08 of the single transaction of a FEDWireMessage
+type Camt056Message struct {
+	Assignment ISOCaseAssignment    `xml:"Assgnmt"`
+	Underlying Camt056UnderlyingTxs `xml:"Undrlyg"`
+}
+
+// ISOCaseAssignment is a CaseAssignment5, whose assigner and assignee are the sender and receiver of the message
+type ISOCaseAssignment struct {
+	ID               string              `xml:"Id"`
+	Assigner         ISOTransactionParty `xml:"Assgnr"`
+	Assignee         ISOTransactionParty `xml:"Assgne"`
+	CreationDateTime string              `xml:"CreDtTm"`
+}
+
+// Camt056UnderlyingTxs is an UnderlyingTransaction23 of a single transaction
+type Camt056UnderlyingTxs struct {
+	TransactionInfo Camt056Transaction `xml:"TxInf"`
+}
+
+// Camt056Transaction is a PaymentTransaction106. Its OriginalTransactionReference holds the parties of the
+// original transaction.
+type Camt056Transaction struct {
+	CancellationID                    string                           `xml:"CxlId,omitempty"`
+	OriginalGroupInfo                 ISOOriginalGroupInformation      `xml:"OrgnlGrpInf"`
+	OriginalEndToEndID                string                           `xml:"OrgnlEndToEndId,omitempty"`
+	OriginalInterbankSettlementAmount *ISOAmount                       `xml:"OrgnlIntrBkSttlmAmt,omitempty"`
+	OriginalInterbankSettlementDate   string                           `xml:"OrgnlIntrBkSttlmDt,omitempty"`
+	CancellationReason                []ISOReasonInformation           `xml:"CxlRsnInf,omitempty"`
+	OriginalTransactionReference      *ISOOriginalTransactionReference `xml:"OrgnlTxRef,omitempty"`
+}
+
+// Bytes returns the XML of doc
+func (doc *Camt056Document) Bytes() ([]byte, error) {
+	return marshalISODocument(doc)
+}
+
+// ToCamt056 converts fwm, a request for reversal with SubTypeCode RequestReversal or
+// RequestReversalPriorDayTransfer, to a camt.056 message. The IMAD of fwm is the identification of the assignment,
+// whose assigner and assignee are the sender and receiver depository institutions. The PreviousMessageIdentifier is
+// the MessageIdentification of the original message, the Amount is its interbank settlement amount, and the
+// ServiceMessage is the reason for the cancellation, with a reason code when one is recognised in its text. Its
+// lines are joined into the two 105 character lines of additional information a camt.056 reason permits.
+//
+// Tags without a corresponding element, and values truncated to fit one, are returned as warnings.
+func (fwm *FEDWireMessage) ToCamt056(opts ISOOpts) (*Camt056Document, []ConversionWarning, error) {
+	if fwm.TypeSubType != nil {
+		switch code := fwm.TypeSubType.SubTypeCode; code {
+		case RequestReversal, RequestReversalPriorDayTransfer:
+		default:
+			return nil, nil, fieldError("TypeSubType.SubTypeCode", ErrISOMessageType, code)
+		}
+	}
+	if err := fwm.mandatoryFields(); err != nil {
+		return nil, nil, err
+	}
+	original, originalDate, err := fwm.isoOriginalGroupInformation()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ws conversionWarnings
+	created, err := opts.creationTime()
+	if err != nil {
+		return nil, nil, err
+	}
+	assignment := ISOCaseAssignment{
+		ID: fwm.InputMessageAccountabilityData.IMAD(),
+		Assigner: ISOTransactionParty{Agent: isoABAAgent(fwm.SenderDepositoryInstitution.SenderABANumber,
+			fwm.SenderDepositoryInstitution.SenderShortName)},
+		Assignee: ISOTransactionParty{Agent: isoABAAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber,
+			fwm.ReceiverDepositoryInstitution.ReceiverShortName)},
+		CreationDateTime: created,
+	}
+	tx := Camt056Transaction{
+		OriginalGroupInfo:               original,
+		OriginalEndToEndID:              isoNotProvided,
+		OriginalInterbankSettlementDate: originalDate,
+		CancellationReason:              fwm.isoCancellationReason(&ws),
+	}
+	if fwm.SenderReference != nil {
+		tx.CancellationID = strings.TrimSpace(fwm.SenderReference.SenderReference)
+	}
+	if fwm.BeneficiaryReference != nil && strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference) != "" {
+		tx.OriginalEndToEndID = strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference)
+	}
+	if code := fwm.TypeSubType.TypeCode; code != isoTypeCode(fwm.BusinessFunctionCode.BusinessFunctionCode) {
+		ws.add(TagTypeSubType, "TypeSubType.TypeCode", "%s is not translated to %s", code, camt056Format)
+	}
+	amt, err := fwm.Amount.Money()
+	if err != nil {
+		return nil, nil, fieldError("Amount.Amount", err, fwm.Amount.Amount)
+	}
+	settlementAmount, err := newISOAmount(amt)
+	if err != nil {
+		return nil, nil, fieldError("Amount.Amount", err, fwm.Amount.Amount)
+	}
+	tx.OriginalInterbankSettlementAmount = &settlementAmount
+	tx.OriginalTransactionReference = &ISOOriginalTransactionReference{
+		PaymentType:           fwm.isoPaymentType(),
+		ISOTransactionParties: fwm.isoTransactionParties(assignment.Assigner.Agent, &ws),
+	}
+
+	ws.untranslated(fwm, camt056Tags, camt056Format)
+	fwm.isoSenderSuppliedWarnings(&ws)
+
+	doc := &Camt056Document{CancellationRequest: Camt056Message{
+		Assignment: assignment,
+		Underlying: Camt056UnderlyingTxs{TransactionInfo: tx},
+	}}
+	return doc, ws, nil
+}
+
+// isoCancellationReason returns the ServiceMessage of fwm as the reason of a cancellation, its lines joined and
+// broken into camt056AdditionalInfoWidths
+func (fwm *FEDWireMessage) isoCancellationReason(ws *conversionWarnings) []ISOReasonInformation {
+	infos := fwm.isoServiceMessageReason(isoCancellationReasons)
+	for i := range infos {
+		infos[i].AdditionalInfo = fedwireTextLines(TagServiceMessage, "ServiceMessage",
+			[]string{isoJoinLines(infos[i].AdditionalInfo...)}, camt056AdditionalInfoWidths, ws)
+	}
+	return infos
+}
+
+// FEDWireMessage converts doc to a request for reversal of the original message it cancels, with SubTypeCode
+// RequestReversalPriorDayTransfer when the original was sent on an earlier cycle date and otherwise
+// RequestReversal. The IMAD is the identification of the assignment, whose assigner and assignee are the sender and
+// receiver depository institutions. The BusinessFunctionCode is the CategoryPurpose of the original transaction
+// when it permits a request for reversal, and otherwise BFCServiceMessage. The reasons for the cancellation are its
+// ServiceMessage.
+//
+// Elements without a corresponding tag, values truncated to fit one, and tags removed as not permitted with the
+// BusinessFunctionCode of the message are returned as warnings.
+func (doc *Camt056Document) FEDWireMessage(opts ISOOpts) (FEDWireMessage, []ConversionWarning, error) {
+	var ws conversionWarnings
+	assignment := doc.CancellationRequest.Assignment
+	tx := doc.CancellationRequest.Underlying.TransactionInfo
+	fwm := FEDWireMessage{}
+	if err := fwm.setISOMessageID("Assgnmt.Id", assignment.ID, opts); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	if tx.OriginalInterbankSettlementAmount == nil {
+		return FEDWireMessage{}, nil, fieldError("TxInf.OrgnlIntrBkSttlmAmt", ErrFieldRequired)
+	}
+	if err := fwm.setISOAmount("TxInf.OrgnlIntrBkSttlmAmt", *tx.OriginalInterbankSettlementAmount); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	if err := fwm.setISODepositoryInstitutions("Assgnmt.Assgnr.Agt", assignment.Assigner.Agent, "Assgnmt.Assgne.Agt",
+		assignment.Assignee.Agent, &ws); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	fwm.setISOReferences("TxInf.CxlId", tx.CancellationID, "TxInf.OrgnlEndToEndId", tx.OriginalEndToEndID, &ws)
+
+	var purpose *ISOCodeOrProprietary
+	var parties ISOTransactionParties
+	if ref := tx.OriginalTransactionReference; ref != nil {
+		if ref.PaymentType != nil {
+			purpose = ref.PaymentType.CategoryPurpose
+			fwm.LocalInstrument = fedwireLocalInstrument(ref.PaymentType.LocalInstrument, &ws)
+		}
+		parties = ref.ISOTransactionParties
+	}
+	if err := fwm.setISOReversal("TxInf.OrgnlGrpInf", tx.OriginalGroupInfo, purpose, RequestReversal,
+		RequestReversalPriorDayTransfer, BFCServiceMessage); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	fwm.setISOTransactionParties("TxInf.OrgnlTxRef", parties, assignment.Assigner.Agent, assignment.Assignee.Agent, &ws)
+	fwm.ServiceMessage = fedwireServiceMessage("TxInf.CxlRsnInf", tx.CancellationReason, isoCancellationReasons, &ws)
+	fwm.removeISOUnpermittedTags(&ws)
+	return fwm, ws, nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockCamt056RequestForReversal creates a request for reversal of a CustomerTransfer, with a duplicate payment as
+// its reason
+func mockCamt056RequestForReversal(t *testing.T) (FEDWireMessage, FEDWireMessage) {
+	original := mockPacs008CustomerTransfer()
+	opts := ReversalOpts{InputMessageAccountabilityData: mockInputMessageAccountabilityData(), SenderReference: "Request"}
+	opts.InputMessageAccountabilityData.InputCycleDate = "20201005"
+	opts.InputMessageAccountabilityData.InputSequenceNumber = "000002"
+	fwm, err := NewRequestForReversalOf(original, opts)
+	require.NoError(t, err)
+	fwm.ServiceMessage = NewServiceMessage()
+	fwm.ServiceMessage.LineOne = "Please reverse, sent twice as a"
+	fwm.ServiceMessage.LineTwo = "duplicate payment"
+	require.NoError(t, fwm.verify())
+	return original, fwm
+}
+
+func TestToCamt056(t *testing.T) {
+	original, fwm := mockCamt056RequestForReversal(t)
+	created := time.Date(2020, time.October, 5, 14, 30, 0, 0, time.UTC)
+	doc, _, err := fwm.ToCamt056(ISOOpts{CreationTime: created})
+	require.NoError(t, err)
+
+	assignment := doc.CancellationRequest.Assignment
+	require.Equal(t, fwm.InputMessageAccountabilityData.IMAD(), assignment.ID)
+	require.Equal(t, "121042882", assignment.Assigner.Agent.ABA())
+	require.Equal(t, "231380104", assignment.Assignee.Agent.ABA())
+	require.Equal(t, "2020-10-05T10:30:00-04:00", assignment.CreationDateTime)
+
+	tx := doc.CancellationRequest.Underlying.TransactionInfo
+	require.Equal(t, "Request", tx.CancellationID)
+	require.Equal(t, original.InputMessageAccountabilityData.IMAD(), tx.OriginalGroupInfo.OriginalMessageID)
+	require.Equal(t, pacs008MessageNameID, tx.OriginalGroupInfo.OriginalMessageNameID)
+	require.Equal(t, "2020-10-05", tx.OriginalInterbankSettlementDate)
+	require.Equal(t, &ISOAmount{Currency: "USD", Value: "12345.67"}, tx.OriginalInterbankSettlementAmount)
+	require.Equal(t, []ISOReasonInformation{{
+		Reason:         &ISOCodeOrProprietary{Code: "DUPL"},
+		AdditionalInfo: []string{"Please reverse, sent twice as a duplicate payment"},
+	}}, tx.CancellationReason)
+
+	ref := tx.OriginalTransactionReference
+	require.Equal(t, BFCServiceMessage, ref.PaymentType.CategoryPurpose.Proprietary)
+	require.Equal(t, "Name", ref.Debtor.Party.Name)
+	require.Equal(t, "CITIUS33", ref.DebtorAgent.FinancialInstitution.BICFI)
+	require.Equal(t, "231380104", ref.CreditorAgent.ABA())
+	require.Equal(t, "987654321", ref.CreditorAccount.Number())
+}
+
+func TestToCamt056_cancellationReasonLength(t *testing.T) {
+	_, fwm := mockCamt056RequestForReversal(t)
+	sm := fwm.ServiceMessage
+	for _, line := range []*string{&sm.LineOne, &sm.LineTwo, &sm.LineThree, &sm.LineFour, &sm.LineFive, &sm.LineSix,
+		&sm.LineSeven, &sm.LineEight, &sm.LineNine, &sm.LineTen, &sm.LineEleven, &sm.LineTwelve} {
+		*line = "Duplicate payment of the original"
+	}
+	doc, warnings, err := fwm.ToCamt056(ISOOpts{})
+	require.NoError(t, err)
+
+	reasons := doc.CancellationRequest.Underlying.TransactionInfo.CancellationReason
+	require.Len(t, reasons, 1)
+	require.Equal(t, &ISOCodeOrProprietary{Code: "DUPL"}, reasons[0].Reason)
+	require.Len(t, reasons[0].AdditionalInfo, 2)
+	for _, info := range reasons[0].AdditionalInfo {
+		require.LessOrEqual(t, len(info), 105)
+	}
+	require.Contains(t, warnings, ConversionWarning{
+		Tag:     TagServiceMessage,
+		Field:   "ServiceMessage",
+		Message: "is truncated to 2 lines",
+	})
+}
+
+func TestToCamt056_invalid(t *testing.T) {
+	_, fwm := mockPacs004Reversal(t)
+	_, _, err := fwm.ToCamt056(ISOOpts{})
+	require.EqualError(t, err, fieldError("TypeSubType.SubTypeCode", ErrISOMessageType, ReversalPriorDayTransfer).Error())
+
+	_, fwm = mockCamt056RequestForReversal(t)
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = "2020"
+	_, _, err = fwm.ToCamt056(ISOOpts{})
+	require.EqualError(t, err, fieldError("PreviousMessageIdentifier.PreviousMessageIdentifier", ErrInvalidProperty,
+		"2020").Error())
+}
+
+func TestCamt056Document_FEDWireMessage(t *testing.T) {
+	_, fwm := mockCamt056RequestForReversal(t)
+	doc, _, err := fwm.ToCamt056(ISOOpts{})
+	require.NoError(t, err)
+	read, warnings := readISO20022(t, doc)
+
+	require.Equal(t, fwm.InputMessageAccountabilityData, read.InputMessageAccountabilityData)
+	require.Equal(t, fwm.TypeSubType, read.TypeSubType)
+	require.Equal(t, RequestReversal, read.TypeSubType.SubTypeCode)
+	require.Equal(t, BFCServiceMessage, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, fwm.PreviousMessageIdentifier, read.PreviousMessageIdentifier)
+	require.Equal(t, fwm.Amount, read.Amount)
+	require.Equal(t, fwm.SenderDepositoryInstitution, read.SenderDepositoryInstitution)
+	require.Equal(t, fwm.ReceiverDepositoryInstitution, read.ReceiverDepositoryInstitution)
+	require.Equal(t, fwm.SenderReference, read.SenderReference)
+	require.Equal(t, fwm.BeneficiaryReference, read.BeneficiaryReference)
+	require.Equal(t, fwm.Originator, read.Originator)
+	require.Equal(t, fwm.OriginatorFI, read.OriginatorFI)
+	require.Equal(t, fwm.BeneficiaryFI, read.BeneficiaryFI)
+	require.Equal(t, fwm.Beneficiary, read.Beneficiary)
+	require.Equal(t, fwm.ServiceMessage, read.ServiceMessage)
+	require.Empty(t, warnings)
+}
+
+func TestCamt056Document_FEDWireMessagePriorDay(t *testing.T) {
+	_, fwm := mockCamt056RequestForReversal(t)
+	doc, _, err := fwm.ToCamt056(ISOOpts{})
+	require.NoError(t, err)
+	doc.CancellationRequest.Assignment.ID = "20201006" + doc.CancellationRequest.Assignment.ID[8:]
+	tx := &doc.CancellationRequest.Underlying.TransactionInfo
+	tx.CancellationReason = []ISOReasonInformation{{Reason: &ISOCodeOrProprietary{Proprietary: "RECALL"}}}
+	tx.OriginalTransactionReference.PaymentType.CategoryPurpose.Proprietary = CustomerTransfer
+	read, _ := readISO20022(t, doc)
+
+	require.Equal(t, RequestReversalPriorDayTransfer, read.TypeSubType.SubTypeCode)
+	require.Equal(t, BFCServiceMessage, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "RECALL", read.ServiceMessage.LineOne)
+	require.Empty(t, read.ServiceMessage.LineTwo)
+}
+
+func TestCamt056Document_FEDWireMessageInvalid(t *testing.T) {
+	_, fwm := mockCamt056RequestForReversal(t)
+	doc, _, err := fwm.ToCamt056(ISOOpts{})
+	require.NoError(t, err)
+	doc.CancellationRequest.Assignment.Assigner.Agent = nil
+	_, _, err = doc.FEDWireMessage(ISOOpts{})
+	require.EqualError(t, err, fieldError("Assgnmt.Assgnr.Agt", ErrFieldRequired).Error())
+
+	doc.CancellationRequest.Underlying.TransactionInfo.OriginalInterbankSettlementAmount = nil
+	_, _, err = doc.FEDWireMessage(ISOOpts{})
+	require.EqualError(t, err, fieldError("TxInf.OrgnlIntrBkSttlmAmt", ErrFieldRequired).Error())
+}
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // Charges is the Charges of the wire
@@ -50,7 +53,10 @@ func NewCharges() *Charges {
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
-func (c *Charges) Parse(record string) {
+func (c *Charges) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 7 {
+		return NewTagWrongLengthErr(7, utf8.RuneCountInString(record))
+	}
 	c.tag = record[:6]
 	c.ChargeDetails = c.parseStringField(record[6:7])
 
@@ -67,6 +73,7 @@ func (c *Charges) Parse(record string) {
 	if len(optionalFields) >= 4 {
 		c.SendersChargesFour = c.parseStringField(optionalFields[3])
 	}
+	return nil
 }
 
 func (c *Charges) UnmarshalJSON(data []byte) error {
@@ -99,43 +106,47 @@ func (c *Charges) String() string {
 // Validate performs WIRE format rule checks on Charges and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (c *Charges) Validate() error {
-	if err := c.fieldInclusion(); err != nil {
-		return err
-	}
+	return c.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on Charges and returns every error found
+func (c *Charges) validateAll() base.ErrorList {
+	errs := c.fieldInclusion()
 	if err := c.isChargeDetails(c.ChargeDetails); err != nil {
-		return fieldError("ChargeDetails", ErrChargeDetails, c.ChargeDetails)
+		errs.Add(fieldError("ChargeDetails", ErrChargeDetails, c.ChargeDetails))
 	}
 	if err := c.isAlphanumeric(c.SendersChargesOne); err != nil {
-		return fieldError("SendersChargesOne", err, c.SendersChargesOne)
+		errs.Add(fieldError("SendersChargesOne", err, c.SendersChargesOne))
 	}
 	/*	if err := c.validateCharges(c.SendersChargesOne); err != nil {
-		return fieldError("SendersChargesOne", err, c.SendersChargesOne)
+		errs.Add(fieldError("SendersChargesOne", err, c.SendersChargesOne))
 	}*/
 	if err := c.isAlphanumeric(c.SendersChargesTwo); err != nil {
-		return fieldError("SendersChargesTwo", err, c.SendersChargesTwo)
+		errs.Add(fieldError("SendersChargesTwo", err, c.SendersChargesTwo))
 	}
 	/*	if err := c.validateCharges(c.SendersChargesTwo); err != nil {
-		return fieldError("SendersChargesTwo", err, c.SendersChargesTwo)
+		errs.Add(fieldError("SendersChargesTwo", err, c.SendersChargesTwo))
 	}*/
 	if err := c.isAlphanumeric(c.SendersChargesThree); err != nil {
-		return fieldError("SendersChargesThree", err, c.SendersChargesThree)
+		errs.Add(fieldError("SendersChargesThree", err, c.SendersChargesThree))
 	}
 	/*	if err := c.validateCharges(c.SendersChargesThree); err != nil {
-		return fieldError("SendersChargesThree", err, c.SendersChargesThree)
+		errs.Add(fieldError("SendersChargesThree", err, c.SendersChargesThree))
 	}*/
 	if err := c.isAlphanumeric(c.SendersChargesFour); err != nil {
-		return fieldError("SendersChargesFour", err, c.SendersChargesFour)
+		errs.Add(fieldError("SendersChargesFour", err, c.SendersChargesFour))
 	}
 	/*	if err := c.validateCharges(c.SendersChargesFour); err != nil {
-		return fieldError("SendersChargesFour", err, c.SendersChargesFour)
+		errs.Add(fieldError("SendersChargesFour", err, c.SendersChargesFour))
 	}*/
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (c *Charges) fieldInclusion() error {
-	return nil
+func (c *Charges) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
+	return errs
 }
 
 // ChargeDetailsField gets a string of the ChargeDetails field
@@ -162,3 +173,58 @@ func (c *Charges) SendersChargesThreeField() string {
 func (c *Charges) SendersChargesFourField() string {
 	return c.alphaField(c.SendersChargesFour, 15)
 }
+
+// SendersCharges returns the SendersCharges which are present, in order
+func (c *Charges) SendersCharges() ([]Money, error) {
+	var charges []Money
+	for i, charge := range []string{c.SendersChargesOne, c.SendersChargesTwo, c.SendersChargesThree, c.SendersChargesFour} {
+		if charge == "" {
+			continue
+		}
+		m, err := ParseSendersCharge(charge)
+		if err != nil {
+			return nil, fieldError(sendersChargesFieldNames[i], err, charge)
+		}
+		charges = append(charges, m)
+	}
+	return charges, nil
+}
+
+// SetSendersCharges sets SendersChargesOne through SendersChargesFour to up to four charges, clearing the rest
+func (c *Charges) SetSendersCharges(charges ...Money) error {
+	if len(charges) > len(sendersChargesFieldNames) {
+		return ErrAmountRange
+	}
+	var fields [4]string
+	for i := range charges {
+		charge, err := FormatSendersCharge(charges[i])
+		if err != nil {
+			return fieldError(sendersChargesFieldNames[i], err, charges[i])
+		}
+		fields[i] = charge
+	}
+	c.SendersChargesOne, c.SendersChargesTwo, c.SendersChargesThree, c.SendersChargesFour = fields[0], fields[1], fields[2], fields[3]
+	return nil
+}
+
+// sendersChargesFieldNames are the names of the SendersCharges fields, in order
+var sendersChargesFieldNames = []string{"SendersChargesOne", "SendersChargesTwo", "SendersChargesThree", "SendersChargesFour"}
+
+// ParseSendersCharge parses a SendersCharges value, a currency code followed by an amount with a decimal comma
+// such as USD1234,56
+func ParseSendersCharge(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 4 {
+		return Money{}, ErrNonAmount
+	}
+	return parseDecimalMoney(s[:3], s[3:], ',')
+}
+
+// FormatSendersCharge returns m as a SendersCharges value, such as USD1234,56
+func FormatSendersCharge(m Money) (string, error) {
+	amount, err := formatDecimalMoney(m, ',', 12)
+	if err != nil {
+		return "", err
+	}
+	return m.Currency + amount, nil
+}
@@ -43,3 +43,28 @@ func TestChargesEmpty(t *testing.T) {
 	require.Empty(t, c.SendersChargesThree)
 	require.Empty(t, c.SendersChargesFour)
 }
+
+// TestChargesSendersCharges validates Charges converts SendersCharges to and from Money
+func TestChargesSendersCharges(t *testing.T) {
+	c := mockCharges()
+
+	charges, err := c.SendersCharges()
+	require.NoError(t, err)
+	require.Equal(t, []Money{USD(99), USD(299), USD(399), USD(100)}, charges)
+
+	require.NoError(t, c.SetSendersCharges(USD(1250), Money{Currency: "EUR", MinorUnits: 7}))
+	require.Equal(t, "USD12,50", c.SendersChargesOne)
+	require.Equal(t, "EUR0,07", c.SendersChargesTwo)
+	require.Empty(t, c.SendersChargesThree)
+	require.Empty(t, c.SendersChargesFour)
+
+	charges, err = c.SendersCharges()
+	require.NoError(t, err)
+	require.Len(t, charges, 2)
+
+	require.Equal(t, ErrAmountRange, c.SetSendersCharges(USD(1), USD(2), USD(3), USD(4), USD(5)))
+
+	c.SendersChargesTwo = "USD1.00"
+	_, err = c.SendersCharges()
+	require.EqualError(t, err, fieldError("SendersChargesTwo", ErrNonAmount, c.SendersChargesTwo).Error())
+}
@@ -147,6 +147,36 @@ const (
 	// MessageDuplicationResend designates a resend of a message
 	MessageDuplicationResend = "P"
 
+	// MessageStatusIndicator
+
+	// MessageStatusInProcess is MessageDisposition {1100} MessageStatusIndicator for a message in process or
+	// intercepted
+	MessageStatusInProcess = "0"
+	// MessageStatusSuccessfulValue is MessageDisposition {1100} MessageStatusIndicator for a message processed
+	// successfully with accounting
+	MessageStatusSuccessfulValue = "2"
+	// MessageStatusRejected is MessageDisposition {1100} MessageStatusIndicator for a message rejected due to an
+	// error condition
+	MessageStatusRejected = "3"
+	// MessageStatusSuccessfulNonValue is MessageDisposition {1100} MessageStatusIndicator for a message processed
+	// successfully without accounting
+	MessageStatusSuccessfulNonValue = "7"
+
+	// ErrorCategory
+
+	// ErrorCategoryData is ErrorWire {1130} ErrorCategory for a data error
+	ErrorCategoryData = "E"
+	// ErrorCategoryInsufficientBalance is ErrorWire {1130} ErrorCategory for an insufficient balance
+	ErrorCategoryInsufficientBalance = "F"
+	// ErrorCategoryAccountability is ErrorWire {1130} ErrorCategory for an accountability error
+	ErrorCategoryAccountability = "H"
+	// ErrorCategoryIntercepted is ErrorWire {1130} ErrorCategory for a message in process or intercepted
+	ErrorCategoryIntercepted = "I"
+	// ErrorCategoryCutoffHour is ErrorWire {1130} ErrorCategory for a cutoff hour error
+	ErrorCategoryCutoffHour = "W"
+	// ErrorCategoryDuplicateIMAD is ErrorWire {1130} ErrorCategory for a duplicate IMAD
+	ErrorCategoryDuplicateIMAD = "X"
+
 	// TypeCode
 
 	// FundsTransfer is SenderSuppliedInformation {1510} TypeCode which designates a funds transfer in which the
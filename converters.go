@@ -23,6 +23,15 @@ func (c *converters) parseStringField(r string) (s string) {
 	return s
 }
 
+// parseVariableStringField returns the field at the start of r, which ends at the first "*" delimiter if present
+func (c *converters) parseVariableStringField(r string) (s string) {
+	if delim := strings.IndexByte(r, '*'); delim >= 0 {
+		r = r[:delim]
+	}
+	s = strings.TrimSpace(r)
+	return s
+}
+
 // alphaField Alphanumeric and Alphabetic fields are left-justified and space filled.
 func (c *converters) alphaField(s string, max uint) string {
 	ln := uint(len(s))
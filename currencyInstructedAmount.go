@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // CurrencyInstructedAmount is the currency instructed amount
@@ -40,6 +43,9 @@ func NewCurrencyInstructedAmount() *CurrencyInstructedAmount {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (cia *CurrencyInstructedAmount) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	cia.tag = record[:6]
 
 	optionalFields := strings.Split(record[6:], "*")
@@ -47,6 +53,9 @@ func (cia *CurrencyInstructedAmount) Parse(record string) error {
 		cia.SwiftFieldTag = cia.parseStringField(optionalFields[0])
 	}
 	if len(optionalFields) >= 2 {
+		if utf8.RuneCountInString(optionalFields[1]) < 3 {
+			return fieldError("CurrencyCode", ErrNonCurrencyCode, optionalFields[1])
+		}
 		cia.CurrencyCode = optionalFields[1][:3]
 		cia.Amount = cia.parseStringField(optionalFields[1][3:])
 	}
@@ -80,21 +89,27 @@ func (cia *CurrencyInstructedAmount) String() string {
 // Validate performs WIRE format rule checks on CurrencyInstructedAmount and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (cia *CurrencyInstructedAmount) Validate() error {
+	return cia.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on CurrencyInstructedAmount and returns every error found
+func (cia *CurrencyInstructedAmount) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if cia.tag != TagCurrencyInstructedAmount {
-		return fieldError("tag", ErrValidTagForType, cia.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, cia.tag))
 	}
 	if err := cia.isAlphanumeric(cia.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, cia.SwiftFieldTag)
+		errs.Add(fieldError("SwiftFieldTag", err, cia.SwiftFieldTag))
 	}
 	if cia.CurrencyCode != "" {
 		if err := cia.isCurrencyCode(cia.CurrencyCode); err != nil {
-			return fieldError("CurrencyCode", err, cia.CurrencyCode)
+			errs.Add(fieldError("CurrencyCode", err, cia.CurrencyCode))
 		}
 		if err := cia.isAmount(cia.Amount); err != nil {
-			return fieldError("Amount", err, cia.Amount)
+			errs.Add(fieldError("Amount", err, cia.Amount))
 		}
 	}
-	return nil
+	return errs
 }
 
 // SwiftFieldTagField gets a string of the SwiftFieldTag field
@@ -108,3 +123,19 @@ func (cia *CurrencyInstructedAmount) SwiftFieldTagField() string {
 func (cia *CurrencyInstructedAmount) AmountField() string {
 	return cia.numericStringField(cia.Amount, 15)
 }
+
+// Money returns the CurrencyCode and Amount, which uses a decimal comma
+func (cia *CurrencyInstructedAmount) Money() (Money, error) {
+	return parseDecimalMoney(cia.CurrencyCode, cia.Amount, ',')
+}
+
+// SetMoney sets the CurrencyCode and Amount to m, with a decimal comma
+func (cia *CurrencyInstructedAmount) SetMoney(m Money) error {
+	amount, err := formatDecimalMoney(m, ',', 15)
+	if err != nil {
+		return err
+	}
+	cia.CurrencyCode = m.Currency
+	cia.Amount = amount
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"sync"
+	"time"
+)
+
+// CutoffTimes are the hours of the Fedwire Funds Service for a business day, each as the offset from midnight
+// America/New_York at the start of the business day. Open is negative as the service opens the evening before.
+type CutoffTimes struct {
+	// Open is when messages for the business day are first accepted
+	Open time.Duration `json:"open"`
+	// CustomerTransferCutoff is when messages with BusinessFunctionCode CustomerTransfer or CustomerTransferPlus
+	// are last accepted
+	CustomerTransferCutoff time.Duration `json:"customerTransferCutoff"`
+	// SettlementOnly begins the window until Close in which only TypeCode SettlementTransfer messages are accepted
+	SettlementOnly time.Duration `json:"settlementOnly"`
+	// Close is the standard cutoff, when messages are last accepted
+	Close time.Duration `json:"close"`
+}
+
+// StandardCutoffTimes are the usual hours of the Fedwire Funds Service: open from 9:00 p.m. the evening before,
+// customer transfers until 6:00 p.m., settlement transfers only from 6:30 p.m. and closed at 7:00 p.m.
+var StandardCutoffTimes = CutoffTimes{
+	Open:                   -3 * time.Hour,
+	CustomerTransferCutoff: 18 * time.Hour,
+	SettlementOnly:         18*time.Hour + 30*time.Minute,
+	Close:                  19 * time.Hour,
+}
+
+// CutoffSchedule predicts whether the Fedwire Funds Service will accept a message at a time, rather than reject
+// it with ErrorWire category W (Cutoff Hour Error). The zero value is a CutoffSchedule of StandardCutoffTimes on
+// each business day of NewFedwireCalendar.
+type CutoffSchedule struct {
+	calendar  *FedwireCalendar
+	standard  *CutoffTimes
+	overrides map[string]CutoffTimes
+}
+
+// NewCutoffSchedule returns a CutoffSchedule of StandardCutoffTimes on each business day of calendar, or of
+// NewFedwireCalendar when calendar is nil
+func NewCutoffSchedule(calendar *FedwireCalendar) *CutoffSchedule {
+	return &CutoffSchedule{calendar: calendar}
+}
+
+var (
+	defaultCalendar     *FedwireCalendar
+	defaultCalendarOnce sync.Once
+)
+
+// fedwireCalendar returns the calendar of s, defaulting to NewFedwireCalendar
+func (s *CutoffSchedule) fedwireCalendar() *FedwireCalendar {
+	if s.calendar != nil {
+		return s.calendar
+	}
+	defaultCalendarOnce.Do(func() {
+		defaultCalendar = NewFedwireCalendar()
+	})
+	return defaultCalendar
+}
+
+// SetStandardTimes replaces the times of each business day without an override
+func (s *CutoffSchedule) SetStandardTimes(times CutoffTimes) {
+	s.standard = &times
+}
+
+// SetTimes overrides the times of the business day of cycleDate, such as for an early close
+func (s *CutoffSchedule) SetTimes(cycleDate time.Time, times CutoffTimes) {
+	if s.overrides == nil {
+		s.overrides = make(map[string]CutoffTimes)
+	}
+	s.overrides[startOfDay(cycleDate).Format(cycleDateLayout)] = times
+}
+
+// Times returns the times of the business day of cycleDate
+func (s *CutoffSchedule) Times(cycleDate time.Time) CutoffTimes {
+	if times, ok := s.overrides[startOfDay(cycleDate).Format(cycleDateLayout)]; ok {
+		return times
+	}
+	if s.standard == nil {
+		return StandardCutoffTimes
+	}
+	return *s.standard
+}
+
+// Accepts returns true if the Fedwire Funds Service will accept fwm at t
+func (s *CutoffSchedule) Accepts(fwm *FEDWireMessage, t time.Time) bool {
+	return s.Check(fwm, t) == nil
+}
+
+// Check returns an error if the Fedwire Funds Service will not accept fwm at t, because t is outside of the hours
+// of the current business day or after the cutoff for the BusinessFunctionCode and TypeCode of fwm
+func (s *CutoffSchedule) Check(fwm *FEDWireMessage, t time.Time) error {
+	cycleDate := s.fedwireCalendar().CycleDate(t)
+	times := s.Times(cycleDate)
+	if t.Before(cycleDate.Add(times.Open)) || !t.Before(cycleDate.Add(times.Close)) {
+		return fieldError("SendTime", ErrFedwireClosed, t.Format(time.RFC3339))
+	}
+	if fwm.BusinessFunctionCode != nil {
+		switch bfc := fwm.BusinessFunctionCode.BusinessFunctionCode; bfc {
+		case CustomerTransfer, CustomerTransferPlus:
+			if !t.Before(cycleDate.Add(times.CustomerTransferCutoff)) {
+				return fieldError("BusinessFunctionCode", ErrCustomerTransferCutoff, bfc)
+			}
+		}
+	}
+	if !t.Before(cycleDate.Add(times.SettlementOnly)) {
+		if fwm.TypeSubType == nil || fwm.TypeSubType.TypeCode != SettlementTransfer {
+			typeCode := ""
+			if fwm.TypeSubType != nil {
+				typeCode = fwm.TypeSubType.TypeCode
+			}
+			return fieldError("TypeSubType.TypeCode", ErrSettlementOnly, typeCode)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+	"time"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+// mockBankTransferData returns a valid BankTransfer FEDWireMessage
+func mockBankTransferData() FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BankTransfer
+	fwm.BusinessFunctionCode.TransactionTypeCode = ""
+	return fwm
+}
+
+func TestCutoffSchedule_Check(t *testing.T) {
+	s := NewCutoffSchedule(NewFedwireCalendar())
+	ctr := mockCustomerTransferData()
+	btr := mockBankTransferData()
+	settlement := mockBankTransferData()
+	settlement.TypeSubType.TypeCode = SettlementTransfer
+
+	cases := []struct {
+		fwm      FEDWireMessage
+		at       time.Time
+		accepted bool
+	}{
+		// Tuesday evening opens for Wednesday at 9:00 p.m.
+		{btr, newYork(t, 2023, time.November, 7, 20, 59), false},
+		{btr, newYork(t, 2023, time.November, 7, 21, 0), true},
+		{ctr, newYork(t, 2023, time.November, 8, 17, 59), true},
+		{ctr, newYork(t, 2023, time.November, 8, 18, 0), false},
+		{btr, newYork(t, 2023, time.November, 8, 18, 29), true},
+		{btr, newYork(t, 2023, time.November, 8, 18, 30), false},
+		{settlement, newYork(t, 2023, time.November, 8, 18, 59), true},
+		{settlement, newYork(t, 2023, time.November, 8, 19, 0), false},
+		// Saturday, and Sunday evening opening for Monday
+		{btr, newYork(t, 2023, time.November, 11, 12, 0), false},
+		{btr, newYork(t, 2023, time.November, 12, 21, 0), true},
+		// Thanksgiving
+		{btr, newYork(t, 2023, time.November, 23, 12, 0), false},
+	}
+	for _, tc := range cases {
+		require.Equal(t, tc.accepted, s.Accepts(&tc.fwm, tc.at), "%s %s", tc.fwm.BusinessFunctionCode.BusinessFunctionCode, tc.at)
+	}
+
+	at := newYork(t, 2023, time.November, 8, 18, 0)
+	require.EqualError(t, s.Check(&ctr, at), fieldError("BusinessFunctionCode", ErrCustomerTransferCutoff, CustomerTransfer).Error())
+	at = newYork(t, 2023, time.November, 8, 18, 45)
+	require.EqualError(t, s.Check(&btr, at), fieldError("TypeSubType.TypeCode", ErrSettlementOnly, FundsTransfer).Error())
+	at = newYork(t, 2023, time.November, 8, 19, 0)
+	require.EqualError(t, s.Check(&btr, at), fieldError("SendTime", ErrFedwireClosed, "2023-11-08T19:00:00-05:00").Error())
+}
+
+func TestCutoffSchedule_EarlyClose(t *testing.T) {
+	s := NewCutoffSchedule(NewFedwireCalendar())
+	earlyClose := newYork(t, 2023, time.November, 24, 0, 0)
+	times := StandardCutoffTimes
+	times.CustomerTransferCutoff = 13 * time.Hour
+	times.SettlementOnly = 13*time.Hour + 30*time.Minute
+	times.Close = 14 * time.Hour
+	s.SetTimes(earlyClose, times)
+
+	require.Equal(t, times, s.Times(newYork(t, 2023, time.November, 24, 9, 0)))
+	require.Equal(t, StandardCutoffTimes, s.Times(newYork(t, 2023, time.November, 27, 9, 0)))
+
+	ctr := mockCustomerTransferData()
+	require.True(t, s.Accepts(&ctr, newYork(t, 2023, time.November, 24, 12, 59)))
+	require.False(t, s.Accepts(&ctr, newYork(t, 2023, time.November, 24, 13, 0)))
+	require.True(t, s.Accepts(&ctr, newYork(t, 2023, time.November, 27, 13, 0)))
+
+	later := StandardCutoffTimes
+	later.Close = 19*time.Hour + 30*time.Minute
+	s.SetStandardTimes(later)
+	btr := mockBankTransferData()
+	btr.TypeSubType.TypeCode = SettlementTransfer
+	require.True(t, s.Accepts(&btr, newYork(t, 2023, time.November, 27, 19, 15)))
+}
+
+// TestCutoffSchedule_Defaults ensures a CutoffSchedule without a calendar uses NewFedwireCalendar
+func TestCutoffSchedule_Defaults(t *testing.T) {
+	ctr := mockCustomerTransferData()
+	thanksgiving := newYork(t, 2023, time.November, 23, 12, 0)
+
+	for _, s := range []*CutoffSchedule{NewCutoffSchedule(nil), {}} {
+		require.Equal(t, StandardCutoffTimes, s.Times(thanksgiving))
+		// a message sent on a holiday is for the next business day, which opens that evening
+		require.False(t, s.Accepts(&ctr, thanksgiving))
+		require.True(t, s.Accepts(&ctr, newYork(t, 2023, time.November, 24, 12, 0)))
+
+		times := StandardCutoffTimes
+		times.CustomerTransferCutoff = 12 * time.Hour
+		s.SetTimes(newYork(t, 2023, time.November, 24, 0, 0), times)
+		require.False(t, s.Accepts(&ctr, newYork(t, 2023, time.November, 24, 12, 0)))
+	}
+}
+
+func TestFEDWireMessage_ValidateWithCutoffs(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.TransactionTypeCode = ""
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	opts := &ValidateOpts{
+		Cutoffs:  NewCutoffSchedule(NewFedwireCalendar()),
+		SendTime: newYork(t, 2023, time.November, 8, 12, 0),
+	}
+	require.NoError(t, fwm.ValidateWith(opts))
+
+	opts.SendTime = newYork(t, 2023, time.November, 8, 18, 5)
+	require.EqualError(t, fwm.ValidateWith(opts), "{3600} BusinessFunctionCode CTR is after the customer transfer cutoff")
+
+	opts.CollectAll = true
+	err := fwm.ValidateWith(opts)
+	require.IsType(t, base.ErrorList{}, err)
+	require.Len(t, err.(base.ErrorList), 1)
+	require.True(t, base.Match(err.(base.ErrorList)[0], ErrCustomerTransferCutoff))
+}
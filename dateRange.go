@@ -0,0 +1,116 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"time"
+
+	"github.com/moov-io/base"
+)
+
+// DateRange limits how far a tag's date may be from the day of the ValidateOpts SendTime, in America/New_York.
+// The zero value permits any date.
+//
+// A DateRange applies to the dates of these tags:
+//   - TagInputMessageAccountabilityData: InputCycleDate
+//   - TagRemittanceOriginator and TagRemittanceBeneficiary: the date of birth of a PICDateBirthPlace DateBirthPlace
+//   - TagDateRemittanceDocument: DateRemittanceDocument
+type DateRange struct {
+	// NotInFuture rejects dates after today, such as for a date of birth
+	NotInFuture bool `json:"notInFuture,omitempty"`
+	// MaxYearsFuture, when positive, rejects dates more than this many years after today
+	MaxYearsFuture int `json:"maxYearsFuture,omitempty"`
+	// MaxYearsPast, when positive, rejects dates more than this many years before today
+	MaxYearsPast int `json:"maxYearsPast,omitempty"`
+}
+
+// check returns an error if date is outside of r relative to today
+func (r DateRange) check(date, today time.Time) error {
+	if r.NotInFuture && date.After(today) {
+		return ErrDateInFuture
+	}
+	if r.MaxYearsFuture > 0 && date.After(today.AddDate(r.MaxYearsFuture, 0, 0)) {
+		return ErrDateOutOfRange
+	}
+	if r.MaxYearsPast > 0 && date.Before(today.AddDate(-r.MaxYearsPast, 0, 0)) {
+		return ErrDateOutOfRange
+	}
+	return nil
+}
+
+// dateField is a CCYYMMDD date within a FEDWireMessage and the path of the field holding it
+type dateField struct {
+	path  string
+	value string
+	date  string
+}
+
+// tagDates returns the dates held by the tag of fwm
+func (fwm *FEDWireMessage) tagDates(tag string) []dateField {
+	var dates []dateField
+	switch tag {
+	case TagInputMessageAccountabilityData:
+		if fwm.InputMessageAccountabilityData != nil {
+			dates = append(dates, dateField{"InputMessageAccountabilityData.InputCycleDate",
+				fwm.InputMessageAccountabilityData.InputCycleDate, fwm.InputMessageAccountabilityData.InputCycleDate})
+		}
+	case TagRemittanceOriginator:
+		if ro := fwm.RemittanceOriginator; ro != nil && ro.IdentificationCode == PICDateBirthPlace {
+			dates = append(dates, dateBirthPlaceField("RemittanceOriginator.RemittanceData.DateBirthPlace",
+				ro.RemittanceData.DateBirthPlace)...)
+		}
+	case TagRemittanceBeneficiary:
+		if rb := fwm.RemittanceBeneficiary; rb != nil && rb.IdentificationCode == PICDateBirthPlace {
+			dates = append(dates, dateBirthPlaceField("RemittanceBeneficiary.RemittanceData.DateBirthPlace",
+				rb.RemittanceData.DateBirthPlace)...)
+		}
+	case TagDateRemittanceDocument:
+		if fwm.DateRemittanceDocument != nil {
+			dates = append(dates, dateField{"DateRemittanceDocument.DateRemittanceDocument",
+				fwm.DateRemittanceDocument.DateRemittanceDocument, fwm.DateRemittanceDocument.DateRemittanceDocument})
+		}
+	}
+	return dates
+}
+
+// dateBirthPlaceField returns the date of birth of a Date & Place of Birth, when it has one
+func dateBirthPlaceField(path, dateBirthPlace string) []dateField {
+	if len(dateBirthPlace) < 8 {
+		return nil
+	}
+	return []dateField{{path, dateBirthPlace, dateBirthPlace[:8]}}
+}
+
+// checkDateRanges returns an error for each date within fwm outside of the DateRange for its tag, relative to the
+// day of sendTime. Dates which are not valid are reported by the tag's validation instead.
+func (fwm *FEDWireMessage) checkDateRanges(ranges map[string]DateRange, sendTime time.Time) base.ErrorList {
+	var errs base.ErrorList
+	if len(ranges) == 0 {
+		return errs
+	}
+	loc, err := FedwireLocation()
+	if err != nil {
+		errs.Add(err)
+		return errs
+	}
+	now := sendTime.In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	for _, tag := range fedWireMessageTagList() {
+		r, ok := ranges[tag]
+		if !ok {
+			continue
+		}
+		for _, d := range fwm.tagDates(tag) {
+			date, err := parseCycleDate(d.date)
+			if err != nil {
+				continue
+			}
+			if err := r.check(date, today); err != nil {
+				errs.Add(&FieldError{FieldName: d.path, Value: d.value, Err: err, Tag: tag})
+			}
+		}
+	}
+	return errs
+}
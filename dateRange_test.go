@@ -0,0 +1,114 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+	"time"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateRange_check(t *testing.T) {
+	today := time.Date(2021, time.June, 15, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, DateRange{}.check(today.AddDate(50, 0, 0), today))
+
+	r := DateRange{NotInFuture: true}
+	require.NoError(t, r.check(today, today))
+	require.Equal(t, ErrDateInFuture, r.check(today.AddDate(0, 0, 1), today))
+
+	r = DateRange{MaxYearsFuture: 1, MaxYearsPast: 2}
+	require.NoError(t, r.check(today.AddDate(1, 0, 0), today))
+	require.NoError(t, r.check(today.AddDate(-2, 0, 0), today))
+	require.Equal(t, ErrDateOutOfRange, r.check(today.AddDate(1, 0, 1), today))
+	require.Equal(t, ErrDateOutOfRange, r.check(today.AddDate(-2, 0, -1), today))
+}
+
+func TestFEDWireMessage_ValidateWithDateRanges(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.TransactionTypeCode = ""
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	require.NoError(t, fwm.InputMessageAccountabilityData.SetCycleDate(time.Now().AddDate(3, 0, 0)))
+	require.NoError(t, fwm.verify())
+
+	opts := &ValidateOpts{DateRanges: map[string]DateRange{
+		TagInputMessageAccountabilityData: {MaxYearsFuture: 1},
+	}}
+	err := fwm.ValidateWith(opts)
+	require.EqualError(t, err, "{1520} InputMessageAccountabilityData.InputCycleDate "+
+		fwm.InputMessageAccountabilityData.InputCycleDate+" is outside of the permitted date range")
+
+	opts.CollectAll = true
+	err = fwm.ValidateWith(opts)
+	require.IsType(t, base.ErrorList{}, err)
+	require.Len(t, err.(base.ErrorList), 1)
+
+	file := NewFile()
+	file.AddFEDWireMessage(fwm)
+	file.AddFEDWireMessage(fwm)
+	require.NoError(t, file.Validate())
+	err = file.ValidateWith(opts)
+	require.Len(t, err.(base.ErrorList), 2)
+	require.EqualError(t, err.(base.ErrorList)[1], "{1520} FEDWireMessages[1].InputMessageAccountabilityData.InputCycleDate "+
+		fwm.InputMessageAccountabilityData.InputCycleDate+" is outside of the permitted date range")
+
+	opts.CollectAll = false
+	err = file.ValidateWith(opts)
+	require.Len(t, err.(base.ErrorList), 2)
+	require.True(t, base.Match(err.(base.ErrorList)[0], ErrDateOutOfRange))
+}
+
+func TestFEDWireMessage_checkDateRanges(t *testing.T) {
+	loc, err := FedwireLocation()
+	require.NoError(t, err)
+	tomorrow := time.Now().In(loc).AddDate(0, 0, 1).Format(cycleDateLayout)
+
+	fwm := new(FEDWireMessage)
+	fwm.RemittanceBeneficiary = mockRemittanceBeneficiary()
+	fwm.RemittanceBeneficiary.IdentificationCode = PICDateBirthPlace
+	fwm.RemittanceBeneficiary.RemittanceData.DateBirthPlace = tomorrow + " Pottstown US"
+	fwm.DateRemittanceDocument = mockDateRemittanceDocument()
+	fwm.DateRemittanceDocument.DateRemittanceDocument = time.Now().In(loc).AddDate(-20, 0, 0).Format(cycleDateLayout)
+
+	ranges := map[string]DateRange{
+		TagRemittanceBeneficiary:  {NotInFuture: true},
+		TagDateRemittanceDocument: {MaxYearsPast: 10, MaxYearsFuture: 1},
+	}
+	errs := fwm.checkDateRanges(ranges, time.Now())
+
+	require.Len(t, errs, 2)
+	require.Equal(t, &FieldError{FieldName: "RemittanceBeneficiary.RemittanceData.DateBirthPlace",
+		Value: tomorrow + " Pottstown US", Err: ErrDateInFuture, Tag: TagRemittanceBeneficiary}, errs[0])
+	require.True(t, base.Match(errs[1], ErrDateOutOfRange))
+
+	// dates which are not valid are left to the tag's validation
+	fwm.RemittanceBeneficiary.RemittanceData.DateBirthPlace = "Pottstown"
+	fwm.DateRemittanceDocument.DateRemittanceDocument = "20230229"
+	require.Empty(t, fwm.checkDateRanges(ranges, time.Now()))
+	require.Empty(t, fwm.checkDateRanges(nil, time.Now()))
+}
+
+// TestFEDWireMessage_checkDateRangesSendTime ensures dates are checked relative to the day of the SendTime
+func TestFEDWireMessage_checkDateRangesSendTime(t *testing.T) {
+	loc, err := FedwireLocation()
+	require.NoError(t, err)
+	fwm := new(FEDWireMessage)
+	fwm.DateRemittanceDocument = mockDateRemittanceDocument()
+	fwm.DateRemittanceDocument.DateRemittanceDocument = "20201006"
+	ranges := map[string]DateRange{TagDateRemittanceDocument: {NotInFuture: true}}
+
+	sendTime := time.Date(2020, time.October, 6, 9, 0, 0, 0, loc)
+	require.Empty(t, fwm.checkDateRanges(ranges, sendTime))
+
+	errs := fwm.checkDateRanges(ranges, sendTime.AddDate(0, 0, -1))
+	require.Len(t, errs, 1)
+	require.True(t, base.Match(errs[0], ErrDateInFuture))
+
+	opts := &ValidateOpts{DateRanges: ranges, SendTime: sendTime.AddDate(0, 0, -1)}
+	require.True(t, base.Match(fwm.checkOpts(opts)[0], ErrDateInFuture))
+}
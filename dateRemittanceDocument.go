@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // DateRemittanceDocument is the date of remittance document
@@ -35,6 +38,9 @@ func NewDateRemittanceDocument() *DateRemittanceDocument {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (drd *DateRemittanceDocument) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 14 {
+		return NewTagWrongLengthErr(14, utf8.RuneCountInString(record))
+	}
 	drd.tag = record[:6]
 	drd.DateRemittanceDocument = drd.parseStringField(record[6:14])
 	return nil
@@ -66,25 +72,29 @@ func (drd *DateRemittanceDocument) String() string {
 // Validate performs WIRE format rule checks on DateRemittanceDocument and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (drd *DateRemittanceDocument) Validate() error {
-	if err := drd.fieldInclusion(); err != nil {
-		return err
-	}
+	return drd.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on DateRemittanceDocument and returns every error found
+func (drd *DateRemittanceDocument) validateAll() base.ErrorList {
+	errs := drd.fieldInclusion()
 	if drd.tag != TagDateRemittanceDocument {
-		return fieldError("tag", ErrValidTagForType, drd.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, drd.tag))
 	}
 	if err := drd.validateDate(drd.DateRemittanceDocument); err != nil {
-		return err
+		errs.Add(err)
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (drd *DateRemittanceDocument) fieldInclusion() error {
+func (drd *DateRemittanceDocument) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if drd.DateRemittanceDocument == "" {
-		return fieldError("DateRemittanceDocument", ErrFieldRequired)
+		errs.Add(fieldError("DateRemittanceDocument", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // DateRemittanceDocumentField gets a string of the DateRemittanceDocument field
@@ -0,0 +1,151 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// DrawdownOpts are the fields of a drawdown response which are not taken from the drawdown request
+type DrawdownOpts struct {
+	// InputMessageAccountabilityData is the IMAD of the response
+	InputMessageAccountabilityData *InputMessageAccountabilityData `json:"inputMessageAccountabilityData"`
+	// SenderReference, when not empty, is the SenderReference of the response
+	SenderReference string `json:"senderReference,omitempty"`
+	// Reason, when not empty, is sent as FIAdditionalFIToFI of a refusal
+	Reason string `json:"reason,omitempty"`
+}
+
+// NewDrawdownPayment returns a DrawdownResponse with SubTypeCode FundsTransferRequestCredit which honors
+// request, a received BankDrawDownRequest or CustomerCorporateDrawdownRequest. The AccountDebitedDrawdown of
+// request is the Originator and the Beneficiary of request, or otherwise the ABA routing number of its
+// AccountCreditedDrawdown, is the Beneficiary.
+//
+// The PreviousMessageIdentifier of the response is the IMAD of request and its BeneficiaryReference is the
+// SenderReference of request, so the requester can correlate the two.
+func NewDrawdownPayment(request FEDWireMessage, opts DrawdownOpts) (FEDWireMessage, error) {
+	fwm, err := newDrawdownResponse(request, opts, FundsTransferRequestCredit)
+	if err != nil {
+		return FEDWireMessage{}, err
+	}
+	fwm.BusinessFunctionCode = NewBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = DrawdownResponse
+
+	fwm.Originator = NewOriginator()
+	fwm.Originator.Personal.IdentificationCode = request.AccountDebitedDrawdown.IdentificationCode
+	fwm.Originator.Personal.Identifier = request.AccountDebitedDrawdown.Identifier
+	fwm.Originator.Personal.Name = request.AccountDebitedDrawdown.Name
+	fwm.Originator.Personal.Address = request.AccountDebitedDrawdown.Address
+	fwm.Beneficiary = NewBeneficiary()
+	if request.Beneficiary != nil {
+		fwm.Beneficiary.Personal = request.Beneficiary.Personal
+	} else {
+		// the DrawdownCreditAccountNumber is the ABA routing number of the institution credited
+		fwm.Beneficiary.Personal.IdentificationCode = FEDRoutingNumber
+		fwm.Beneficiary.Personal.Identifier = request.AccountCreditedDrawdown.DrawdownCreditAccountNumber
+		fwm.Beneficiary.Personal.Name = request.SenderDepositoryInstitution.SenderShortName
+	}
+	if request.SenderReference != nil {
+		fwm.BeneficiaryReference = NewBeneficiaryReference()
+		fwm.BeneficiaryReference.BeneficiaryReference = request.SenderReference.SenderReference
+	}
+	return fwm, nil
+}
+
+// NewDrawdownRefusal returns a refusal of request, a received BankDrawDownRequest or
+// CustomerCorporateDrawdownRequest, with the BusinessFunctionCode, accounts and Beneficiary of request and
+// SubTypeCode RefusalRequestCredit. The PreviousMessageIdentifier of the refusal is the IMAD of request.
+func NewDrawdownRefusal(request FEDWireMessage, opts DrawdownOpts) (FEDWireMessage, error) {
+	fwm, err := newDrawdownResponse(request, opts, RefusalRequestCredit)
+	if err != nil {
+		return FEDWireMessage{}, err
+	}
+	bfc := *request.BusinessFunctionCode
+	fwm.BusinessFunctionCode = &bfc
+	debitDD := *request.AccountDebitedDrawdown
+	fwm.AccountDebitedDrawdown = &debitDD
+	creditDD := *request.AccountCreditedDrawdown
+	fwm.AccountCreditedDrawdown = &creditDD
+	if request.Beneficiary != nil {
+		ben := *request.Beneficiary
+		fwm.Beneficiary = &ben
+	}
+	if opts.Reason != "" {
+		fwm.FIAdditionalFIToFI = NewFIAdditionalFIToFI()
+		fwm.FIAdditionalFIToFI.AdditionalFIToFI.LineOne = opts.Reason
+	}
+	return fwm, nil
+}
+
+// newDrawdownResponse returns the tags shared by a drawdown payment and refusal of request, which is sent back
+// to the sender of request
+func newDrawdownResponse(request FEDWireMessage, opts DrawdownOpts, subTypeCode string) (FEDWireMessage, error) {
+	if !request.IsDrawdownRequest() {
+		subTypeCode := ""
+		if request.TypeSubType != nil {
+			subTypeCode = request.TypeSubType.SubTypeCode
+		}
+		return FEDWireMessage{}, fieldError("TypeSubType.SubTypeCode", ErrDrawdownRequest, subTypeCode)
+	}
+	if err := request.requireTags(respondedTags...); err != nil {
+		return FEDWireMessage{}, err
+	}
+	if request.AccountDebitedDrawdown == nil {
+		return FEDWireMessage{}, fieldError("AccountDebitedDrawdown", ErrFieldRequired)
+	}
+	if request.AccountCreditedDrawdown == nil {
+		return FEDWireMessage{}, fieldError("AccountCreditedDrawdown", ErrFieldRequired)
+	}
+	if opts.InputMessageAccountabilityData == nil {
+		return FEDWireMessage{}, fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+
+	fwm := FEDWireMessage{}
+	// a received request may have a MessageDisposition in place of SenderSupplied
+	fwm.SenderSupplied = NewSenderSupplied()
+	if request.SenderSupplied != nil {
+		ss := *request.SenderSupplied
+		ss.MessageDuplicationCode = MessageDuplicationOriginal
+		fwm.SenderSupplied = &ss
+	}
+	fwm.TypeSubType = NewTypeSubType()
+	fwm.TypeSubType.TypeCode = request.TypeSubType.TypeCode
+	fwm.TypeSubType.SubTypeCode = subTypeCode
+	imad := *opts.InputMessageAccountabilityData
+	fwm.InputMessageAccountabilityData = &imad
+	amt := *request.Amount
+	fwm.Amount = &amt
+	fwm.SenderDepositoryInstitution = NewSenderDepositoryInstitution()
+	fwm.SenderDepositoryInstitution.SenderABANumber = request.ReceiverDepositoryInstitution.ReceiverABANumber
+	fwm.SenderDepositoryInstitution.SenderShortName = request.ReceiverDepositoryInstitution.ReceiverShortName
+	fwm.ReceiverDepositoryInstitution = NewReceiverDepositoryInstitution()
+	fwm.ReceiverDepositoryInstitution.ReceiverABANumber = request.SenderDepositoryInstitution.SenderABANumber
+	fwm.ReceiverDepositoryInstitution.ReceiverShortName = request.SenderDepositoryInstitution.SenderShortName
+	if opts.SenderReference != "" {
+		fwm.SenderReference = NewSenderReference()
+		fwm.SenderReference.SenderReference = opts.SenderReference
+	}
+	fwm.PreviousMessageIdentifier = NewPreviousMessageIdentifier()
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = request.InputMessageAccountabilityData.IMAD()
+	return fwm, nil
+}
+
+// IsDrawdownRequest returns true if fwm is a BankDrawDownRequest or CustomerCorporateDrawdownRequest with
+// SubTypeCode RequestCredit
+func (fwm *FEDWireMessage) IsDrawdownRequest() bool {
+	if fwm.BusinessFunctionCode == nil || fwm.TypeSubType == nil || fwm.TypeSubType.SubTypeCode != RequestCredit {
+		return false
+	}
+	switch fwm.BusinessFunctionCode.BusinessFunctionCode {
+	case BankDrawDownRequest, CustomerCorporateDrawdownRequest:
+		return true
+	}
+	return false
+}
+
+// RespondsTo returns true if the PreviousMessageIdentifier of fwm is the IMAD of original, as for a drawdown
+// response to its drawdown request or a reversal of its original transfer
+func (fwm *FEDWireMessage) RespondsTo(original FEDWireMessage) bool {
+	if fwm.PreviousMessageIdentifier == nil || original.InputMessageAccountabilityData == nil {
+		return false
+	}
+	return fwm.PreviousMessageIdentifier.PreviousMessageIdentifier == original.InputMessageAccountabilityData.IMAD()
+}
@@ -0,0 +1,116 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockBankDrawdownRequest creates a received BankDrawDownRequest
+func mockBankDrawdownRequest() FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.TypeSubType.TypeCode = SettlementTransfer
+	fwm.TypeSubType.SubTypeCode = RequestCredit
+	fwm.ReceiverDepositoryInstitution.ReceiverABANumber = "231380104"
+	fwm.ReceiverDepositoryInstitution.ReceiverShortName = "Citadel"
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BankDrawDownRequest
+	fwm.BusinessFunctionCode.TransactionTypeCode = ""
+	fwm.SenderReference = mockSenderReference()
+	fwm.AccountDebitedDrawdown = mockAccountDebitedDrawdown()
+	fwm.AccountCreditedDrawdown = mockAccountCreditedDrawdown()
+	return fwm
+}
+
+func TestNewDrawdownPayment(t *testing.T) {
+	request := mockBankDrawdownRequest()
+	require.NoError(t, request.verify())
+	require.True(t, request.IsDrawdownRequest())
+	opts := DrawdownOpts{InputMessageAccountabilityData: mockInputMessageAccountabilityData(), SenderReference: "Payment"}
+	opts.InputMessageAccountabilityData.InputSequenceNumber = "000002"
+
+	fwm, err := NewDrawdownPayment(request, opts)
+	require.NoError(t, err)
+	require.NoError(t, fwm.verify())
+	require.False(t, fwm.IsDrawdownRequest())
+	require.True(t, fwm.RespondsTo(request))
+
+	require.Equal(t, DrawdownResponse, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, SettlementTransfer, fwm.TypeSubType.TypeCode)
+	require.Equal(t, FundsTransferRequestCredit, fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, request.Amount.Amount, fwm.Amount.Amount)
+	require.Equal(t, request.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, request.SenderDepositoryInstitution.SenderABANumber, fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	require.Equal(t, "Payment", fwm.SenderReference.SenderReference)
+	require.Equal(t, request.SenderReference.SenderReference, fwm.BeneficiaryReference.BeneficiaryReference)
+
+	require.Equal(t, request.AccountDebitedDrawdown.Identifier, fwm.Originator.Personal.Identifier)
+	require.Equal(t, request.AccountDebitedDrawdown.Name, fwm.Originator.Personal.Name)
+	require.Equal(t, request.AccountDebitedDrawdown.Address, fwm.Originator.Personal.Address)
+	require.Equal(t, FEDRoutingNumber, fwm.Beneficiary.Personal.IdentificationCode)
+	require.Equal(t, request.AccountCreditedDrawdown.DrawdownCreditAccountNumber, fwm.Beneficiary.Personal.Identifier)
+	require.Nil(t, fwm.AccountDebitedDrawdown)
+	require.Nil(t, fwm.AccountCreditedDrawdown)
+}
+
+func TestNewDrawdownPayment_customerCorporate(t *testing.T) {
+	request := mockBankDrawdownRequest()
+	request.TypeSubType.TypeCode = FundsTransfer
+	request.BusinessFunctionCode.BusinessFunctionCode = CustomerCorporateDrawdownRequest
+	request.Beneficiary = mockBeneficiary()
+	require.NoError(t, request.verify())
+
+	fwm, err := NewDrawdownPayment(request, DrawdownOpts{InputMessageAccountabilityData: mockInputMessageAccountabilityData()})
+	require.NoError(t, err)
+	require.NoError(t, fwm.verify())
+	require.Equal(t, FundsTransfer, fwm.TypeSubType.TypeCode)
+	require.Equal(t, request.Beneficiary.Personal, fwm.Beneficiary.Personal)
+}
+
+func TestNewDrawdownRefusal(t *testing.T) {
+	request := mockBankDrawdownRequest()
+	opts := DrawdownOpts{InputMessageAccountabilityData: mockInputMessageAccountabilityData(), Reason: "Insufficient funds"}
+
+	fwm, err := NewDrawdownRefusal(request, opts)
+	require.NoError(t, err)
+	require.NoError(t, fwm.verify())
+	require.True(t, fwm.RespondsTo(request))
+	require.Equal(t, BankDrawDownRequest, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, RefusalRequestCredit, fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, *request.AccountDebitedDrawdown, *fwm.AccountDebitedDrawdown)
+	require.Equal(t, *request.AccountCreditedDrawdown, *fwm.AccountCreditedDrawdown)
+	require.Equal(t, request.SenderDepositoryInstitution.SenderABANumber, fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	require.Equal(t, "Insufficient funds", fwm.FIAdditionalFIToFI.AdditionalFIToFI.LineOne)
+}
+
+// TestNewDrawdownRefusal_partialRequest ensures only the tags read from the request are required
+func TestNewDrawdownRefusal_partialRequest(t *testing.T) {
+	request := mockBankDrawdownRequest()
+	request.SenderSupplied = nil
+	opts := DrawdownOpts{InputMessageAccountabilityData: mockInputMessageAccountabilityData()}
+
+	fwm, err := NewDrawdownRefusal(request, opts)
+	require.NoError(t, err)
+	require.NotNil(t, fwm.SenderSupplied)
+
+	request.Amount = nil
+	_, err = NewDrawdownRefusal(request, opts)
+	require.EqualError(t, err, fieldError("Amount", ErrFieldRequired).Error())
+}
+
+func TestNewDrawdownResponse_invalid(t *testing.T) {
+	request := mockBankDrawdownRequest()
+
+	_, err := NewDrawdownPayment(request, DrawdownOpts{})
+	require.EqualError(t, err, fieldError("InputMessageAccountabilityData", ErrFieldRequired).Error())
+
+	opts := DrawdownOpts{InputMessageAccountabilityData: mockInputMessageAccountabilityData()}
+	_, err = NewDrawdownRefusal(mockCustomerTransferData(), opts)
+	require.EqualError(t, err, fieldError("TypeSubType.SubTypeCode", ErrDrawdownRequest, BasicFundsTransfer).Error())
+
+	other := mockBankDrawdownRequest()
+	require.False(t, other.RespondsTo(request))
+}
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // ErrorWire is a wire error with the fedwire message
@@ -38,18 +41,18 @@ func NewErrorWire() *ErrorWire {
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
-func (ew *ErrorWire) Parse(record string) {
+func (ew *ErrorWire) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 10 {
+		return NewTagWrongLengthErr(10, utf8.RuneCountInString(record))
+	}
 	ew.tag = record[:6]
 	ew.ErrorCategory = ew.parseStringField(record[6:7])
 	ew.ErrorCode = ew.parseStringField(record[7:10])
 
 	// A description of the error/intercept condition. In some error descriptions, the left and right curly braces will be used to denote
 	// Fedwire Funds tags. For example: H024=INVLD CYCLE DT/MISSING/INVLD {1520}
-	if delim := strings.IndexByte(record, '*'); delim > 0 {
-		ew.ErrorDescription = ew.parseStringField(record[10:delim])
-	} else {
-		ew.ErrorDescription = ew.parseStringField(record[10:])
-	}
+	ew.ErrorDescription = ew.parseVariableStringField(record[10:])
+	return nil
 }
 
 func (ew *ErrorWire) UnmarshalJSON(data []byte) error {
@@ -80,8 +83,13 @@ func (ew *ErrorWire) String() string {
 // Validate performs WIRE format rule checks on ErrorWire and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (ew *ErrorWire) Validate() error {
-	// Currently no validation as the FED is responsible for the values
-	return nil
+	return ew.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on ErrorWire and returns every error found
+func (ew *ErrorWire) validateAll() base.ErrorList {
+	var errs base.ErrorList
+	return errs
 }
 
 // ErrorCategoryField gets a string of the ErrorCategory field
@@ -0,0 +1,202 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrorWireCode describes an ErrorWire {1130} error or intercept condition reported by the Fedwire Funds Service
+type ErrorWireCode struct {
+	// Category is the ErrorCategory, such as ErrorCategoryData
+	Category string `json:"category"`
+	// Code is the three character ErrorCode, which is empty when describing a Category
+	Code string `json:"code,omitempty"`
+	// Description describes the condition
+	Description string `json:"description"`
+	// Tag is the tag in error, such as {1520}, when the condition identifies one
+	Tag string `json:"tag,omitempty"`
+	// Retryable is true if the same message may be accepted when sent again later, without correcting it
+	Retryable bool `json:"retryable"`
+	// Remediation suggests how to resolve the condition
+	Remediation string `json:"remediation"`
+}
+
+// errorCategories describes each ErrorCategory, and so each ErrorCode not in an ErrorCatalog
+var errorCategories = map[string]ErrorWireCode{
+	ErrorCategoryData: {
+		Category:    ErrorCategoryData,
+		Description: "Data Error",
+		Remediation: "Correct the tag identified by the error description and send the message again with a new IMAD",
+	},
+	ErrorCategoryInsufficientBalance: {
+		Category:    ErrorCategoryInsufficientBalance,
+		Description: "Insufficient Balance",
+		Retryable:   true,
+		Remediation: "Fund the sender's account or raise its limits, then send the message again with a new IMAD",
+	},
+	ErrorCategoryAccountability: {
+		Category:    ErrorCategoryAccountability,
+		Description: "Accountability Error",
+		Remediation: "Correct the InputMessageAccountabilityData and send the message again",
+	},
+	ErrorCategoryIntercepted: {
+		Category:    ErrorCategoryIntercepted,
+		Description: "In Process or Intercepted",
+		Remediation: "Do not send the message again; wait for the Fedwire Funds Service to process or reject it",
+	},
+	ErrorCategoryCutoffHour: {
+		Category:    ErrorCategoryCutoffHour,
+		Description: "Cutoff Hour Error",
+		Retryable:   true,
+		Remediation: "Send the message again with a new IMAD once the Fedwire Funds Service accepts it, such as on the next business day",
+	},
+	ErrorCategoryDuplicateIMAD: {
+		Category:    ErrorCategoryDuplicateIMAD,
+		Description: "Duplicate IMAD",
+		Remediation: "Confirm whether the message with the IMAD was processed, and only if not send it again with a new IMAD",
+	},
+}
+
+// fedwireErrorWireCodes are the ErrorCodes built into this package, in the format read by ReadErrorWireCodes.
+// They are limited to the ErrorCodes cited by the documentation of ErrorWire. The complete list of ErrorCodes is
+// published in the Fedwire Funds Service Format Reference Guide, which is distributed to Fedwire participants
+// rather than with this package, and is loaded with ReadErrorWireCodes.
+const fedwireErrorWireCodes = `# Category and Code|Tag|Retryable|Description|Remediation
+H024|{1520}|false|INVLD CYCLE DT/MISSING/INVLD|Set InputCycleDate to the current Fedwire business day and send the message again
+`
+
+// errorDescriptionTag matches a tag within an ErrorDescription, such as {1520} in INVLD CYCLE DT/MISSING/INVLD {1520}
+var errorDescriptionTag = regexp.MustCompile(`{[0-9]{4}}`)
+
+// ErrorCatalog describes the ErrorCodes of the Fedwire Funds Service, so an ErrorWire can be interpreted
+type ErrorCatalog struct {
+	codes map[string]ErrorWireCode
+}
+
+// NewErrorCatalog returns an ErrorCatalog of the ErrorCodes built into this package, which describes every
+// ErrorCategory but only the few ErrorCodes cited by ErrorWire. ErrorCodes not in the catalog are described by
+// their ErrorCategory and the ErrorDescription of the message, with the remediation of a data error naming the
+// tag in error. Use NewErrorCatalogWithCodes to describe the ErrorCodes of the Fedwire Funds Service Format
+// Reference Guide.
+func NewErrorCatalog() *ErrorCatalog {
+	codes, err := ReadErrorWireCodes(strings.NewReader(fedwireErrorWireCodes))
+	if err != nil {
+		panic(fmt.Sprintf("wire: invalid built in error codes: %v", err))
+	}
+	c, err := NewErrorCatalogWithCodes(codes)
+	if err != nil {
+		panic(fmt.Sprintf("wire: invalid built in error codes: %v", err))
+	}
+	return c
+}
+
+// NewErrorCatalogWithCodes returns an ErrorCatalog of codes in place of the built in ErrorCodes. To keep the built
+// in ErrorCodes, pass them first with append(NewErrorCatalog().Codes(), codes...); a later code replaces an
+// earlier one with the same ErrorCategory and ErrorCode.
+func NewErrorCatalogWithCodes(codes []ErrorWireCode) (*ErrorCatalog, error) {
+	c := &ErrorCatalog{codes: make(map[string]ErrorWireCode, len(codes))}
+	for _, code := range codes {
+		if err := code.validate(); err != nil {
+			return nil, err
+		}
+		c.codes[code.Category+code.Code] = code
+	}
+	return c, nil
+}
+
+// ReadErrorWireCodes reads ErrorCodes from r, one per line as the ErrorCategory and ErrorCode, tag, retryable,
+// description and remediation separated by |, such as H024|{1520}|false|INVLD CYCLE DT|Correct the cycle date.
+// Blank lines and lines beginning with # are ignored. This is the format of the built in ErrorCodes.
+func ReadErrorWireCodes(r io.Reader) ([]ErrorWireCode, error) {
+	var codes []ErrorWireCode
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.SplitN(text, "|", 5)
+		if len(fields) != 5 || len(fields[0]) != 4 {
+			return nil, fmt.Errorf("line %d: %v", line, fieldError("Code", ErrInvalidProperty, fields[0]))
+		}
+		retryable, err := strconv.ParseBool(fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, fieldError("Retryable", ErrInvalidProperty, fields[2]))
+		}
+		code := ErrorWireCode{
+			Category:    fields[0][:1],
+			Code:        fields[0][1:],
+			Tag:         fields[1],
+			Retryable:   retryable,
+			Description: fields[3],
+			Remediation: fields[4],
+		}
+		if err := code.validate(); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		codes = append(codes, code)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// validate returns an error if the Category or Tag of code is not known
+func (code ErrorWireCode) validate() error {
+	if _, ok := errorCategories[code.Category]; !ok {
+		return fieldError("Category", ErrInvalidProperty, code.Category)
+	}
+	if code.Tag != "" && !associatedTags(fedWireMessageTagList()).Contains(code.Tag) {
+		return fieldError("Tag", ErrInvalidProperty, code.Tag)
+	}
+	return nil
+}
+
+// Codes returns the ErrorCodes of the catalog in ErrorCategory and ErrorCode order
+func (c *ErrorCatalog) Codes() []ErrorWireCode {
+	codes := make([]ErrorWireCode, 0, len(c.codes))
+	for _, code := range c.codes {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, j int) bool {
+		return codes[i].Category+codes[i].Code < codes[j].Category+codes[j].Code
+	})
+	return codes
+}
+
+// Lookup describes ew by its ErrorCode in the catalog, or otherwise by its ErrorCategory and ErrorDescription. The
+// Tag is the tag in error named by the catalog or within the ErrorDescription of ew, and the remediation of a data
+// error not in the catalog names that tag.
+func (c *ErrorCatalog) Lookup(ew *ErrorWire) ErrorWireCode {
+	code, cataloged := c.codes[ew.ErrorCategory+ew.ErrorCode]
+	if !cataloged {
+		category, ok := errorCategories[ew.ErrorCategory]
+		code = category
+		if !ok {
+			code.Remediation = "Contact the Fedwire Funds Service about the unknown ErrorCategory"
+		}
+		code.Category = ew.ErrorCategory
+		code.Code = ew.ErrorCode
+		if d := strings.TrimSpace(ew.ErrorDescription); d != "" {
+			code.Description = d
+		}
+	}
+	if code.Tag == "" {
+		code.Tag = errorDescriptionTag.FindString(ew.ErrorDescription)
+	}
+	if !cataloged && code.Category == ErrorCategoryData && code.Tag != "" {
+		code.Remediation = fmt.Sprintf("Correct %s %s and send the message again with a new IMAD",
+			fedWireMessageFieldName(code.Tag), code.Tag)
+	}
+	return code
+}
@@ -0,0 +1,101 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewErrorCatalog(t *testing.T) {
+	codes := NewErrorCatalog().Codes()
+	require.NotEmpty(t, codes)
+	for _, code := range codes {
+		require.NoError(t, code.validate())
+		require.Len(t, code.Code, 3)
+		require.NotEmpty(t, code.Description)
+		require.NotEmpty(t, code.Remediation)
+	}
+	for category, code := range errorCategories {
+		require.Equal(t, category, code.Category)
+		require.NotEmpty(t, code.Remediation)
+	}
+}
+
+func TestReadErrorWireCodes(t *testing.T) {
+	codes, err := ReadErrorWireCodes(strings.NewReader(`
+# comment
+E123|{2000}|false|INVLD AMOUNT|Correct the Amount
+F001||true|INSUFFICIENT BALANCE|Fund the account
+`))
+	require.NoError(t, err)
+	require.Equal(t, []ErrorWireCode{
+		{Category: "E", Code: "123", Tag: TagAmount, Description: "INVLD AMOUNT", Remediation: "Correct the Amount"},
+		{Category: "F", Code: "001", Retryable: true, Description: "INSUFFICIENT BALANCE", Remediation: "Fund the account"},
+	}, codes)
+
+	c, err := NewErrorCatalogWithCodes(codes)
+	require.NoError(t, err)
+	ew := mockErrorWire()
+	ew.ErrorCode = "123"
+	code := c.Lookup(ew)
+	require.Equal(t, codes[0], code)
+
+	_, err = ReadErrorWireCodes(strings.NewReader("E12|||x|y"))
+	require.EqualError(t, err, "line 1: "+fieldError("Code", ErrInvalidProperty, "E12").Error())
+	_, err = ReadErrorWireCodes(strings.NewReader("\nE123||maybe|x|y"))
+	require.EqualError(t, err, "line 2: "+fieldError("Retryable", ErrInvalidProperty, "maybe").Error())
+	_, err = ReadErrorWireCodes(strings.NewReader("Z123||false|x|y"))
+	require.EqualError(t, err, "line 1: "+fieldError("Category", ErrInvalidProperty, "Z").Error())
+	_, err = ReadErrorWireCodes(strings.NewReader("E123|{0000}|false|x|y"))
+	require.EqualError(t, err, "line 1: "+fieldError("Tag", ErrInvalidProperty, "{0000}").Error())
+}
+
+// TestNewErrorCatalogWithCodes_extend ensures ErrorCodes read from the Format Reference Guide extend or replace
+// the built in ErrorCodes
+func TestNewErrorCatalogWithCodes_extend(t *testing.T) {
+	codes, err := ReadErrorWireCodes(strings.NewReader(`
+H024|{1520}|false|INVLD CYCLE DT|Correct the cycle date
+EXYZ|{3600}|false|INVLD BUS FUNC CD|Correct the business function code
+`))
+	require.NoError(t, err)
+	builtIn := NewErrorCatalog().Codes()
+	c, err := NewErrorCatalogWithCodes(append(builtIn, codes...))
+	require.NoError(t, err)
+	require.Len(t, c.Codes(), len(builtIn)+1)
+
+	ew := mockErrorWire()
+	code := c.Lookup(ew)
+	require.Equal(t, "INVLD BUS FUNC CD", code.Description)
+	require.Equal(t, TagBusinessFunctionCode, code.Tag)
+
+	ew.ErrorCategory, ew.ErrorCode = ErrorCategoryAccountability, "024"
+	require.Equal(t, "Correct the cycle date", c.Lookup(ew).Remediation)
+}
+
+func TestErrorCatalog_Lookup(t *testing.T) {
+	c := NewErrorCatalog()
+	ew := mockErrorWire()
+	ew.ErrorDescription = "INVLD {3600}"
+
+	code := c.Lookup(ew)
+	require.Equal(t, ErrorCategoryData, code.Category)
+	require.Equal(t, "XYZ", code.Code)
+	require.Equal(t, "INVLD {3600}", code.Description)
+	require.Equal(t, TagBusinessFunctionCode, code.Tag)
+	require.Equal(t, "Correct BusinessFunctionCode {3600} and send the message again with a new IMAD", code.Remediation)
+
+	ew.ErrorDescription = "INVLD"
+	code = c.Lookup(ew)
+	require.Empty(t, code.Tag)
+	require.Equal(t, errorCategories[ErrorCategoryData].Remediation, code.Remediation)
+
+	ew.ErrorCategory = "Q"
+	code = c.Lookup(ew)
+	require.False(t, code.Retryable)
+	require.NotEmpty(t, code.Remediation)
+}
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // ExchangeRate is the ExchangeRate of the wire
@@ -36,13 +39,12 @@ func NewExchangeRate() *ExchangeRate {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (eRate *ExchangeRate) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	eRate.tag = record[:6]
 
-	if delim := strings.IndexByte(record, '*'); delim > 0 {
-		eRate.ExchangeRate = eRate.parseStringField(record[6:delim])
-	} else {
-		eRate.ExchangeRate = eRate.parseStringField(record[6:])
-	}
+	eRate.ExchangeRate = eRate.parseVariableStringField(record[6:])
 
 	return nil
 }
@@ -73,13 +75,19 @@ func (eRate *ExchangeRate) String() string {
 // Validate performs WIRE format rule checks on ExchangeRate and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (eRate *ExchangeRate) Validate() error {
+	return eRate.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on ExchangeRate and returns every error found
+func (eRate *ExchangeRate) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if eRate.tag != TagExchangeRate {
-		return fieldError("tag", ErrValidTagForType, eRate.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, eRate.tag))
 	}
 	if err := eRate.isAmount(eRate.ExchangeRate); err != nil {
-		return fieldError("ExchangeRate", err, eRate.ExchangeRate)
+		errs.Add(fieldError("ExchangeRate", err, eRate.ExchangeRate))
 	}
-	return nil
+	return errs
 }
 
 // ExchangeRateField gets a string of the ExchangeRate field
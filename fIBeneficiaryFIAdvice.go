@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIBeneficiaryFIAdvice is the financial institution beneficiary financial institution
@@ -35,6 +38,9 @@ func NewFIBeneficiaryFIAdvice() *FIBeneficiaryFIAdvice {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (fibfia *FIBeneficiaryFIAdvice) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 9 {
+		return NewTagWrongLengthErr(9, utf8.RuneCountInString(record))
+	}
 	fibfia.tag = record[:6]
 	fibfia.Advice.AdviceCode = fibfia.parseStringField(record[6:9])
 
@@ -92,31 +98,37 @@ func (fibfia *FIBeneficiaryFIAdvice) String() string {
 // Validate performs WIRE format rule checks on FIBeneficiaryFIAdvice and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (fibfia *FIBeneficiaryFIAdvice) Validate() error {
+	return fibfia.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on FIBeneficiaryFIAdvice and returns every error found
+func (fibfia *FIBeneficiaryFIAdvice) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if fibfia.tag != TagFIBeneficiaryFIAdvice {
-		return fieldError("tag", ErrValidTagForType, fibfia.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, fibfia.tag))
 	}
 	if err := fibfia.isAdviceCode(fibfia.Advice.AdviceCode); err != nil {
-		return fieldError("AdviceCode", err, fibfia.Advice.AdviceCode)
+		errs.Add(fieldError("AdviceCode", err, fibfia.Advice.AdviceCode))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineOne); err != nil {
-		return fieldError("LineOne", err, fibfia.Advice.LineOne)
+		errs.Add(fieldError("LineOne", err, fibfia.Advice.LineOne))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineTwo); err != nil {
-		return fieldError("LineTwo", err, fibfia.Advice.LineTwo)
+		errs.Add(fieldError("LineTwo", err, fibfia.Advice.LineTwo))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineThree); err != nil {
-		return fieldError("LineThree", err, fibfia.Advice.LineThree)
+		errs.Add(fieldError("LineThree", err, fibfia.Advice.LineThree))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineFour); err != nil {
-		return fieldError("LineFour", err, fibfia.Advice.LineFour)
+		errs.Add(fieldError("LineFour", err, fibfia.Advice.LineFour))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineFive); err != nil {
-		return fieldError("LineFive", err, fibfia.Advice.LineFive)
+		errs.Add(fieldError("LineFive", err, fibfia.Advice.LineFive))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineSix); err != nil {
-		return fieldError("LineSix", err, fibfia.Advice.LineSix)
+		errs.Add(fieldError("LineSix", err, fibfia.Advice.LineSix))
 	}
-	return nil
+	return errs
 }
 
 // AdviceCodeField gets a string of the AdviceCode field
@@ -50,8 +50,10 @@ func TestFEDWireMessage_previousMessageIdentifierInvalid(t *testing.T) {
 	// Override to trigger error
 	fwm.TypeSubType.SubTypeCode = ReversalTransfer
 	fwm.PreviousMessageIdentifier = nil // required when SubTypeCode is ReversalTransfer
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
 
-	err := fwm.checkPreviousMessageIdentifier()
+	err := fwm.checkMandatoryTags()
 
 	expected := fieldError("PreviousMessageIdentifier", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -64,9 +66,9 @@ func TestFEDWireMessage_invalidLocalInstrument(t *testing.T) {
 	fwm.LocalInstrument = li
 	fwm.BusinessFunctionCode.BusinessFunctionCode = BankTransfer // local instrument only permitted for CTP
 
-	err := fwm.validateLocalInstrumentCode()
+	err := fwm.checkProhibitedTags()
 
-	expected := fieldError("LocalInstrument", ErrLocalInstrumentNotPermitted).Error()
+	expected := fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -79,9 +81,9 @@ func TestFEDWireMessage_invalidCharges(t *testing.T) {
 	fwm.Charges = mockCharges()
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
 
-	err := fwm.validateCharges()
+	err := fwm.checkLocalInstrumentTags()
 
-	expected := NewErrInvalidPropertyForProperty("LocalInstrumentCode", fwm.LocalInstrument.LocalInstrumentCode, "Charges", fwm.Charges.String()).Error()
+	expected := NewErrInvalidPropertyForProperty("Charges", TagCharges, "LocalInstrumentCode", fwm.LocalInstrument.LocalInstrumentCode).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -94,9 +96,9 @@ func TestFEDWireMessage_invalidInstructedAmount(t *testing.T) {
 	fwm.InstructedAmount = mockInstructedAmount()
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
 
-	err := fwm.validateInstructedAmount()
+	err := fwm.checkLocalInstrumentTags()
 
-	expected := NewErrInvalidPropertyForProperty("LocalInstrumentCode", fwm.LocalInstrument.LocalInstrumentCode, "Instructed Amount", fwm.InstructedAmount.String()).Error()
+	expected := NewErrInvalidPropertyForProperty("InstructedAmount", TagInstructedAmount, "LocalInstrumentCode", fwm.LocalInstrument.LocalInstrumentCode).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -119,12 +121,11 @@ func TestFEDWireMessage_isExchangeRateValid_missingLocalInstrumentCode(t *testin
 	li.LocalInstrumentCode = SequenceBCoverPaymentStructured
 	fwm.LocalInstrument = li
 	fwm.ExchangeRate = mockExchangeRate()
-	fwm.InstructedAmount = mockInstructedAmount()
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
 
-	err := fwm.validateExchangeRate()
+	err := fwm.checkLocalInstrumentTags()
 
-	expected := NewErrInvalidPropertyForProperty("LocalInstrumentCode", fwm.LocalInstrument.LocalInstrumentCode, "ExchangeRate", fwm.ExchangeRate.ExchangeRate).Error()
+	expected := NewErrInvalidPropertyForProperty("ExchangeRate", TagExchangeRate, "LocalInstrumentCode", fwm.LocalInstrument.LocalInstrumentCode).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -308,13 +309,43 @@ func TestFEDWireMessage_validateUnstructuredAddenda(t *testing.T) {
 	fwm.UnstructuredAddenda = mockUnstructuredAddenda()
 
 	// UnstructuredAddenda Invalid Property
-	err := fwm.validateUnstructuredAddenda()
+	err := fwm.checkLocalInstrumentTags()
 
-	expected := NewErrInvalidPropertyForProperty("UnstructuredAddenda", fwm.UnstructuredAddenda.String(),
+	expected := NewErrInvalidPropertyForProperty("UnstructuredAddenda", TagUnstructuredAddenda,
 		"LocalInstrumentCode", fwm.LocalInstrument.LocalInstrumentCode).Error()
 	require.EqualError(t, err, expected)
 }
 
+func TestFEDWireMessage_validateUnstructuredAddendaCharacterSet(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	li := NewLocalInstrument()
+	li.LocalInstrumentCode = ANSIX12format
+	fwm.LocalInstrument = li
+	fwm.UnstructuredAddenda = mockUnstructuredAddenda()
+
+	require.NoError(t, fwm.validateUnstructuredAddenda())
+
+	// X12 has no grave accent
+	fwm.UnstructuredAddenda.Addenda = "Unstructured`Addenda"
+
+	err := fwm.validateUnstructuredAddenda()
+
+	expected := fieldError("UnstructuredAddenda.Addenda", NewCharacterSetErr("X12", '`', 12), fwm.UnstructuredAddenda.Addenda).Error()
+	require.EqualError(t, err, expected)
+
+	// SWIFT MX has a grave accent but no control characters
+	li.LocalInstrumentCode = ISO20022XMLformat
+	require.NoError(t, fwm.validateUnstructuredAddenda())
+
+	fwm.UnstructuredAddenda.Addenda = "Unstructured\tAddenda"
+
+	err = fwm.validateUnstructuredAddenda()
+
+	expected = fieldError("UnstructuredAddenda.Addenda", NewCharacterSetErr("SWIFT MX ISO 20022", '\t', 12), fwm.UnstructuredAddenda.Addenda).Error()
+	require.EqualError(t, err, expected)
+}
+
 func TestFEDWireMessage_validateRelatedRemittance(t *testing.T) {
 	fwm := mockCustomerTransferData()
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
@@ -324,9 +355,10 @@ func TestFEDWireMessage_validateRelatedRemittance(t *testing.T) {
 	fwm.RelatedRemittance = mockRelatedRemittance()
 
 	// RelatedRemittance Invalid Property
-	err := fwm.validateRelatedRemittance()
+	err := fwm.checkLocalInstrumentTags()
 
-	expected := fieldError("RelatedRemittance", ErrNotPermitted).Error()
+	expected := NewErrInvalidPropertyForProperty("RelatedRemittance", TagRelatedRemittance, "LocalInstrumentCode",
+		fwm.LocalInstrument.LocalInstrumentCode).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -339,9 +371,10 @@ func TestFEDWireMessage_validateRemittanceOriginator(t *testing.T) {
 	fwm.RemittanceOriginator = mockRemittanceOriginator()
 
 	// RemittanceOriginator Invalid Property
-	err := fwm.validateRemittanceOriginator()
+	err := fwm.checkLocalInstrumentTags()
 
-	expected := fieldError("RemittanceOriginator", ErrNotPermitted).Error()
+	expected := NewErrInvalidPropertyForProperty("RemittanceOriginator", TagRemittanceOriginator, "LocalInstrumentCode",
+		fwm.LocalInstrument.LocalInstrumentCode).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -354,16 +387,20 @@ func TestFEDWireMessage_validateRemittanceBeneficiary(t *testing.T) {
 	fwm.RemittanceBeneficiary = mockRemittanceBeneficiary()
 
 	// RemittanceBeneficiary Invalid Property
-	err := fwm.validateRemittanceBeneficiary()
+	err := fwm.checkLocalInstrumentTags()
 
-	expected := fieldError("RemittanceBeneficiary", ErrNotPermitted).Error()
+	expected := NewErrInvalidPropertyForProperty("RemittanceBeneficiary", TagRemittanceBeneficiary, "LocalInstrumentCode",
+		fwm.LocalInstrument.LocalInstrumentCode).Error()
 	require.EqualError(t, err, expected)
 
 	fwm.RemittanceBeneficiary = nil
 	fwm.LocalInstrument.LocalInstrumentCode = RemittanceInformationStructured
+	fwm.RemittanceOriginator = mockRemittanceOriginator()
+	fwm.PrimaryRemittanceDocument = mockPrimaryRemittanceDocument()
+	fwm.ActualAmountPaid = mockActualAmountPaid()
 
-	// RemittanceBeneficiary Invalid Property
-	err = fwm.validateRemittanceBeneficiary()
+	// RemittanceBeneficiary is mandatory for RemittanceInformationStructured
+	err = fwm.checkMandatoryLocalInstrumentTags()
 
 	expected = fieldError("RemittanceBeneficiary", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -378,9 +415,10 @@ func TestFEDWireMessage_validatePrimaryRemittanceDocument(t *testing.T) {
 	fwm.PrimaryRemittanceDocument = mockPrimaryRemittanceDocument()
 
 	// PrimaryRemittanceDocument Invalid Property
-	err := fwm.validatePrimaryRemittanceDocument()
+	err := fwm.checkLocalInstrumentTags()
 
-	expected := fieldError("PrimaryRemittanceDocument", ErrNotPermitted).Error()
+	expected := NewErrInvalidPropertyForProperty("PrimaryRemittanceDocument", TagPrimaryRemittanceDocument, "LocalInstrumentCode",
+		fwm.LocalInstrument.LocalInstrumentCode).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -393,9 +431,10 @@ func TestFEDWireMessage_validateActualAmountPaid(t *testing.T) {
 	fwm.ActualAmountPaid = mockActualAmountPaid()
 
 	// ActualAmountPaid only permitted for CTP and RMTS
-	err := fwm.validateActualAmountPaid()
+	err := fwm.checkLocalInstrumentTags()
 
-	expected := fieldError("ActualAmountPaid", ErrNotPermitted).Error()
+	expected := NewErrInvalidPropertyForProperty("ActualAmountPaid", TagActualAmountPaid, "LocalInstrumentCode",
+		fwm.LocalInstrument.LocalInstrumentCode).Error()
 	require.EqualError(t, err, expected)
 
 }
@@ -409,9 +448,10 @@ func TestFEDWireMessage_validateGrossAmountRemittanceDocument(t *testing.T) {
 	fwm.GrossAmountRemittanceDocument = mockGrossAmountRemittanceDocument()
 
 	// GrossAmountRemittanceDocument only permitted for CTP and RMTS
-	err := fwm.validateGrossAmountRemittanceDocument()
+	err := fwm.checkLocalInstrumentTags()
 
-	expected := fieldError("GrossAmountRemittanceDocument", ErrNotPermitted).Error()
+	expected := NewErrInvalidPropertyForProperty("GrossAmountRemittanceDocument", TagGrossAmountRemittanceDocument, "LocalInstrumentCode",
+		fwm.LocalInstrument.LocalInstrumentCode).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -424,9 +464,10 @@ func TestFEDWireMessage_validateAdjustment(t *testing.T) {
 	fwm.Adjustment = mockAdjustment()
 
 	// Adjustment Invalid Property
-	err := fwm.validateAdjustment()
+	err := fwm.checkLocalInstrumentTags()
 
-	expected := fieldError("Adjustment", ErrNotPermitted).Error()
+	expected := NewErrInvalidPropertyForProperty("Adjustment", TagAdjustment, "LocalInstrumentCode",
+		fwm.LocalInstrument.LocalInstrumentCode).Error()
 	require.EqualError(t, err, expected)
 
 }
@@ -440,9 +481,10 @@ func TestFEDWireMessage_validateDateRemittanceDocument(t *testing.T) {
 	fwm.DateRemittanceDocument = mockDateRemittanceDocument()
 
 	// DateRemittanceDocument Invalid Property
-	err := fwm.validateDateRemittanceDocument()
+	err := fwm.checkLocalInstrumentTags()
 
-	expected := fieldError("DateRemittanceDocument", ErrNotPermitted).Error()
+	expected := NewErrInvalidPropertyForProperty("DateRemittanceDocument", TagDateRemittanceDocument, "LocalInstrumentCode",
+		fwm.LocalInstrument.LocalInstrumentCode).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -455,9 +497,10 @@ func TestFEDWireMessage_validateSecondaryRemittanceDocument(t *testing.T) {
 	fwm.SecondaryRemittanceDocument = mockSecondaryRemittanceDocument()
 
 	// SecondaryRemittanceDocument Invalid Property
-	err := fwm.validateSecondaryRemittanceDocument()
+	err := fwm.checkLocalInstrumentTags()
 
-	expected := fieldError("SecondaryRemittanceDocument", ErrNotPermitted).Error()
+	expected := NewErrInvalidPropertyForProperty("SecondaryRemittanceDocument", TagSecondaryRemittanceDocument, "LocalInstrumentCode",
+		fwm.LocalInstrument.LocalInstrumentCode).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -470,9 +513,10 @@ func TestFEDWireMessage_isRemittanceFreeTextValid(t *testing.T) {
 	fwm.RemittanceFreeText = mockRemittanceFreeText()
 
 	// RemittanceFreeTextValid Invalid Property
-	err := fwm.validateRemittanceFreeText()
+	err := fwm.checkLocalInstrumentTags()
 
-	expected := fieldError("RemittanceFreeText", ErrNotPermitted).Error()
+	expected := NewErrInvalidPropertyForProperty("RemittanceFreeText", TagRemittanceFreeText, "LocalInstrumentCode",
+		fwm.LocalInstrument.LocalInstrumentCode).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -487,10 +531,10 @@ func TestFEDWireMessage_validateBankTransfer(t *testing.T) {
 	tst.SubTypeCode = RequestCredit
 	fwm.TypeSubType = tst
 
-	err := fwm.validateBankTransfer()
+	err := fwm.checkBusinessFunctionCodeRule()
 
-	expected := NewErrBusinessFunctionCodeProperty("TypeSubType", tst.TypeCode+tst.SubTypeCode,
-		fwm.BusinessFunctionCode.BusinessFunctionCode).Error()
+	expected := fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", tst.TypeCode+tst.SubTypeCode,
+		fwm.BusinessFunctionCode.BusinessFunctionCode)).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -502,7 +546,7 @@ func TestFEDWireMessage_invalidTransTypeCodeBankTransfer(t *testing.T) {
 	bfc.TransactionTypeCode = "COV"
 	fwm.BusinessFunctionCode = bfc
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode,
 		fwm.BusinessFunctionCode.TransactionTypeCode).Error()
@@ -517,7 +561,7 @@ func TestInvalidLocalInstrumentForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.LocalInstrument = mockLocalInstrument()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument).Error()
 	require.EqualError(t, err, expected)
@@ -531,7 +575,7 @@ func TestInvalidChargesForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.Charges = mockCharges()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("Charges", ErrInvalidProperty, fwm.Charges).Error()
 	require.EqualError(t, err, expected)
@@ -545,7 +589,7 @@ func TestInvalidInstructedAmountForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.InstructedAmount = mockInstructedAmount()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount).Error()
 	require.EqualError(t, err, expected)
@@ -559,7 +603,7 @@ func TestInvalidExchangeRateForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.ExchangeRate = mockExchangeRate()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate).Error()
 	require.EqualError(t, err, expected)
@@ -575,7 +619,7 @@ func TestInvalidBeneficiaryIdentificationCodeForBankTransfer(t *testing.T) {
 	ben.Personal.IdentificationCode = SWIFTBICORBEIANDAccountNumber
 	fwm.Beneficiary = ben
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty,
 		fwm.Beneficiary.Personal.IdentificationCode).Error()
@@ -590,7 +634,7 @@ func TestInvalidAccountDebitedDrawdownForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.AccountDebitedDrawdown = mockAccountDebitedDrawdown()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown).Error()
 	require.EqualError(t, err, expected)
@@ -606,7 +650,7 @@ func TestInvalidOriginatorIdentificationCodeForBankTransfer(t *testing.T) {
 	o.Personal.IdentificationCode = SWIFTBICORBEIANDAccountNumber
 	fwm.Originator = o
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty,
 		fwm.Originator.Personal.IdentificationCode).Error()
@@ -621,7 +665,7 @@ func TestInvalidOriginatorOptionFForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.OriginatorOptionF = mockOriginatorOptionF()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF).Error()
 	require.EqualError(t, err, expected)
@@ -635,7 +679,7 @@ func TestInvalidAccountCreditedDrawdownForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.AccountCreditedDrawdown = mockAccountCreditedDrawdown()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown).Error()
 	require.EqualError(t, err, expected)
@@ -649,7 +693,7 @@ func TestInvalidFIDrawdownDebitAccountAdviceForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.FIDrawdownDebitAccountAdvice = mockFIDrawdownDebitAccountAdvice()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("FIDrawdownDebitAccountAdvice", ErrInvalidProperty, fwm.FIDrawdownDebitAccountAdvice).Error()
 	require.EqualError(t, err, expected)
@@ -663,7 +707,7 @@ func TestInvalidServiceMessageForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.ServiceMessage = mockServiceMessage()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("ServiceMessage", ErrInvalidProperty, fwm.ServiceMessage).Error()
 	require.EqualError(t, err, expected)
@@ -677,7 +721,7 @@ func TestInvalidUnstructuredAddendaForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.UnstructuredAddenda = mockUnstructuredAddenda()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("UnstructuredAddenda", ErrInvalidProperty, fwm.UnstructuredAddenda).Error()
 	require.EqualError(t, err, expected)
@@ -691,7 +735,7 @@ func TestInvalidCurrencyInstructedAmountForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.CurrencyInstructedAmount = mockCurrencyInstructedAmount()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("CurrencyInstructedAmount", ErrInvalidProperty, fwm.CurrencyInstructedAmount).Error()
 	require.EqualError(t, err, expected)
@@ -705,7 +749,7 @@ func TestInvalidRelatedRemittanceForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.RelatedRemittance = mockRelatedRemittance()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("RelatedRemittance", ErrInvalidProperty, fwm.RelatedRemittance).Error()
 	require.EqualError(t, err, expected)
@@ -719,7 +763,7 @@ func TestInvalidTransactionTypeCodeForCustomerTransfer(t *testing.T) {
 	bfc.TransactionTypeCode = "COV"
 	fwm.BusinessFunctionCode = bfc
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode,
 		fwm.BusinessFunctionCode.TransactionTypeCode).Error()
@@ -734,7 +778,7 @@ func TestInvalidLocalInstrumentForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.LocalInstrument = mockLocalInstrument()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument).Error()
 	require.EqualError(t, err, expected)
@@ -748,7 +792,7 @@ func TestInvalidPaymentNotificationForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.PaymentNotification = mockPaymentNotification()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification).Error()
 	require.EqualError(t, err, expected)
@@ -762,7 +806,7 @@ func TestInvalidAccountDebitedDrawdownForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.AccountDebitedDrawdown = mockAccountDebitedDrawdown()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown).Error()
 	require.EqualError(t, err, expected)
@@ -776,7 +820,7 @@ func TestInvalidOriginatorOptionFForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.OriginatorOptionF = mockOriginatorOptionF()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF).Error()
 	require.EqualError(t, err, expected)
@@ -790,7 +834,7 @@ func TestInvalidAccountCreditedDrawdownForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.AccountCreditedDrawdown = mockAccountCreditedDrawdown()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown).Error()
 	require.EqualError(t, err, expected)
@@ -804,7 +848,7 @@ func TestInvalidFIDrawdownDebitAccountAdviceForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.FIDrawdownDebitAccountAdvice = mockFIDrawdownDebitAccountAdvice()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("FIDrawdownDebitAccountAdvice", ErrInvalidProperty, fwm.FIDrawdownDebitAccountAdvice).Error()
 	require.EqualError(t, err, expected)
@@ -818,7 +862,7 @@ func TestInvalidServiceMessageForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.ServiceMessage = mockServiceMessage()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("ServiceMessage", ErrInvalidProperty, fwm.ServiceMessage).Error()
 	require.EqualError(t, err, expected)
@@ -832,7 +876,7 @@ func TestInvalidUnstructuredAddendaForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.UnstructuredAddenda = mockUnstructuredAddenda()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("UnstructuredAddenda", ErrInvalidProperty, fwm.UnstructuredAddenda).Error()
 	require.EqualError(t, err, expected)
@@ -846,7 +890,7 @@ func TestInvalidCurrencyInstructedAmountForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.CurrencyInstructedAmount = mockCurrencyInstructedAmount()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("CurrencyInstructedAmount", ErrInvalidProperty, fwm.CurrencyInstructedAmount).Error()
 	require.EqualError(t, err, expected)
@@ -860,7 +904,7 @@ func TestInvalidRelatedRemittanceForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.RelatedRemittance = mockRelatedRemittance()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("RelatedRemittance", ErrInvalidProperty, fwm.RelatedRemittance).Error()
 	require.EqualError(t, err, expected)
@@ -877,7 +921,7 @@ func TestLocalInstrumentUnstructuredAddendaForCustomerTransferPlus(t *testing.T)
 	fwm.Originator = mockOriginator()
 	fwm.LocalInstrument = mockLocalInstrument()
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryLocalInstrumentTags()
 
 	expected := fieldError("UnstructuredAddenda", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -896,7 +940,7 @@ func TestLocalInstrumentRelatedRemittanceForCustomerTransferPlus(t *testing.T) {
 	li.LocalInstrumentCode = RelatedRemittanceInformation
 	fwm.LocalInstrument = li
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryLocalInstrumentTags()
 
 	expected := fieldError("RelatedRemittance", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -915,7 +959,9 @@ func TestLocalInstrumentBeneficiaryReferenceForCustomerTransferPlus(t *testing.T
 	li.LocalInstrumentCode = SequenceBCoverPaymentStructured
 	fwm.LocalInstrument = li
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	fwm.OrderingCustomer = mockOrderingCustomer()
+	fwm.BeneficiaryCustomer = mockBeneficiaryCustomer()
+	err := fwm.checkMandatoryLocalInstrumentTags()
 
 	expected := fieldError("BeneficiaryReference", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -935,7 +981,8 @@ func TestLocalInstrumentOrderingCustomerForCustomerTransferPlus(t *testing.T) {
 	fwm.LocalInstrument = li
 	fwm.BeneficiaryReference = mockBeneficiaryReference()
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	fwm.BeneficiaryCustomer = mockBeneficiaryCustomer()
+	err := fwm.checkMandatoryLocalInstrumentTags()
 
 	expected := fieldError("OrderingCustomer", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -956,7 +1003,7 @@ func TestLocalInstrumentBeneficiaryCustomerForCustomerTransferPlus(t *testing.T)
 	fwm.BeneficiaryReference = mockBeneficiaryReference()
 	fwm.OrderingCustomer = mockOrderingCustomer()
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryLocalInstrumentTags()
 
 	expected := fieldError("BeneficiaryCustomer", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -975,7 +1022,7 @@ func TestLocalInstrumentProprietaryCodeForCustomerTransferPlus(t *testing.T) {
 	li.LocalInstrumentCode = ProprietaryLocalInstrumentCode
 	fwm.LocalInstrument = li
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryLocalInstrumentTags()
 
 	expected := fieldError("ProprietaryCode", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -994,7 +1041,10 @@ func TestLocalInstrumentRemittanceOriginatorForCustomerTransferPlus(t *testing.T
 	li.LocalInstrumentCode = RemittanceInformationStructured
 	fwm.LocalInstrument = li
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	fwm.RemittanceBeneficiary = mockRemittanceBeneficiary()
+	fwm.PrimaryRemittanceDocument = mockPrimaryRemittanceDocument()
+	fwm.ActualAmountPaid = mockActualAmountPaid()
+	err := fwm.checkMandatoryLocalInstrumentTags()
 
 	expected := fieldError("RemittanceOriginator", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -1014,7 +1064,9 @@ func TestLocalInstrumentRemittanceBeneficiaryForCustomerTransferPlus(t *testing.
 	fwm.LocalInstrument = li
 	fwm.RemittanceOriginator = mockRemittanceOriginator()
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	fwm.PrimaryRemittanceDocument = mockPrimaryRemittanceDocument()
+	fwm.ActualAmountPaid = mockActualAmountPaid()
+	err := fwm.checkMandatoryLocalInstrumentTags()
 
 	expected := fieldError("RemittanceBeneficiary", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -1035,7 +1087,8 @@ func TestLocalInstrumentPrimaryRemittanceDocumentForCustomerTransferPlus(t *test
 	fwm.RemittanceOriginator = mockRemittanceOriginator()
 	fwm.RemittanceBeneficiary = mockRemittanceBeneficiary()
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	fwm.ActualAmountPaid = mockActualAmountPaid()
+	err := fwm.checkMandatoryLocalInstrumentTags()
 
 	expected := fieldError("PrimaryRemittanceDocument", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -1057,7 +1110,7 @@ func TestLocalInstrumentActualAmountPaidForCustomerTransferPlus(t *testing.T) {
 	fwm.RemittanceBeneficiary = mockRemittanceBeneficiary()
 	fwm.PrimaryRemittanceDocument = mockPrimaryRemittanceDocument()
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryLocalInstrumentTags()
 
 	expected := fieldError("ActualAmountPaid", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -1070,7 +1123,8 @@ func TestBeneficiaryIdentificationCodeForCustomerTransferPlus(t *testing.T) {
 	bfc.BusinessFunctionCode = CustomerTransferPlus
 	fwm.BusinessFunctionCode = bfc
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	fwm.Originator = mockOriginator()
+	err := fwm.checkMandatoryTags()
 
 	expected := fieldError("Beneficiary", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -1084,7 +1138,7 @@ func TestOriginatorIdentificationCodeForCustomerTransferPlus(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.Beneficiary = mockBeneficiary()
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryTags()
 
 	expected := fieldError("Originator OR OriginatorOptionF", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -1098,7 +1152,7 @@ func TestInvalidAccountDebitedDrawdownForCustomerTransferPlus(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.AccountDebitedDrawdown = mockAccountDebitedDrawdown()
 
-	err := fwm.checkProhibitedCustomerTransferPlusTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown).Error()
 	require.EqualError(t, err, expected)
@@ -1112,7 +1166,7 @@ func TestInvalidAccountCreditedDrawdownForCustomerTransferPlus(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.AccountCreditedDrawdown = mockAccountCreditedDrawdown()
 
-	err := fwm.checkProhibitedCustomerTransferPlusTags()
+	err := fwm.checkProhibitedTags()
 
 	expected := fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown).Error()
 	require.EqualError(t, err, expected)
@@ -0,0 +1,398 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/moov-io/base"
+)
+
+// fedwireOpenHour is the hour, in America/New_York, at which the Fedwire Funds Service opens for the next
+// business day on the evening before it
+const fedwireOpenHour = 21
+
+// maxClosedDays is the most consecutive days NextBusinessDay and PreviousBusinessDay search for a business day.
+// The Fedwire Funds Service has not been closed for more than four consecutive days.
+const maxClosedDays = 10
+
+// Holiday is a Federal Reserve holiday on which the Fedwire Funds Service is closed
+type Holiday struct {
+	// Date is the CCYYMMDD date the holiday is observed
+	Date string `json:"date"`
+	// Name is the name of the holiday
+	Name string `json:"name"`
+}
+
+// FedwireCalendar knows the weekends and holidays on which the Fedwire Funds Service is closed, and so its
+// business days
+type FedwireCalendar struct {
+	holidays map[string]Holiday
+}
+
+// NewFedwireCalendar returns a FedwireCalendar of the Federal Reserve holidays built into this package, which
+// cover 2020 through 2035. Use NewFedwireCalendarWithHolidays for other years or announced changes.
+func NewFedwireCalendar() *FedwireCalendar {
+	holidays, err := ReadHolidays(strings.NewReader(fedwireHolidays))
+	if err != nil {
+		panic(fmt.Sprintf("wire: invalid built in holidays: %v", err))
+	}
+	c, err := NewFedwireCalendarWithHolidays(holidays)
+	if err != nil {
+		panic(fmt.Sprintf("wire: invalid built in holidays: %v", err))
+	}
+	return c
+}
+
+// NewFedwireCalendarWithHolidays returns a FedwireCalendar closed on holidays in place of the built in holidays
+func NewFedwireCalendarWithHolidays(holidays []Holiday) (*FedwireCalendar, error) {
+	c := &FedwireCalendar{holidays: make(map[string]Holiday, len(holidays))}
+	for _, h := range holidays {
+		if err := (&validator{}).validateDate(h.Date); err != nil {
+			return nil, fieldError("Date", err, h.Date)
+		}
+		c.holidays[h.Date] = h
+	}
+	return c, nil
+}
+
+// ReadHolidays reads holidays from r, one per line as a CCYYMMDD date followed by a space and the holiday's name.
+// Blank lines and lines beginning with # are ignored. This is the format of the built in holidays.
+func ReadHolidays(r io.Reader) ([]Holiday, error) {
+	var holidays []Holiday
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.SplitN(text, " ", 2)
+		h := Holiday{Date: fields[0]}
+		if len(fields) == 2 {
+			h.Name = strings.TrimSpace(fields[1])
+		}
+		if err := (&validator{}).validateDate(h.Date); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, fieldError("Date", err, h.Date))
+		}
+		holidays = append(holidays, h)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return holidays, nil
+}
+
+// Holidays returns the holidays of the calendar in date order
+func (c *FedwireCalendar) Holidays() []Holiday {
+	holidays := make([]Holiday, 0, len(c.holidays))
+	for _, h := range c.holidays {
+		holidays = append(holidays, h)
+	}
+	sort.Slice(holidays, func(i, j int) bool {
+		return holidays[i].Date < holidays[j].Date
+	})
+	return holidays
+}
+
+// IsHoliday returns true if the date of t in America/New_York is a holiday
+func (c *FedwireCalendar) IsHoliday(t time.Time) bool {
+	date, err := formatCycleDate(t)
+	if err != nil {
+		return false
+	}
+	_, ok := c.holidays[date]
+	return ok
+}
+
+// IsBusinessDay returns true if the date of t in America/New_York is neither a weekend nor a holiday
+func (c *FedwireCalendar) IsBusinessDay(t time.Time) bool {
+	loc, err := FedwireLocation()
+	if err != nil {
+		return false
+	}
+	switch t.In(loc).Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+	return !c.IsHoliday(t)
+}
+
+// NextBusinessDay returns midnight in America/New_York of the first business day after the date of t, or the zero
+// Time when none of the following 10 days is a business day
+func (c *FedwireCalendar) NextBusinessDay(t time.Time) time.Time {
+	return c.searchBusinessDay(t, 1)
+}
+
+// PreviousBusinessDay returns midnight in America/New_York of the last business day before the date of t, or the
+// zero Time when none of the preceding 10 days is a business day
+func (c *FedwireCalendar) PreviousBusinessDay(t time.Time) time.Time {
+	return c.searchBusinessDay(t, -1)
+}
+
+// searchBusinessDay returns the first business day at most maxClosedDays days from the date of t in the direction
+// of step, or the zero Time without one
+func (c *FedwireCalendar) searchBusinessDay(t time.Time, step int) time.Time {
+	day := startOfDay(t)
+	for i := 0; i < maxClosedDays; i++ {
+		day = day.AddDate(0, 0, step)
+		if c.IsBusinessDay(day) {
+			return day
+		}
+	}
+	return time.Time{}
+}
+
+// CycleDate returns midnight in America/New_York of the Fedwire Funds Service business day current at t. The
+// service opens at 9:00 p.m. on the evening before each business day, so from then the next business day is
+// current. Outside of business days the next business day is current. CycleDate returns the zero Time when the
+// next business day is not found by NextBusinessDay.
+func (c *FedwireCalendar) CycleDate(t time.Time) time.Time {
+	day := startOfDay(t)
+	if t.In(day.Location()).Hour() >= fedwireOpenHour || !c.IsBusinessDay(day) {
+		return c.NextBusinessDay(day)
+	}
+	return day
+}
+
+// ValidateInputCycleDate returns an error unless the InputCycleDate of imad is a business day which is not
+// before the business day current at now
+func (c *FedwireCalendar) ValidateInputCycleDate(imad *InputMessageAccountabilityData, now time.Time) error {
+	cycleDate, err := imad.CycleDate()
+	if err != nil {
+		return err
+	}
+	if !c.IsBusinessDay(cycleDate) {
+		return fieldError("InputCycleDate", ErrNotBusinessDay, imad.InputCycleDate)
+	}
+	if cycleDate.Before(c.CycleDate(now)) {
+		return fieldError("InputCycleDate", ErrCycleDateInPast, imad.InputCycleDate)
+	}
+	return nil
+}
+
+// ValidatePreviousCycleDate returns an error when fwm reverses a prior day transfer, with SubTypeCode
+// RequestReversalPriorDayTransfer or ReversalPriorDayTransfer, unless the cycle date of its
+// PreviousMessageIdentifier, the IMAD of the transfer, is a business day before its own InputCycleDate
+func (c *FedwireCalendar) ValidatePreviousCycleDate(fwm *FEDWireMessage) error {
+	if fwm.TypeSubType == nil || fwm.InputMessageAccountabilityData == nil || fwm.PreviousMessageIdentifier == nil {
+		return nil
+	}
+	switch fwm.TypeSubType.SubTypeCode {
+	case RequestReversalPriorDayTransfer, ReversalPriorDayTransfer:
+	default:
+		return nil
+	}
+	cycleDate, err := fwm.InputMessageAccountabilityData.CycleDate()
+	if err != nil {
+		return err
+	}
+	pmi := fwm.PreviousMessageIdentifier.PreviousMessageIdentifier
+	var previous time.Time
+	if len(pmi) >= 8 {
+		previous, err = parseCycleDate(pmi[:8])
+	}
+	if len(pmi) < 8 || err != nil || !c.IsBusinessDay(previous) {
+		return fieldError("PreviousMessageIdentifier", ErrNotBusinessDay, pmi)
+	}
+	if !previous.Before(cycleDate) {
+		return fieldError("PreviousMessageIdentifier", ErrPreviousCycleDate, pmi)
+	}
+	return nil
+}
+
+// checkCalendar returns the errors of ValidateInputCycleDate and ValidatePreviousCycleDate for fwm at now
+func (c *FedwireCalendar) checkCalendar(fwm *FEDWireMessage, now time.Time) base.ErrorList {
+	var errs base.ErrorList
+	if fwm.InputMessageAccountabilityData != nil {
+		if err := c.ValidateInputCycleDate(fwm.InputMessageAccountabilityData, now); err != nil {
+			errs.Add(err)
+		}
+	}
+	if err := c.ValidatePreviousCycleDate(fwm); err != nil {
+		errs.Add(err)
+	}
+	return errs
+}
+
+// startOfDay returns midnight in America/New_York of the date of t
+func startOfDay(t time.Time) time.Time {
+	loc, err := FedwireLocation()
+	if err != nil {
+		loc = time.UTC
+	}
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// fedwireHolidays are the Federal Reserve holidays in the format read by ReadHolidays. A holiday falling on a
+// Sunday is observed the following Monday, and one falling on a Saturday is not observed.
+const fedwireHolidays = `# Federal Reserve holidays observed by the Fedwire Funds Service
+20200101 New Year's Day
+20200120 Birthday of Martin Luther King, Jr.
+20200217 Washington's Birthday
+20200525 Memorial Day
+20200907 Labor Day
+20201012 Columbus Day
+20201111 Veterans Day
+20201126 Thanksgiving Day
+20201225 Christmas Day
+20210101 New Year's Day
+20210118 Birthday of Martin Luther King, Jr.
+20210215 Washington's Birthday
+20210531 Memorial Day
+20210705 Independence Day
+20210906 Labor Day
+20211011 Columbus Day
+20211111 Veterans Day
+20211125 Thanksgiving Day
+20220117 Birthday of Martin Luther King, Jr.
+20220221 Washington's Birthday
+20220530 Memorial Day
+20220620 Juneteenth National Independence Day
+20220704 Independence Day
+20220905 Labor Day
+20221010 Columbus Day
+20221111 Veterans Day
+20221124 Thanksgiving Day
+20221226 Christmas Day
+20230102 New Year's Day
+20230116 Birthday of Martin Luther King, Jr.
+20230220 Washington's Birthday
+20230529 Memorial Day
+20230619 Juneteenth National Independence Day
+20230704 Independence Day
+20230904 Labor Day
+20231009 Columbus Day
+20231123 Thanksgiving Day
+20231225 Christmas Day
+20240101 New Year's Day
+20240115 Birthday of Martin Luther King, Jr.
+20240219 Washington's Birthday
+20240527 Memorial Day
+20240619 Juneteenth National Independence Day
+20240704 Independence Day
+20240902 Labor Day
+20241014 Columbus Day
+20241111 Veterans Day
+20241128 Thanksgiving Day
+20241225 Christmas Day
+20250101 New Year's Day
+20250120 Birthday of Martin Luther King, Jr.
+20250217 Washington's Birthday
+20250526 Memorial Day
+20250619 Juneteenth National Independence Day
+20250704 Independence Day
+20250901 Labor Day
+20251013 Columbus Day
+20251111 Veterans Day
+20251127 Thanksgiving Day
+20251225 Christmas Day
+20260101 New Year's Day
+20260119 Birthday of Martin Luther King, Jr.
+20260216 Washington's Birthday
+20260525 Memorial Day
+20260619 Juneteenth National Independence Day
+20260907 Labor Day
+20261012 Columbus Day
+20261111 Veterans Day
+20261126 Thanksgiving Day
+20261225 Christmas Day
+20270101 New Year's Day
+20270118 Birthday of Martin Luther King, Jr.
+20270215 Washington's Birthday
+20270531 Memorial Day
+20270705 Independence Day
+20270906 Labor Day
+20271011 Columbus Day
+20271111 Veterans Day
+20271125 Thanksgiving Day
+20280117 Birthday of Martin Luther King, Jr.
+20280221 Washington's Birthday
+20280529 Memorial Day
+20280619 Juneteenth National Independence Day
+20280704 Independence Day
+20280904 Labor Day
+20281009 Columbus Day
+20281123 Thanksgiving Day
+20281225 Christmas Day
+20290101 New Year's Day
+20290115 Birthday of Martin Luther King, Jr.
+20290219 Washington's Birthday
+20290528 Memorial Day
+20290619 Juneteenth National Independence Day
+20290704 Independence Day
+20290903 Labor Day
+20291008 Columbus Day
+20291112 Veterans Day
+20291122 Thanksgiving Day
+20291225 Christmas Day
+20300101 New Year's Day
+20300121 Birthday of Martin Luther King, Jr.
+20300218 Washington's Birthday
+20300527 Memorial Day
+20300619 Juneteenth National Independence Day
+20300704 Independence Day
+20300902 Labor Day
+20301014 Columbus Day
+20301111 Veterans Day
+20301128 Thanksgiving Day
+20301225 Christmas Day
+20310101 New Year's Day
+20310120 Birthday of Martin Luther King, Jr.
+20310217 Washington's Birthday
+20310526 Memorial Day
+20310619 Juneteenth National Independence Day
+20310704 Independence Day
+20310901 Labor Day
+20311013 Columbus Day
+20311111 Veterans Day
+20311127 Thanksgiving Day
+20311225 Christmas Day
+20320101 New Year's Day
+20320119 Birthday of Martin Luther King, Jr.
+20320216 Washington's Birthday
+20320531 Memorial Day
+20320705 Independence Day
+20320906 Labor Day
+20321011 Columbus Day
+20321111 Veterans Day
+20321125 Thanksgiving Day
+20330117 Birthday of Martin Luther King, Jr.
+20330221 Washington's Birthday
+20330530 Memorial Day
+20330620 Juneteenth National Independence Day
+20330704 Independence Day
+20330905 Labor Day
+20331010 Columbus Day
+20331111 Veterans Day
+20331124 Thanksgiving Day
+20331226 Christmas Day
+20340102 New Year's Day
+20340116 Birthday of Martin Luther King, Jr.
+20340220 Washington's Birthday
+20340529 Memorial Day
+20340619 Juneteenth National Independence Day
+20340704 Independence Day
+20340904 Labor Day
+20341009 Columbus Day
+20341123 Thanksgiving Day
+20341225 Christmas Day
+20350101 New Year's Day
+20350115 Birthday of Martin Luther King, Jr.
+20350219 Washington's Birthday
+20350528 Memorial Day
+20350619 Juneteenth National Independence Day
+20350704 Independence Day
+20350903 Labor Day
+20351008 Columbus Day
+20351112 Veterans Day
+20351122 Thanksgiving Day
+20351225 Christmas Day
+`
@@ -0,0 +1,174 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+// newYork returns the time in America/New_York
+func newYork(t *testing.T, year int, month time.Month, day, hour, min int) time.Time {
+	loc, err := FedwireLocation()
+	require.NoError(t, err)
+	return time.Date(year, month, day, hour, min, 0, 0, loc)
+}
+
+func TestFedwireCalendar_Holidays(t *testing.T) {
+	c := NewFedwireCalendar()
+
+	holidays := c.Holidays()
+	require.Equal(t, Holiday{Date: "20200101", Name: "New Year's Day"}, holidays[0])
+	for i := 1; i < len(holidays); i++ {
+		require.True(t, holidays[i-1].Date < holidays[i].Date)
+	}
+
+	// Juneteenth on a Sunday is observed on Monday
+	require.True(t, c.IsHoliday(newYork(t, 2022, time.June, 20, 12, 0)))
+	// Independence Day on a Saturday is not observed on Friday
+	require.False(t, c.IsHoliday(newYork(t, 2020, time.July, 3, 12, 0)))
+	require.True(t, c.IsBusinessDay(newYork(t, 2020, time.July, 3, 12, 0)))
+	// Thanksgiving
+	require.False(t, c.IsBusinessDay(newYork(t, 2023, time.November, 23, 12, 0)))
+	// weekends
+	require.False(t, c.IsBusinessDay(newYork(t, 2023, time.November, 25, 12, 0)))
+	require.False(t, c.IsBusinessDay(newYork(t, 2023, time.November, 26, 12, 0)))
+	// 01:00 UTC on Monday is still Sunday in New York
+	require.False(t, c.IsBusinessDay(time.Date(2023, time.November, 27, 1, 0, 0, 0, time.UTC)))
+}
+
+func TestFedwireCalendar_BusinessDays(t *testing.T) {
+	c := NewFedwireCalendar()
+
+	require.Equal(t, newYork(t, 2023, time.November, 24, 0, 0), c.NextBusinessDay(newYork(t, 2023, time.November, 22, 23, 0)))
+	require.Equal(t, newYork(t, 2023, time.November, 22, 0, 0), c.PreviousBusinessDay(newYork(t, 2023, time.November, 24, 8, 0)))
+	require.Equal(t, newYork(t, 2024, time.January, 2, 0, 0), c.NextBusinessDay(newYork(t, 2023, time.December, 29, 12, 0)))
+}
+
+func TestFedwireCalendar_CycleDate(t *testing.T) {
+	c := NewFedwireCalendar()
+
+	cases := []struct {
+		now, expected time.Time
+	}{
+		// Wednesday during the day
+		{newYork(t, 2023, time.November, 8, 10, 0), newYork(t, 2023, time.November, 8, 0, 0)},
+		// Wednesday after the close and before the 9:00 p.m. open
+		{newYork(t, 2023, time.November, 8, 20, 59), newYork(t, 2023, time.November, 8, 0, 0)},
+		// Wednesday from the 9:00 p.m. open, ahead of Thanksgiving
+		{newYork(t, 2023, time.November, 22, 21, 0), newYork(t, 2023, time.November, 24, 0, 0)},
+		// Friday evening opens for Monday
+		{newYork(t, 2023, time.November, 24, 21, 30), newYork(t, 2023, time.November, 27, 0, 0)},
+		// Saturday
+		{newYork(t, 2023, time.November, 25, 9, 0), newYork(t, 2023, time.November, 27, 0, 0)},
+		// Sunday evening opens for Monday
+		{newYork(t, 2023, time.November, 26, 21, 0), newYork(t, 2023, time.November, 27, 0, 0)},
+		// New Year's Eve on a Sunday, with New Year's Day observed on Monday
+		{newYork(t, 2023, time.December, 31, 22, 0), newYork(t, 2024, time.January, 2, 0, 0)},
+	}
+	for _, tc := range cases {
+		require.Equal(t, tc.expected, c.CycleDate(tc.now), tc.now.String())
+	}
+}
+
+func TestFedwireCalendar_ValidateInputCycleDate(t *testing.T) {
+	c := NewFedwireCalendar()
+	now := newYork(t, 2023, time.November, 22, 21, 15)
+	imad := mockInputMessageAccountabilityData()
+
+	imad.InputCycleDate = "20231124"
+	require.NoError(t, c.ValidateInputCycleDate(imad, now))
+
+	imad.InputCycleDate = "20231123"
+	require.EqualError(t, c.ValidateInputCycleDate(imad, now),
+		fieldError("InputCycleDate", ErrNotBusinessDay, imad.InputCycleDate).Error())
+
+	imad.InputCycleDate = "20231122"
+	require.EqualError(t, c.ValidateInputCycleDate(imad, now),
+		fieldError("InputCycleDate", ErrCycleDateInPast, imad.InputCycleDate).Error())
+
+	imad.InputCycleDate = "20231131"
+	require.EqualError(t, c.ValidateInputCycleDate(imad, now),
+		fieldError("InputCycleDate", ErrValidDate, imad.InputCycleDate).Error())
+}
+
+func TestFedwireCalendar_ValidatePreviousCycleDate(t *testing.T) {
+	c := NewFedwireCalendar()
+	fwm := mockCustomerTransferData()
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20231124"
+	fwm.TypeSubType.SubTypeCode = ReversalPriorDayTransfer
+	fwm.PreviousMessageIdentifier = mockPreviousMessageIdentifier()
+
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = "20231122Source08000001"
+	require.NoError(t, c.ValidatePreviousCycleDate(&fwm))
+
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = "20231124Source08000001"
+	require.EqualError(t, c.ValidatePreviousCycleDate(&fwm),
+		fieldError("PreviousMessageIdentifier", ErrPreviousCycleDate, "20231124Source08000001").Error())
+
+	fwm.TypeSubType.SubTypeCode = RequestReversalPriorDayTransfer
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = "20231123Source08000001"
+	require.EqualError(t, c.ValidatePreviousCycleDate(&fwm),
+		fieldError("PreviousMessageIdentifier", ErrNotBusinessDay, "20231123Source08000001").Error())
+
+	// same day reversals are not checked
+	fwm.TypeSubType.SubTypeCode = ReversalTransfer
+	require.NoError(t, c.ValidatePreviousCycleDate(&fwm))
+}
+
+// TestFedwireCalendar_NoBusinessDay ensures the search for a business day stops when the calendar has none
+func TestFedwireCalendar_NoBusinessDay(t *testing.T) {
+	var holidays []Holiday
+	day := newYork(t, 2023, time.November, 1, 0, 0)
+	for i := 0; i < 30; i++ {
+		holidays = append(holidays, Holiday{Date: day.AddDate(0, 0, i).Format(cycleDateLayout)})
+	}
+	c, err := NewFedwireCalendarWithHolidays(holidays)
+	require.NoError(t, err)
+
+	require.True(t, c.NextBusinessDay(newYork(t, 2023, time.November, 10, 12, 0)).IsZero())
+	require.True(t, c.PreviousBusinessDay(newYork(t, 2023, time.November, 20, 12, 0)).IsZero())
+	require.True(t, c.CycleDate(newYork(t, 2023, time.November, 10, 12, 0)).IsZero())
+	require.Equal(t, newYork(t, 2023, time.December, 1, 0, 0), c.NextBusinessDay(newYork(t, 2023, time.November, 25, 12, 0)))
+}
+
+func TestFedwireCalendar_WithHolidays(t *testing.T) {
+	holidays, err := ReadHolidays(strings.NewReader("# closures\n\n20231110 Veterans Day (observed)\n20231122\n"))
+	require.NoError(t, err)
+	require.Equal(t, []Holiday{{Date: "20231110", Name: "Veterans Day (observed)"}, {Date: "20231122"}}, holidays)
+
+	c, err := NewFedwireCalendarWithHolidays(holidays)
+	require.NoError(t, err)
+	require.False(t, c.IsBusinessDay(newYork(t, 2023, time.November, 10, 12, 0)))
+	require.False(t, c.IsBusinessDay(newYork(t, 2023, time.November, 22, 12, 0)))
+	require.True(t, c.IsBusinessDay(newYork(t, 2023, time.November, 23, 12, 0)))
+
+	_, err = ReadHolidays(strings.NewReader("20231110 Veterans Day\n20230229 Leap Day\n"))
+	require.EqualError(t, err, "line 2: "+fieldError("Date", ErrValidDate, "20230229").Error())
+
+	_, err = NewFedwireCalendarWithHolidays([]Holiday{{Date: "2023-11-10"}})
+	require.EqualError(t, err, fieldError("Date", ErrValidDate, "2023-11-10").Error())
+}
+
+func TestFEDWireMessage_ValidateWithCalendar(t *testing.T) {
+	c := NewFedwireCalendar()
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.TransactionTypeCode = ""
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	require.NoError(t, fwm.InputMessageAccountabilityData.SetCycleDate(c.NextBusinessDay(time.Now())))
+	require.NoError(t, fwm.ValidateWith(&ValidateOpts{Calendar: c}))
+
+	require.NoError(t, fwm.InputMessageAccountabilityData.SetCycleDate(c.PreviousBusinessDay(time.Now())))
+	opts := &ValidateOpts{Calendar: c, CollectAll: true}
+	err := fwm.ValidateWith(opts)
+	require.IsType(t, base.ErrorList{}, err)
+	require.Equal(t, &FieldError{FieldName: "InputMessageAccountabilityData.InputCycleDate", Tag: TagInputMessageAccountabilityData,
+		Value: fwm.InputMessageAccountabilityData.InputCycleDate, Err: ErrCycleDateInPast}, err.(base.ErrorList)[0])
+}
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIAdditionalFIToFI is the financial institution beneficiary financial institution
@@ -35,6 +38,9 @@ func NewFIAdditionalFIToFI() *FIAdditionalFIToFI {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (fifi *FIAdditionalFIToFI) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	fifi.tag = record[:6]
 
 	optionalFields := strings.Split(record[6:], "*")
@@ -90,28 +96,34 @@ func (fifi *FIAdditionalFIToFI) String() string {
 // Validate performs WIRE format rule checks on FIAdditionalFIToFI and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (fifi *FIAdditionalFIToFI) Validate() error {
+	return fifi.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on FIAdditionalFIToFI and returns every error found
+func (fifi *FIAdditionalFIToFI) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if fifi.tag != TagFIAdditionalFIToFI {
-		return fieldError("tag", ErrValidTagForType, fifi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, fifi.tag))
 	}
 	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineOne); err != nil {
-		return fieldError("LineOne", err, fifi.AdditionalFIToFI.LineOne)
+		errs.Add(fieldError("LineOne", err, fifi.AdditionalFIToFI.LineOne))
 	}
 	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineTwo); err != nil {
-		return fieldError("LineTwo", err, fifi.AdditionalFIToFI.LineTwo)
+		errs.Add(fieldError("LineTwo", err, fifi.AdditionalFIToFI.LineTwo))
 	}
 	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineThree); err != nil {
-		return fieldError("LineThree", err, fifi.AdditionalFIToFI.LineThree)
+		errs.Add(fieldError("LineThree", err, fifi.AdditionalFIToFI.LineThree))
 	}
 	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineFour); err != nil {
-		return fieldError("LineFour", err, fifi.AdditionalFIToFI.LineFour)
+		errs.Add(fieldError("LineFour", err, fifi.AdditionalFIToFI.LineFour))
 	}
 	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineFive); err != nil {
-		return fieldError("LineFive", err, fifi.AdditionalFIToFI.LineFive)
+		errs.Add(fieldError("LineFive", err, fifi.AdditionalFIToFI.LineFive))
 	}
 	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineSix); err != nil {
-		return fieldError("LineSix", err, fifi.AdditionalFIToFI.LineSix)
+		errs.Add(fieldError("LineSix", err, fifi.AdditionalFIToFI.LineSix))
 	}
-	return nil
+	return errs
 }
 
 // LineOneField gets a string of the LineOne field
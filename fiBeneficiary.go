@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIBeneficiary is the financial institution beneficiary
@@ -35,6 +38,9 @@ func NewFIBeneficiary() *FIBeneficiary {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (fib *FIBeneficiary) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	fib.tag = record[:6]
 
 	optionalFields := strings.Split(record[6:], "*")
@@ -90,28 +96,34 @@ func (fib *FIBeneficiary) String() string {
 // Validate performs WIRE format rule checks on FIBeneficiary and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (fib *FIBeneficiary) Validate() error {
+	return fib.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on FIBeneficiary and returns every error found
+func (fib *FIBeneficiary) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if fib.tag != TagFIBeneficiary {
-		return fieldError("tag", ErrValidTagForType, fib.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, fib.tag))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineOne); err != nil {
-		return fieldError("LineOne", err, fib.FIToFI.LineOne)
+		errs.Add(fieldError("LineOne", err, fib.FIToFI.LineOne))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineTwo); err != nil {
-		return fieldError("LineTwo", err, fib.FIToFI.LineTwo)
+		errs.Add(fieldError("LineTwo", err, fib.FIToFI.LineTwo))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineThree); err != nil {
-		return fieldError("LineThree", err, fib.FIToFI.LineThree)
+		errs.Add(fieldError("LineThree", err, fib.FIToFI.LineThree))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineFour); err != nil {
-		return fieldError("LineFour", err, fib.FIToFI.LineFour)
+		errs.Add(fieldError("LineFour", err, fib.FIToFI.LineFour))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineFive); err != nil {
-		return fieldError("LineFive", err, fib.FIToFI.LineFive)
+		errs.Add(fieldError("LineFive", err, fib.FIToFI.LineFive))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineSix); err != nil {
-		return fieldError("LineSix", err, fib.FIToFI.LineSix)
+		errs.Add(fieldError("LineSix", err, fib.FIToFI.LineSix))
 	}
-	return nil
+	return errs
 }
 
 // LineOneField gets a string of the LineOne field
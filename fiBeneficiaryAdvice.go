@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIBeneficiaryAdvice is the financial institution beneficiary advice
@@ -35,6 +38,9 @@ func NewFIBeneficiaryAdvice() *FIBeneficiaryAdvice {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (fiba *FIBeneficiaryAdvice) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 9 {
+		return NewTagWrongLengthErr(9, utf8.RuneCountInString(record))
+	}
 	fiba.tag = record[:6]
 	fiba.Advice.AdviceCode = fiba.parseStringField(record[6:9])
 
@@ -92,31 +98,37 @@ func (fiba *FIBeneficiaryAdvice) String() string {
 // Validate performs WIRE format rule checks on FIBeneficiaryAdvice and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (fiba *FIBeneficiaryAdvice) Validate() error {
+	return fiba.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on FIBeneficiaryAdvice and returns every error found
+func (fiba *FIBeneficiaryAdvice) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if fiba.tag != TagFIBeneficiaryAdvice {
-		return fieldError("tag", ErrValidTagForType, fiba.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, fiba.tag))
 	}
 	if err := fiba.isAdviceCode(fiba.Advice.AdviceCode); err != nil {
-		return fieldError("AdviceCode", err, fiba.Advice.AdviceCode)
+		errs.Add(fieldError("AdviceCode", err, fiba.Advice.AdviceCode))
 	}
 	if err := fiba.isAlphanumeric(fiba.Advice.LineOne); err != nil {
-		return fieldError("LineOne", err, fiba.Advice.LineOne)
+		errs.Add(fieldError("LineOne", err, fiba.Advice.LineOne))
 	}
 	if err := fiba.isAlphanumeric(fiba.Advice.LineTwo); err != nil {
-		return fieldError("LineTwo", err, fiba.Advice.LineTwo)
+		errs.Add(fieldError("LineTwo", err, fiba.Advice.LineTwo))
 	}
 	if err := fiba.isAlphanumeric(fiba.Advice.LineThree); err != nil {
-		return fieldError("LineThree", err, fiba.Advice.LineThree)
+		errs.Add(fieldError("LineThree", err, fiba.Advice.LineThree))
 	}
 	if err := fiba.isAlphanumeric(fiba.Advice.LineFour); err != nil {
-		return fieldError("LineFour", err, fiba.Advice.LineFour)
+		errs.Add(fieldError("LineFour", err, fiba.Advice.LineFour))
 	}
 	if err := fiba.isAlphanumeric(fiba.Advice.LineFive); err != nil {
-		return fieldError("LineFive", err, fiba.Advice.LineFive)
+		errs.Add(fieldError("LineFive", err, fiba.Advice.LineFive))
 	}
 	if err := fiba.isAlphanumeric(fiba.Advice.LineSix); err != nil {
-		return fieldError("LineSix", err, fiba.Advice.LineSix)
+		errs.Add(fieldError("LineSix", err, fiba.Advice.LineSix))
 	}
-	return nil
+	return errs
 }
 
 // AdviceCodeField gets a string of the AdviceCode field
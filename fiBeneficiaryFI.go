@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIBeneficiaryFI is the financial institution beneficiary financial institution
@@ -35,6 +38,9 @@ func NewFIBeneficiaryFI() *FIBeneficiaryFI {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (fibfi *FIBeneficiaryFI) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	fibfi.tag = record[:6]
 
 	optionalFields := strings.Split(record[6:], "*")
@@ -90,28 +96,34 @@ func (fibfi *FIBeneficiaryFI) String() string {
 // Validate performs WIRE format rule checks on FIBeneficiaryFI and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (fibfi *FIBeneficiaryFI) Validate() error {
+	return fibfi.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on FIBeneficiaryFI and returns every error found
+func (fibfi *FIBeneficiaryFI) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if fibfi.tag != TagFIBeneficiaryFI {
-		return fieldError("tag", ErrValidTagForType, fibfi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, fibfi.tag))
 	}
 	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineOne); err != nil {
-		return fieldError("LineOne", err, fibfi.FIToFI.LineOne)
+		errs.Add(fieldError("LineOne", err, fibfi.FIToFI.LineOne))
 	}
 	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineTwo); err != nil {
-		return fieldError("LineTwo", err, fibfi.FIToFI.LineTwo)
+		errs.Add(fieldError("LineTwo", err, fibfi.FIToFI.LineTwo))
 	}
 	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineThree); err != nil {
-		return fieldError("LineThree", err, fibfi.FIToFI.LineThree)
+		errs.Add(fieldError("LineThree", err, fibfi.FIToFI.LineThree))
 	}
 	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineFour); err != nil {
-		return fieldError("LineFour", err, fibfi.FIToFI.LineFour)
+		errs.Add(fieldError("LineFour", err, fibfi.FIToFI.LineFour))
 	}
 	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineFive); err != nil {
-		return fieldError("LineFive", err, fibfi.FIToFI.LineFive)
+		errs.Add(fieldError("LineFive", err, fibfi.FIToFI.LineFive))
 	}
 	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineSix); err != nil {
-		return fieldError("LineSix", err, fibfi.FIToFI.LineSix)
+		errs.Add(fieldError("LineSix", err, fibfi.FIToFI.LineSix))
 	}
-	return nil
+	return errs
 }
 
 // LineOneField gets a string of the LineOne field
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIIntermediaryFI is the financial institution intermediary financial institution
@@ -35,6 +38,9 @@ func NewFIIntermediaryFI() *FIIntermediaryFI {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (fiifi *FIIntermediaryFI) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	fiifi.tag = record[:6]
 
 	optionalFields := strings.Split(record[6:], "*")
@@ -90,28 +96,34 @@ func (fiifi *FIIntermediaryFI) String() string {
 // Validate performs WIRE format rule checks on FIIntermediaryFI and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (fiifi *FIIntermediaryFI) Validate() error {
+	return fiifi.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on FIIntermediaryFI and returns every error found
+func (fiifi *FIIntermediaryFI) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if fiifi.tag != TagFIIntermediaryFI {
-		return fieldError("tag", ErrValidTagForType, fiifi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, fiifi.tag))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineOne); err != nil {
-		return fieldError("LineOne", err, fiifi.FIToFI.LineOne)
+		errs.Add(fieldError("LineOne", err, fiifi.FIToFI.LineOne))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineTwo); err != nil {
-		return fieldError("LineTwo", err, fiifi.FIToFI.LineTwo)
+		errs.Add(fieldError("LineTwo", err, fiifi.FIToFI.LineTwo))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineThree); err != nil {
-		return fieldError("LineThree", err, fiifi.FIToFI.LineThree)
+		errs.Add(fieldError("LineThree", err, fiifi.FIToFI.LineThree))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineFour); err != nil {
-		return fieldError("LineFour", err, fiifi.FIToFI.LineFour)
+		errs.Add(fieldError("LineFour", err, fiifi.FIToFI.LineFour))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineFive); err != nil {
-		return fieldError("LineFive", err, fiifi.FIToFI.LineFive)
+		errs.Add(fieldError("LineFive", err, fiifi.FIToFI.LineFive))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineSix); err != nil {
-		return fieldError("LineSix", err, fiifi.FIToFI.LineSix)
+		errs.Add(fieldError("LineSix", err, fiifi.FIToFI.LineSix))
 	}
-	return nil
+	return errs
 }
 
 // LineOneField gets a string of the LineOne field
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIIntermediaryFIAdvice is the financial institution intermediary financial institution
@@ -35,6 +38,9 @@ func NewFIIntermediaryFIAdvice() *FIIntermediaryFIAdvice {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (fiifia *FIIntermediaryFIAdvice) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 9 {
+		return NewTagWrongLengthErr(9, utf8.RuneCountInString(record))
+	}
 	fiifia.tag = record[:6]
 	fiifia.Advice.AdviceCode = fiifia.parseStringField(record[6:9])
 
@@ -90,31 +96,37 @@ func (fiifia *FIIntermediaryFIAdvice) String() string {
 // Validate performs WIRE format rule checks on FIIntermediaryFIAdvice and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (fiifia *FIIntermediaryFIAdvice) Validate() error {
+	return fiifia.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on FIIntermediaryFIAdvice and returns every error found
+func (fiifia *FIIntermediaryFIAdvice) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if fiifia.tag != TagFIIntermediaryFIAdvice {
-		return fieldError("tag", ErrValidTagForType, fiifia.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, fiifia.tag))
 	}
 	if err := fiifia.isAdviceCode(fiifia.Advice.AdviceCode); err != nil {
-		return fieldError("AdviceCode", err, fiifia.Advice.AdviceCode)
+		errs.Add(fieldError("AdviceCode", err, fiifia.Advice.AdviceCode))
 	}
 	if err := fiifia.isAlphanumeric(fiifia.Advice.LineOne); err != nil {
-		return fieldError("LineOne", err, fiifia.Advice.LineOne)
+		errs.Add(fieldError("LineOne", err, fiifia.Advice.LineOne))
 	}
 	if err := fiifia.isAlphanumeric(fiifia.Advice.LineTwo); err != nil {
-		return fieldError("LineTwo", err, fiifia.Advice.LineTwo)
+		errs.Add(fieldError("LineTwo", err, fiifia.Advice.LineTwo))
 	}
 	if err := fiifia.isAlphanumeric(fiifia.Advice.LineThree); err != nil {
-		return fieldError("LineThree", err, fiifia.Advice.LineThree)
+		errs.Add(fieldError("LineThree", err, fiifia.Advice.LineThree))
 	}
 	if err := fiifia.isAlphanumeric(fiifia.Advice.LineFour); err != nil {
-		return fieldError("LineFour", err, fiifia.Advice.LineFour)
+		errs.Add(fieldError("LineFour", err, fiifia.Advice.LineFour))
 	}
 	if err := fiifia.isAlphanumeric(fiifia.Advice.LineFive); err != nil {
-		return fieldError("LineFive", err, fiifia.Advice.LineFive)
+		errs.Add(fieldError("LineFive", err, fiifia.Advice.LineFive))
 	}
 	if err := fiifia.isAlphanumeric(fiifia.Advice.LineSix); err != nil {
-		return fieldError("LineSix", err, fiifia.Advice.LineSix)
+		errs.Add(fieldError("LineSix", err, fiifia.Advice.LineSix))
 	}
-	return nil
+	return errs
 }
 
 // AdviceCodeField gets a string of the AdviceCode field
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIPaymentMethodToBeneficiary is the financial institution payment method to beneficiary
@@ -38,14 +41,13 @@ func NewFIPaymentMethodToBeneficiary() *FIPaymentMethodToBeneficiary {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (pm *FIPaymentMethodToBeneficiary) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 11 {
+		return NewTagWrongLengthErr(11, utf8.RuneCountInString(record))
+	}
 	pm.tag = record[:6]
 	pm.PaymentMethod = pm.parseStringField(record[6:11])
 
-	if delim := strings.IndexByte(record, '*'); delim > 0 {
-		pm.AdditionalInformation = pm.parseStringField(record[11:delim])
-	} else {
-		pm.AdditionalInformation = pm.parseStringField(record[11:])
-	}
+	pm.AdditionalInformation = pm.parseVariableStringField(record[11:])
 
 	return nil
 }
@@ -77,25 +79,29 @@ func (pm *FIPaymentMethodToBeneficiary) String() string {
 // Validate performs WIRE format rule checks on FIPaymentMethodToBeneficiary and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (pm *FIPaymentMethodToBeneficiary) Validate() error {
-	if err := pm.fieldInclusion(); err != nil {
-		return err
-	}
+	return pm.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on FIPaymentMethodToBeneficiary and returns every error found
+func (pm *FIPaymentMethodToBeneficiary) validateAll() base.ErrorList {
+	errs := pm.fieldInclusion()
 	if pm.tag != TagFIPaymentMethodToBeneficiary {
-		return fieldError("tag", ErrValidTagForType, pm.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, pm.tag))
 	}
 	if err := pm.isAlphanumeric(pm.AdditionalInformation); err != nil {
-		return fieldError("AdditionalInformation", err, pm.AdditionalInformation)
+		errs.Add(fieldError("AdditionalInformation", err, pm.AdditionalInformation))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (pm *FIPaymentMethodToBeneficiary) fieldInclusion() error {
+func (pm *FIPaymentMethodToBeneficiary) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if pm.PaymentMethod != PaymentMethod {
-		return fieldError("PaymentMethod", ErrFieldInclusion, pm.PaymentMethod)
+		errs.Add(fieldError("PaymentMethod", ErrFieldInclusion, pm.PaymentMethod))
 	}
-	return nil
+	return errs
 }
 
 // PaymentMethodField gets a string of the PaymentMethod field
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIReceiverFI is the financial institution receiver financial institution
@@ -35,6 +38,9 @@ func NewFIReceiverFI() *FIReceiverFI {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (firfi *FIReceiverFI) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	firfi.tag = record[:6]
 
 	optionalFields := strings.Split(record[6:], "*")
@@ -90,28 +96,34 @@ func (firfi *FIReceiverFI) String() string {
 // Validate performs WIRE format rule checks on FIReceiverFI and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (firfi *FIReceiverFI) Validate() error {
+	return firfi.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on FIReceiverFI and returns every error found
+func (firfi *FIReceiverFI) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if firfi.tag != TagFIReceiverFI {
-		return fieldError("tag", ErrValidTagForType, firfi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, firfi.tag))
 	}
 	if err := firfi.isAlphanumeric(firfi.FIToFI.LineOne); err != nil {
-		return fieldError("LineOne", err, firfi.FIToFI.LineOne)
+		errs.Add(fieldError("LineOne", err, firfi.FIToFI.LineOne))
 	}
 	if err := firfi.isAlphanumeric(firfi.FIToFI.LineTwo); err != nil {
-		return fieldError("LineTwo", err, firfi.FIToFI.LineTwo)
+		errs.Add(fieldError("LineTwo", err, firfi.FIToFI.LineTwo))
 	}
 	if err := firfi.isAlphanumeric(firfi.FIToFI.LineThree); err != nil {
-		return fieldError("LineThree", err, firfi.FIToFI.LineThree)
+		errs.Add(fieldError("LineThree", err, firfi.FIToFI.LineThree))
 	}
 	if err := firfi.isAlphanumeric(firfi.FIToFI.LineFour); err != nil {
-		return fieldError("LineFour", err, firfi.FIToFI.LineFour)
+		errs.Add(fieldError("LineFour", err, firfi.FIToFI.LineFour))
 	}
 	if err := firfi.isAlphanumeric(firfi.FIToFI.LineFive); err != nil {
-		return fieldError("LineFive", err, firfi.FIToFI.LineFive)
+		errs.Add(fieldError("LineFive", err, firfi.FIToFI.LineFive))
 	}
 	if err := firfi.isAlphanumeric(firfi.FIToFI.LineSix); err != nil {
-		return fieldError("LineSix", err, firfi.FIToFI.LineSix)
+		errs.Add(fieldError("LineSix", err, firfi.FIToFI.LineSix))
 	}
-	return nil
+	return errs
 }
 
 // LineOneField gets a string of the LineOne field
@@ -7,6 +7,8 @@ package wire
 import (
 	"errors"
 	"fmt"
+
+	"github.com/moov-io/base"
 )
 
 var (
@@ -20,6 +22,10 @@ var (
 	ErrNonAlphanumeric = errors.New("has non alphanumeric characters")
 	// ErrNonAmount is returned for an incorrect wire amount format
 	ErrNonAmount = errors.New("is an incorrect amount format")
+	// ErrAmountPrecision is returned for an amount with more decimal places than its currency's minor units
+	ErrAmountPrecision = errors.New("has more decimal places than the currency permits")
+	// ErrAmountRange is returned for an amount which is negative or too large for its field
+	ErrAmountRange = errors.New("is out of range for the amount field")
 	// ErrNonCurrencyCode is returned for an incorrect currency code
 	ErrNonCurrencyCode = errors.New("is not a recognized currency code")
 	// ErrUpperAlpha is returned when a field is not in uppercase
@@ -42,8 +48,44 @@ var (
 	ErrValidCentury = errors.New("is an invalid century")
 	// ErrValidDate is returned for an invalid date
 	ErrValidDate = errors.New("is an invalid date format")
+	// ErrDateInFuture is returned for a date after today which must not be in the future
+	ErrDateInFuture = errors.New("is in the future")
+	// ErrDateOutOfRange is returned for a date outside of its tag's DateRange
+	ErrDateOutOfRange = errors.New("is outside of the permitted date range")
+	// ErrNotBusinessDay is returned for a cycle date which is a weekend or holiday
+	ErrNotBusinessDay = errors.New("is not a Fedwire business day")
+	// ErrCycleDateInPast is returned for an InputCycleDate before the current Fedwire business day
+	ErrCycleDateInPast = errors.New("is before the current Fedwire business day")
+	// ErrPreviousCycleDate is returned when a prior day reversal's PreviousMessageIdentifier is not from an earlier cycle date
+	ErrPreviousCycleDate = errors.New("is not from a cycle date before the InputCycleDate")
+	// ErrFedwireClosed is returned for a message sent outside of the hours of the Fedwire Funds Service
+	ErrFedwireClosed = errors.New("is outside of the Fedwire Funds Service hours")
+	// ErrCustomerTransferCutoff is returned for a customer transfer sent after the customer transfer cutoff
+	ErrCustomerTransferCutoff = errors.New("is after the customer transfer cutoff")
+	// ErrSettlementOnly is returned for a message other than a settlement transfer sent in the settlement only window
+	ErrSettlementOnly = errors.New("is not a settlement transfer, the only type accepted after the settlement only cutoff")
+	// ErrReversalBeneficiary is returned for an OriginatorOptionF which cannot be the Beneficiary of a reversal
+	ErrReversalBeneficiary = errors.New("cannot be the Beneficiary of a reversal, which requires an account number")
+	// ErrDrawdownRequest is returned when responding to a message which is not a drawdown request
+	ErrDrawdownRequest = errors.New("is not a drawdown request")
+	// ErrISOMessageType is returned when converting a message which the ISO 20022 message type does not carry
+	ErrISOMessageType = errors.New("cannot be converted to this ISO 20022 message type")
+	// ErrISODocument is returned when reading an ISO 20022 Document which is not a supported message
+	ErrISODocument = errors.New("is not a supported ISO 20022 message")
+	// ErrSWIFTMessageType is returned when converting a message which the SWIFT MT message type does not carry
+	ErrSWIFTMessageType = errors.New("cannot be converted to this SWIFT MT message type")
+	// ErrSWIFTMessage is returned when reading a SWIFT MT message which is malformed or not a supported type
+	ErrSWIFTMessage = errors.New("is not a supported SWIFT MT message")
 	// ErrInvalidProperty is returned for an invalid type property
 	ErrInvalidProperty = errors.New("is an invalid property")
+	// ErrRoutingNumberCheckDigit is returned when an ABA routing number's check digit is incorrect
+	ErrRoutingNumberCheckDigit = errors.New("has an invalid routing number check digit")
+	// ErrBIC is returned for an identifier that is not an ISO 9362 SWIFT BIC
+	ErrBIC = errors.New("is an invalid SWIFT BIC")
+	// ErrIBAN is returned for an account number that is not an ISO 13616 IBAN
+	ErrIBAN = errors.New("is an invalid IBAN")
+	// ErrBICAccountNumber is returned when a BIC and account number identifier has no account number
+	ErrBICAccountNumber = errors.New("is missing the account number following the SWIFT BIC")
 
 	// SenderSupplied Tag {1500}
 
@@ -130,11 +172,15 @@ type FieldError struct {
 	Value     interface{} // value that cause error
 	Err       error       // context of the error.
 	Msg       string      // deprecated
+	Tag       string      // tag of the field, such as {4200}, when known
 }
 
 // Error message is constructed
 
 func (e *FieldError) Error() string {
+	if e.Tag != "" {
+		return fmt.Sprintf("%s %s %v %s", e.Tag, e.FieldName, e.Value, e.Err)
+	}
 	return fmt.Sprintf("%s %v %s", e.FieldName, e.Value, e.Err)
 }
 
@@ -161,6 +207,28 @@ func fieldError(field string, err error, values ...interface{}) error {
 	return &fe
 }
 
+// addErrors appends err to errs, adding each error of a base.ErrorList individually
+func addErrors(errs *base.ErrorList, err error) {
+	switch e := err.(type) {
+	case nil:
+	case base.ErrorList:
+		*errs = append(*errs, e...)
+	default:
+		errs.Add(err)
+	}
+}
+
+// errorList returns nil when errs is empty, its only error when it holds one and errs otherwise
+func errorList(errs base.ErrorList) error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	}
+	return errs
+}
+
 // ErrBusinessFunctionCodeProperty is the error given when the observed check digit does not match the calculated one
 type ErrBusinessFunctionCodeProperty struct {
 	Message              string
@@ -226,3 +294,45 @@ func NewFieldWrongLengthErr(FieldLength, length int) FieldWrongLengthErr {
 func (e FieldWrongLengthErr) Error() string {
 	return e.Message
 }
+
+// TagWrongLengthErr is the error given when a tag is too short to hold its fields
+type TagWrongLengthErr struct {
+	Message   string
+	TagLength int
+	Length    int
+}
+
+// NewTagWrongLengthErr creates a new error of the TagWrongLengthErr type
+func NewTagWrongLengthErr(tagLength, length int) TagWrongLengthErr {
+	return TagWrongLengthErr{
+		Message:   fmt.Sprintf("must be at least %d characters and found %d", tagLength, length),
+		TagLength: tagLength,
+		Length:    length,
+	}
+}
+
+func (e TagWrongLengthErr) Error() string {
+	return e.Message
+}
+
+// CharacterSetErr is the error given when a field holds a character outside of the character set it must use
+type CharacterSetErr struct {
+	Message      string
+	CharacterSet string
+	Character    rune
+	Offset       int
+}
+
+// NewCharacterSetErr creates a new error of the CharacterSetErr type for the character found at the byte offset
+func NewCharacterSetErr(characterSet string, character rune, offset int) CharacterSetErr {
+	return CharacterSetErr{
+		Message:      fmt.Sprintf("has %q at offset %d which is not in the %s character set", character, offset, characterSet),
+		CharacterSet: characterSet,
+		Character:    character,
+		Offset:       offset,
+	}
+}
+
+func (e CharacterSetErr) Error() string {
+	return e.Message
+}
@@ -8,12 +8,15 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+
+	"github.com/moov-io/base"
 )
 
 // File contains the structures of a parsed WIRE File.
 type File struct {
-	ID             string         `json:"id"`
-	FEDWireMessage FEDWireMessage `json:"fedWireMessage"`
+	ID string `json:"id"`
+	// FEDWireMessages are the messages in the File, in the order they were read or added
+	FEDWireMessages []FEDWireMessage `json:"fedWireMessages"`
 }
 
 // NewFile constructs a file template
@@ -23,8 +26,8 @@ func NewFile() *File {
 
 // AddFEDWireMessage appends a FEDWireMessage to the File
 func (f *File) AddFEDWireMessage(fwm FEDWireMessage) FEDWireMessage {
-	f.FEDWireMessage = fwm
-	return f.FEDWireMessage
+	f.FEDWireMessages = append(f.FEDWireMessages, fwm)
+	return fwm
 }
 
 // Create will tabulate and assemble an WIRE file into a valid state.
@@ -36,10 +39,78 @@ func (f *File) Create() error {
 }
 
 // Validate will never modify the file.
+//
+// Every FEDWireMessage is verified. When the File holds a single message its error is returned as is,
+// otherwise each failing message is reported as an ErrFEDWireMessage carrying its index within a base.ErrorList.
 func (f *File) Validate() error {
-	if err := f.FEDWireMessage.verify(); err != nil {
+	return f.validateEach((*FEDWireMessage).verify)
+}
+
+// validateEach returns the error of validate for each FEDWireMessage, as described by Validate
+func (f *File) validateEach(validate func(*FEDWireMessage) error) error {
+	if len(f.FEDWireMessages) == 0 {
+		return ErrFileNoFEDWireMessages
+	}
+	if len(f.FEDWireMessages) == 1 {
+		return validate(&f.FEDWireMessages[0])
+	}
+	var errs base.ErrorList
+	for i := range f.FEDWireMessages {
+		if err := validate(&f.FEDWireMessages[i]); err != nil {
+			errs.Add(NewErrFEDWireMessage(i, err))
+		}
+	}
+	if errs.Empty() {
+		return nil
+	}
+	return errs
+}
+
+// ValidateWith performs WIRE format rule checks on each FEDWireMessage of the File using opts.
+//
+// Without opts, or unless CollectAll is set, each message is checked as by Validate. Otherwise every error of
+// every message is returned in a base.ErrorList of *FieldError, whose names are prefixed with
+// FEDWireMessages[index] when the File holds more than one message.
+func (f *File) ValidateWith(opts *ValidateOpts) error {
+	if opts == nil || !opts.CollectAll {
+		return f.validateEach(func(fwm *FEDWireMessage) error {
+			return fwm.ValidateWith(opts)
+		})
+	}
+	if len(f.FEDWireMessages) == 0 {
+		return ErrFileNoFEDWireMessages
+	}
+	var errs base.ErrorList
+	for i := range f.FEDWireMessages {
+		for _, err := range f.FEDWireMessages[i].collectAll(opts) {
+			if fe, ok := err.(*FieldError); ok && len(f.FEDWireMessages) > 1 {
+				fe.FieldName = fmt.Sprintf("FEDWireMessages[%d].%s", i, fe.FieldName)
+			}
+			errs.Add(err)
+		}
+	}
+	if errs.Empty() {
+		return nil
+	}
+	return errs
+}
+
+// UnmarshalJSON reads a File, accepting the single message "fedWireMessage" form written by
+// earlier versions as well as "fedWireMessages".
+func (f *File) UnmarshalJSON(data []byte) error {
+	type Alias File
+	aux := struct {
+		*Alias
+		FEDWireMessage *FEDWireMessage `json:"fedWireMessage"`
+	}{
+		Alias: (*Alias)(f),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
 		return err
 	}
+	if aux.FEDWireMessage != nil && len(f.FEDWireMessages) == 0 {
+		f.FEDWireMessages = []FEDWireMessage{*aux.FEDWireMessage}
+	}
 	return nil
 }
 
@@ -12,6 +12,8 @@ import (
 var (
 	// ErrFileTooLong is the error given when a file exceeds the maximum possible length
 	ErrFileTooLong = errors.New("file exceeds maximum possible number of lines")
+	// ErrFileNoFEDWireMessages is the error given when a file does not contain any FEDWireMessage
+	ErrFileNoFEDWireMessages = errors.New("file contains no FEDWireMessages")
 )
 
 // ErrInvalidTag is the error given when a tag is invalid
@@ -31,3 +33,28 @@ func NewErrInvalidTag(tag string) ErrInvalidTag {
 func (e ErrInvalidTag) Error() string {
 	return e.Message
 }
+
+// ErrFEDWireMessage is the error given when a FEDWireMessage within a File is invalid
+type ErrFEDWireMessage struct {
+	Message string
+	Index   int
+	Err     error
+}
+
+// NewErrFEDWireMessage creates a new error of the ErrFEDWireMessage type
+func NewErrFEDWireMessage(index int, err error) ErrFEDWireMessage {
+	return ErrFEDWireMessage{
+		Message: fmt.Sprintf("FEDWireMessage %d: %v", index, err),
+		Index:   index,
+		Err:     err,
+	}
+}
+
+func (e ErrFEDWireMessage) Error() string {
+	return e.Message
+}
+
+// Unwrap implements the base.UnwrappableError interface for ErrFEDWireMessage
+func (e ErrFEDWireMessage) Unwrap() error {
+	return e.Err
+}
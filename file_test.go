@@ -5,10 +5,13 @@
 package wire
 
 import (
+	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"path/filepath"
 	"testing"
 
+	"github.com/moov-io/base"
 	"github.com/stretchr/testify/require"
 )
 
@@ -25,5 +28,53 @@ func TestFile__FileFromJSON(t *testing.T) {
 
 	require.NoError(t, err)
 	require.Empty(t, file.ID, "id should not have been set")
-	require.NotNil(t, file.FEDWireMessage.FIAdditionalFIToFI, "FIAdditionalFIToFI shouldn't be nil")
+	require.NotNil(t, file.FEDWireMessages[0].FIAdditionalFIToFI, "FIAdditionalFIToFI shouldn't be nil")
+}
+
+func TestFile__FileFromJSONMultipleMessages(t *testing.T) {
+	bs := []byte(`{"id":"abc","fedWireMessages":[{"amount":{"amount":"000000000100"}},{"amount":{"amount":"000000000200"}}]}`)
+
+	file, err := FileFromJSON(bs)
+
+	require.NoError(t, err)
+	require.Len(t, file.FEDWireMessages, 2)
+	require.Equal(t, "000000000200", file.FEDWireMessages[1].Amount.Amount)
+}
+
+func TestFile__MarshalJSON(t *testing.T) {
+	file := NewFile()
+	file.AddFEDWireMessage(createCustomerTransferData())
+
+	bs, err := json.Marshal(file)
+	require.NoError(t, err)
+	require.Contains(t, string(bs), `"fedWireMessages":[`)
+
+	read, err := FileFromJSON(bs)
+	require.NoError(t, err)
+	require.Len(t, read.FEDWireMessages, 1)
+	require.NoError(t, read.Validate())
+}
+
+func TestFile__ValidateEmpty(t *testing.T) {
+	require.Equal(t, ErrFileNoFEDWireMessages, NewFile().Validate())
+}
+
+func TestFile__ValidateMultipleMessages(t *testing.T) {
+	file := NewFile()
+	file.AddFEDWireMessage(createCustomerTransferData())
+	invalid := createCustomerTransferData()
+	invalid.Beneficiary = nil
+	file.AddFEDWireMessage(invalid)
+	file.AddFEDWireMessage(createCustomerTransferData())
+
+	err := file.Validate()
+
+	require.Error(t, err)
+	el, ok := err.(base.ErrorList)
+	require.True(t, ok)
+	require.Len(t, el, 1)
+	var msgErr ErrFEDWireMessage
+	require.True(t, errors.As(el[0], &msgErr))
+	require.Equal(t, 1, msgErr.Index)
+	require.EqualError(t, msgErr.Err, fieldError("Beneficiary", ErrFieldRequired).Error())
 }
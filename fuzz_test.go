@@ -0,0 +1,340 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fuzzTag is implemented by every tag type
+type fuzzTag interface {
+	Parse(record string) error
+	Validate() error
+	String() string
+}
+
+// fuzzTagSeeds returns the body, after the tag itself, of every record for tag found in the crashers
+// and sample files under test/testdata
+func fuzzTagSeeds(f *testing.F, tag string) []string {
+	var paths []string
+	for _, pattern := range []string{
+		filepath.Join("test", "testdata", "crashers", "*"),
+		filepath.Join("test", "testdata", "*.txt"),
+	} {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			f.Fatal(err)
+		}
+		paths = append(paths, matches...)
+	}
+
+	var seeds []string
+	for _, path := range paths {
+		if strings.HasSuffix(path, ".output") {
+			continue // go-fuzz makes these which contain the panic's trace
+		}
+		bs, err := os.ReadFile(path)
+		if err != nil {
+			f.Fatal(err)
+		}
+		scanner := bufio.NewScanner(strings.NewReader(string(bs)))
+		scanner.Split(scanTags)
+		for scanner.Scan() {
+			if line := scanner.Text(); strings.HasPrefix(line, tag) {
+				seeds = append(seeds, line[len(tag):])
+			}
+		}
+	}
+	return seeds
+}
+
+// fuzzTagParse ensures parsing, validating and writing arbitrary records for tag never panics
+func fuzzTagParse(f *testing.F, tag string, newTag func() fuzzTag) {
+	f.Add("")
+	for _, seed := range fuzzTagSeeds(f, tag) {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, body string) {
+		ft := newTag()
+		if err := ft.Parse(tag + body); err != nil {
+			return
+		}
+		if err := ft.Validate(); err != nil {
+			return
+		}
+		_ = ft.String()
+	})
+}
+
+// FuzzReader ensures reading and validating arbitrary files never panics
+func FuzzReader(f *testing.F) {
+	for _, pattern := range []string{
+		filepath.Join("test", "testdata", "crashers", "*"),
+		filepath.Join("test", "testdata", "*.txt"),
+	} {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			f.Fatal(err)
+		}
+		for _, path := range matches {
+			if strings.HasSuffix(path, ".output") {
+				continue
+			}
+			bs, err := os.ReadFile(path)
+			if err != nil {
+				f.Fatal(err)
+			}
+			f.Add(string(bs))
+		}
+	}
+	f.Fuzz(func(t *testing.T, contents string) {
+		file, _ := NewReader(strings.NewReader(contents)).Read()
+		file.ValidateWith(&ValidateOpts{CollectAll: true})
+	})
+}
+
+func FuzzMessageDisposition(f *testing.F) {
+	fuzzTagParse(f, TagMessageDisposition, func() fuzzTag { return new(MessageDisposition) })
+}
+
+func FuzzReceiptTimeStamp(f *testing.F) {
+	fuzzTagParse(f, TagReceiptTimeStamp, func() fuzzTag { return new(ReceiptTimeStamp) })
+}
+
+func FuzzOutputMessageAccountabilityData(f *testing.F) {
+	fuzzTagParse(f, TagOutputMessageAccountabilityData, func() fuzzTag { return new(OutputMessageAccountabilityData) })
+}
+
+func FuzzErrorWire(f *testing.F) {
+	fuzzTagParse(f, TagErrorWire, func() fuzzTag { return new(ErrorWire) })
+}
+
+func FuzzSenderSupplied(f *testing.F) {
+	fuzzTagParse(f, TagSenderSupplied, func() fuzzTag { return new(SenderSupplied) })
+}
+
+func FuzzTypeSubType(f *testing.F) {
+	fuzzTagParse(f, TagTypeSubType, func() fuzzTag { return new(TypeSubType) })
+}
+
+func FuzzInputMessageAccountabilityData(f *testing.F) {
+	fuzzTagParse(f, TagInputMessageAccountabilityData, func() fuzzTag { return new(InputMessageAccountabilityData) })
+}
+
+func FuzzAmount(f *testing.F) {
+	fuzzTagParse(f, TagAmount, func() fuzzTag { return new(Amount) })
+}
+
+func FuzzSenderDepositoryInstitution(f *testing.F) {
+	fuzzTagParse(f, TagSenderDepositoryInstitution, func() fuzzTag { return new(SenderDepositoryInstitution) })
+}
+
+func FuzzReceiverDepositoryInstitution(f *testing.F) {
+	fuzzTagParse(f, TagReceiverDepositoryInstitution, func() fuzzTag { return new(ReceiverDepositoryInstitution) })
+}
+
+func FuzzBusinessFunctionCode(f *testing.F) {
+	fuzzTagParse(f, TagBusinessFunctionCode, func() fuzzTag { return new(BusinessFunctionCode) })
+}
+
+func FuzzSenderReference(f *testing.F) {
+	fuzzTagParse(f, TagSenderReference, func() fuzzTag { return new(SenderReference) })
+}
+
+func FuzzPreviousMessageIdentifier(f *testing.F) {
+	fuzzTagParse(f, TagPreviousMessageIdentifier, func() fuzzTag { return new(PreviousMessageIdentifier) })
+}
+
+func FuzzLocalInstrument(f *testing.F) {
+	fuzzTagParse(f, TagLocalInstrument, func() fuzzTag { return new(LocalInstrument) })
+}
+
+func FuzzPaymentNotification(f *testing.F) {
+	fuzzTagParse(f, TagPaymentNotification, func() fuzzTag { return new(PaymentNotification) })
+}
+
+func FuzzCharges(f *testing.F) {
+	fuzzTagParse(f, TagCharges, func() fuzzTag { return new(Charges) })
+}
+
+func FuzzInstructedAmount(f *testing.F) {
+	fuzzTagParse(f, TagInstructedAmount, func() fuzzTag { return new(InstructedAmount) })
+}
+
+func FuzzExchangeRate(f *testing.F) {
+	fuzzTagParse(f, TagExchangeRate, func() fuzzTag { return new(ExchangeRate) })
+}
+
+func FuzzBeneficiaryIntermediaryFI(f *testing.F) {
+	fuzzTagParse(f, TagBeneficiaryIntermediaryFI, func() fuzzTag { return new(BeneficiaryIntermediaryFI) })
+}
+
+func FuzzBeneficiaryFI(f *testing.F) {
+	fuzzTagParse(f, TagBeneficiaryFI, func() fuzzTag { return new(BeneficiaryFI) })
+}
+
+func FuzzBeneficiary(f *testing.F) {
+	fuzzTagParse(f, TagBeneficiary, func() fuzzTag { return new(Beneficiary) })
+}
+
+func FuzzBeneficiaryReference(f *testing.F) {
+	fuzzTagParse(f, TagBeneficiaryReference, func() fuzzTag { return new(BeneficiaryReference) })
+}
+
+func FuzzAccountDebitedDrawdown(f *testing.F) {
+	fuzzTagParse(f, TagAccountDebitedDrawdown, func() fuzzTag { return new(AccountDebitedDrawdown) })
+}
+
+func FuzzOriginator(f *testing.F) {
+	fuzzTagParse(f, TagOriginator, func() fuzzTag { return new(Originator) })
+}
+
+func FuzzOriginatorOptionF(f *testing.F) {
+	fuzzTagParse(f, TagOriginatorOptionF, func() fuzzTag { return new(OriginatorOptionF) })
+}
+
+func FuzzOriginatorFI(f *testing.F) {
+	fuzzTagParse(f, TagOriginatorFI, func() fuzzTag { return new(OriginatorFI) })
+}
+
+func FuzzInstructingFI(f *testing.F) {
+	fuzzTagParse(f, TagInstructingFI, func() fuzzTag { return new(InstructingFI) })
+}
+
+func FuzzAccountCreditedDrawdown(f *testing.F) {
+	fuzzTagParse(f, TagAccountCreditedDrawdown, func() fuzzTag { return new(AccountCreditedDrawdown) })
+}
+
+func FuzzOriginatorToBeneficiary(f *testing.F) {
+	fuzzTagParse(f, TagOriginatorToBeneficiary, func() fuzzTag { return new(OriginatorToBeneficiary) })
+}
+
+func FuzzFIReceiverFI(f *testing.F) {
+	fuzzTagParse(f, TagFIReceiverFI, func() fuzzTag { return new(FIReceiverFI) })
+}
+
+func FuzzFIDrawdownDebitAccountAdvice(f *testing.F) {
+	fuzzTagParse(f, TagFIDrawdownDebitAccountAdvice, func() fuzzTag { return new(FIDrawdownDebitAccountAdvice) })
+}
+
+func FuzzFIIntermediaryFI(f *testing.F) {
+	fuzzTagParse(f, TagFIIntermediaryFI, func() fuzzTag { return new(FIIntermediaryFI) })
+}
+
+func FuzzFIIntermediaryFIAdvice(f *testing.F) {
+	fuzzTagParse(f, TagFIIntermediaryFIAdvice, func() fuzzTag { return new(FIIntermediaryFIAdvice) })
+}
+
+func FuzzFIBeneficiaryFI(f *testing.F) {
+	fuzzTagParse(f, TagFIBeneficiaryFI, func() fuzzTag { return new(FIBeneficiaryFI) })
+}
+
+func FuzzFIBeneficiaryFIAdvice(f *testing.F) {
+	fuzzTagParse(f, TagFIBeneficiaryFIAdvice, func() fuzzTag { return new(FIBeneficiaryFIAdvice) })
+}
+
+func FuzzFIBeneficiary(f *testing.F) {
+	fuzzTagParse(f, TagFIBeneficiary, func() fuzzTag { return new(FIBeneficiary) })
+}
+
+func FuzzFIBeneficiaryAdvice(f *testing.F) {
+	fuzzTagParse(f, TagFIBeneficiaryAdvice, func() fuzzTag { return new(FIBeneficiaryAdvice) })
+}
+
+func FuzzFIPaymentMethodToBeneficiary(f *testing.F) {
+	fuzzTagParse(f, TagFIPaymentMethodToBeneficiary, func() fuzzTag { return new(FIPaymentMethodToBeneficiary) })
+}
+
+func FuzzFIAdditionalFIToFI(f *testing.F) {
+	fuzzTagParse(f, TagFIAdditionalFIToFI, func() fuzzTag { return new(FIAdditionalFIToFI) })
+}
+
+func FuzzCurrencyInstructedAmount(f *testing.F) {
+	fuzzTagParse(f, TagCurrencyInstructedAmount, func() fuzzTag { return new(CurrencyInstructedAmount) })
+}
+
+func FuzzOrderingCustomer(f *testing.F) {
+	fuzzTagParse(f, TagOrderingCustomer, func() fuzzTag { return new(OrderingCustomer) })
+}
+
+func FuzzOrderingInstitution(f *testing.F) {
+	fuzzTagParse(f, TagOrderingInstitution, func() fuzzTag { return new(OrderingInstitution) })
+}
+
+func FuzzIntermediaryInstitution(f *testing.F) {
+	fuzzTagParse(f, TagIntermediaryInstitution, func() fuzzTag { return new(IntermediaryInstitution) })
+}
+
+func FuzzInstitutionAccount(f *testing.F) {
+	fuzzTagParse(f, TagInstitutionAccount, func() fuzzTag { return new(InstitutionAccount) })
+}
+
+func FuzzBeneficiaryCustomer(f *testing.F) {
+	fuzzTagParse(f, TagBeneficiaryCustomer, func() fuzzTag { return new(BeneficiaryCustomer) })
+}
+
+func FuzzRemittance(f *testing.F) {
+	fuzzTagParse(f, TagRemittance, func() fuzzTag { return new(Remittance) })
+}
+
+func FuzzSenderToReceiver(f *testing.F) {
+	fuzzTagParse(f, TagSenderToReceiver, func() fuzzTag { return new(SenderToReceiver) })
+}
+
+func FuzzUnstructuredAddenda(f *testing.F) {
+	fuzzTagParse(f, TagUnstructuredAddenda, func() fuzzTag { return new(UnstructuredAddenda) })
+}
+
+func FuzzRelatedRemittance(f *testing.F) {
+	fuzzTagParse(f, TagRelatedRemittance, func() fuzzTag { return new(RelatedRemittance) })
+}
+
+func FuzzRemittanceOriginator(f *testing.F) {
+	fuzzTagParse(f, TagRemittanceOriginator, func() fuzzTag { return new(RemittanceOriginator) })
+}
+
+func FuzzRemittanceBeneficiary(f *testing.F) {
+	fuzzTagParse(f, TagRemittanceBeneficiary, func() fuzzTag { return new(RemittanceBeneficiary) })
+}
+
+func FuzzPrimaryRemittanceDocument(f *testing.F) {
+	fuzzTagParse(f, TagPrimaryRemittanceDocument, func() fuzzTag { return new(PrimaryRemittanceDocument) })
+}
+
+func FuzzActualAmountPaid(f *testing.F) {
+	fuzzTagParse(f, TagActualAmountPaid, func() fuzzTag { return new(ActualAmountPaid) })
+}
+
+func FuzzGrossAmountRemittanceDocument(f *testing.F) {
+	fuzzTagParse(f, TagGrossAmountRemittanceDocument, func() fuzzTag { return new(GrossAmountRemittanceDocument) })
+}
+
+func FuzzAmountNegotiatedDiscount(f *testing.F) {
+	fuzzTagParse(f, TagAmountNegotiatedDiscount, func() fuzzTag { return new(AmountNegotiatedDiscount) })
+}
+
+func FuzzAdjustment(f *testing.F) {
+	fuzzTagParse(f, TagAdjustment, func() fuzzTag { return new(Adjustment) })
+}
+
+func FuzzDateRemittanceDocument(f *testing.F) {
+	fuzzTagParse(f, TagDateRemittanceDocument, func() fuzzTag { return new(DateRemittanceDocument) })
+}
+
+func FuzzSecondaryRemittanceDocument(f *testing.F) {
+	fuzzTagParse(f, TagSecondaryRemittanceDocument, func() fuzzTag { return new(SecondaryRemittanceDocument) })
+}
+
+func FuzzRemittanceFreeText(f *testing.F) {
+	fuzzTagParse(f, TagRemittanceFreeText, func() fuzzTag { return new(RemittanceFreeText) })
+}
+
+func FuzzServiceMessage(f *testing.F) {
+	fuzzTagParse(f, TagServiceMessage, func() fuzzTag { return new(ServiceMessage) })
+}
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // GrossAmountRemittanceDocument is the gross amount remittance document
@@ -35,13 +38,12 @@ func NewGrossAmountRemittanceDocument() *GrossAmountRemittanceDocument {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (gard *GrossAmountRemittanceDocument) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 9 {
+		return NewTagWrongLengthErr(9, utf8.RuneCountInString(record))
+	}
 	gard.tag = record[:6]
 	gard.RemittanceAmount.CurrencyCode = gard.parseStringField(record[6:9])
-	if delim := strings.IndexByte(record, '*'); delim > 0 {
-		gard.RemittanceAmount.Amount = gard.parseStringField(record[9:delim])
-	} else {
-		gard.RemittanceAmount.Amount = gard.parseStringField(record[9:])
-	}
+	gard.RemittanceAmount.Amount = gard.parseVariableStringField(record[9:])
 	return nil
 }
 
@@ -72,31 +74,35 @@ func (gard *GrossAmountRemittanceDocument) String() string {
 // Validate performs WIRE format rule checks on GrossAmountRemittanceDocument and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (gard *GrossAmountRemittanceDocument) Validate() error {
-	if err := gard.fieldInclusion(); err != nil {
-		return err
-	}
+	return gard.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on GrossAmountRemittanceDocument and returns every error found
+func (gard *GrossAmountRemittanceDocument) validateAll() base.ErrorList {
+	errs := gard.fieldInclusion()
 	if gard.tag != TagGrossAmountRemittanceDocument {
-		return fieldError("tag", ErrValidTagForType, gard.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, gard.tag))
 	}
 	if err := gard.isCurrencyCode(gard.RemittanceAmount.CurrencyCode); err != nil {
-		return fieldError("CurrencyCode", err, gard.RemittanceAmount.CurrencyCode)
+		errs.Add(fieldError("CurrencyCode", err, gard.RemittanceAmount.CurrencyCode))
 	}
 	if err := gard.isAmount(gard.RemittanceAmount.Amount); err != nil {
-		return fieldError("Amount", err, gard.RemittanceAmount.Amount)
+		errs.Add(fieldError("Amount", err, gard.RemittanceAmount.Amount))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (gard *GrossAmountRemittanceDocument) fieldInclusion() error {
+func (gard *GrossAmountRemittanceDocument) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if gard.RemittanceAmount.Amount == "" {
-		return fieldError("Amount", ErrFieldRequired)
+		errs.Add(fieldError("Amount", ErrFieldRequired))
 	}
 	if gard.RemittanceAmount.CurrencyCode == "" {
-		return fieldError("CurrencyCode", ErrFieldRequired)
+		errs.Add(fieldError("CurrencyCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // CurrencyCodeField gets a string of the CurrencyCode field
@@ -7,6 +7,10 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // InputMessageAccountabilityData (IMAD) {1520}
@@ -39,6 +43,9 @@ func NewInputMessageAccountabilityData() *InputMessageAccountabilityData {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (imad *InputMessageAccountabilityData) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 28 {
+		return NewTagWrongLengthErr(28, utf8.RuneCountInString(record))
+	}
 	imad.tag = record[:6]
 	imad.InputCycleDate = imad.parseStringField(record[6:14])
 	imad.InputSource = imad.parseStringField(record[14:22])
@@ -83,37 +90,41 @@ func (imad *InputMessageAccountabilityData) IMAD() string {
 // Validate performs WIRE format rule checks on InputMessageAccountabilityData and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (imad *InputMessageAccountabilityData) Validate() error {
-	if err := imad.fieldInclusion(); err != nil {
-		return err
-	}
+	return imad.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on InputMessageAccountabilityData and returns every error found
+func (imad *InputMessageAccountabilityData) validateAll() base.ErrorList {
+	errs := imad.fieldInclusion()
 	if imad.tag != TagInputMessageAccountabilityData {
-		return fieldError("tag", ErrValidTagForType, imad.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, imad.tag))
 	}
 	if err := imad.validateDate(imad.InputCycleDate); err != nil {
-		return fieldError("InputCycleDate", err, imad.InputCycleDate)
+		errs.Add(fieldError("InputCycleDate", err, imad.InputCycleDate))
 	}
 	if err := imad.isAlphanumeric(imad.InputSource); err != nil {
-		return fieldError("InputSource", err, imad.InputSource)
+		errs.Add(fieldError("InputSource", err, imad.InputSource))
 	}
 	if err := imad.isNumeric(imad.InputSequenceNumber); err != nil {
-		return fieldError("InputSequenceNumber", err, imad.InputSequenceNumber)
+		errs.Add(fieldError("InputSequenceNumber", err, imad.InputSequenceNumber))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (imad *InputMessageAccountabilityData) fieldInclusion() error {
+func (imad *InputMessageAccountabilityData) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if imad.InputCycleDate == "" {
-		return fieldError("InputCycleDate", ErrFieldRequired, imad.InputCycleDate)
+		errs.Add(fieldError("InputCycleDate", ErrFieldRequired, imad.InputCycleDate))
 	}
 	if imad.InputSource == "" {
-		return fieldError("InputSource", ErrFieldRequired, imad.InputSource)
+		errs.Add(fieldError("InputSource", ErrFieldRequired, imad.InputSource))
 	}
 	if imad.InputSequenceNumber == "" {
-		return fieldError("InputSequenceNumber", ErrFieldRequired, imad.InputSequenceNumber)
+		errs.Add(fieldError("InputSequenceNumber", ErrFieldRequired, imad.InputSequenceNumber))
 	}
-	return nil
+	return errs
 }
 
 // InputCycleDateField gets a string of the InputCycleDate field
@@ -130,3 +141,22 @@ func (imad *InputMessageAccountabilityData) InputSourceField() string {
 func (imad *InputMessageAccountabilityData) InputSequenceNumberField() string {
 	return imad.alphaField(imad.InputSequenceNumber, 6)
 }
+
+// CycleDate returns the InputCycleDate as midnight in America/New_York
+func (imad *InputMessageAccountabilityData) CycleDate() (time.Time, error) {
+	t, err := parseCycleDate(imad.InputCycleDate)
+	if err != nil {
+		return time.Time{}, fieldError("InputCycleDate", err, imad.InputCycleDate)
+	}
+	return t, nil
+}
+
+// SetCycleDate sets the InputCycleDate to the date of t in America/New_York
+func (imad *InputMessageAccountabilityData) SetCycleDate(t time.Time) error {
+	s, err := formatCycleDate(t)
+	if err != nil {
+		return err
+	}
+	imad.InputCycleDate = s
+	return nil
+}
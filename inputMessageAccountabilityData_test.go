@@ -97,3 +97,23 @@ func TestInputMessageAccountabilityDataInputCycleDateError(t *testing.T) {
 
 	require.EqualError(t, imad.Validate(), fieldError("InputCycleDate", ErrValidDate, imad.InputCycleDate).Error())
 }
+
+// TestInputMessageAccountabilityDataCycleDate validates InputCycleDate converts to and from a time.Time
+func TestInputMessageAccountabilityDataCycleDate(t *testing.T) {
+	loc, err := FedwireLocation()
+	require.NoError(t, err)
+	imad := mockInputMessageAccountabilityData()
+	imad.InputCycleDate = "20190502"
+
+	cycleDate, err := imad.CycleDate()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.May, 2, 0, 0, 0, 0, loc), cycleDate)
+
+	// 02:30 UTC on January 1 is still December 31 in New York
+	require.NoError(t, imad.SetCycleDate(time.Date(2020, time.January, 1, 2, 30, 0, 0, time.UTC)))
+	require.Equal(t, "20191231", imad.InputCycleDate)
+
+	imad.InputCycleDate = "20190231"
+	_, err = imad.CycleDate()
+	require.EqualError(t, err, fieldError("InputCycleDate", ErrValidDate, imad.InputCycleDate).Error())
+}
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // InstitutionAccount is the institution account
@@ -35,6 +38,9 @@ func NewInstitutionAccount() *InstitutionAccount {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (iAccount *InstitutionAccount) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	iAccount.tag = record[:6]
 
 	optionalFields := strings.Split(record[6:], "*")
@@ -90,40 +96,45 @@ func (iAccount *InstitutionAccount) String() string {
 // Validate performs WIRE format rule checks on InstitutionAccount and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (iAccount *InstitutionAccount) Validate() error {
-	if err := iAccount.fieldInclusion(); err != nil {
-		return err
-	}
+	return iAccount.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on InstitutionAccount and returns every error found
+func (iAccount *InstitutionAccount) validateAll() base.ErrorList {
+	errs := iAccount.fieldInclusion()
 	if iAccount.tag != TagInstitutionAccount {
-		return fieldError("tag", ErrValidTagForType, iAccount.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, iAccount.tag))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, iAccount.CoverPayment.SwiftFieldTag)
+		errs.Add(fieldError("SwiftFieldTag", err, iAccount.CoverPayment.SwiftFieldTag))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, iAccount.CoverPayment.SwiftLineOne)
+		errs.Add(fieldError("SwiftLineOne", err, iAccount.CoverPayment.SwiftLineOne))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, iAccount.CoverPayment.SwiftLineTwo)
+		errs.Add(fieldError("SwiftLineTwo", err, iAccount.CoverPayment.SwiftLineTwo))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, iAccount.CoverPayment.SwiftLineThree)
+		errs.Add(fieldError("SwiftLineThree", err, iAccount.CoverPayment.SwiftLineThree))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, iAccount.CoverPayment.SwiftLineFour)
+		errs.Add(fieldError("SwiftLineFour", err, iAccount.CoverPayment.SwiftLineFour))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineFive); err != nil {
-		return fieldError("SwiftLineFive", err, iAccount.CoverPayment.SwiftLineFive)
+		errs.Add(fieldError("SwiftLineFive", err, iAccount.CoverPayment.SwiftLineFive))
 	}
-	return nil
+	errs = append(errs, iAccount.validateCoverPaymentParty(iAccount.CoverPayment)...)
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (iAccount *InstitutionAccount) fieldInclusion() error {
+func (iAccount *InstitutionAccount) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if iAccount.CoverPayment.SwiftLineSix != "" {
-		return fieldError("SwiftLineSix", ErrInvalidProperty, iAccount.CoverPayment.SwiftLineSix)
+		errs.Add(fieldError("SwiftLineSix", ErrInvalidProperty, iAccount.CoverPayment.SwiftLineSix))
 	}
-	return nil
+	return errs
 }
 
 // SwiftFieldTagField gets a string of the SwiftFieldTag field
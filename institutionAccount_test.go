@@ -96,6 +96,20 @@ func TestInstitutionAccountSwiftLineSixAlphaNumeric(t *testing.T) {
 	require.EqualError(t, err, fieldError("SwiftLineSix", ErrInvalidProperty, iAccount.CoverPayment.SwiftLineSix).Error())
 }
 
+// TestInstitutionAccountSwiftOptionA validates an InstitutionAccount option A field carries an IBAN and BIC
+func TestInstitutionAccountSwiftOptionA(t *testing.T) {
+	iAccount := mockInstitutionAccount()
+	iAccount.CoverPayment.SwiftFieldTag = "57A"
+	iAccount.CoverPayment.SwiftLineOne = "/GB82WEST12345698765432"
+	iAccount.CoverPayment.SwiftLineTwo = "NWBKGB2L"
+
+	require.NoError(t, iAccount.Validate())
+
+	iAccount.CoverPayment.SwiftLineTwo = "NWBK"
+
+	require.EqualError(t, iAccount.Validate(), fieldError("SwiftLineTwo", ErrBIC, iAccount.CoverPayment.SwiftLineTwo).Error())
+}
+
 // TestParseInstitutionAccountReaderParseError parses a wrong InstitutionAccount reader parse error
 func TestParseInstitutionAccountReaderParseError(t *testing.T) {
 	var line = "{7057}Swift*Swift ®ine One*"
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // InstructedAmount is the InstructedAmount of the wire
@@ -38,13 +41,12 @@ func NewInstructedAmount() *InstructedAmount {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (ia *InstructedAmount) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 9 {
+		return NewTagWrongLengthErr(9, utf8.RuneCountInString(record))
+	}
 	ia.tag = record[:6]
 	ia.CurrencyCode = ia.parseStringField(record[6:9])
-	if delim := strings.IndexByte(record, '*'); delim > 0 {
-		ia.Amount = ia.parseStringField(record[9:delim])
-	} else {
-		ia.Amount = ia.parseStringField(record[9:])
-	}
+	ia.Amount = ia.parseVariableStringField(record[9:])
 
 	return nil
 }
@@ -76,32 +78,36 @@ func (ia *InstructedAmount) String() string {
 // Validate performs WIRE format rule checks on InstructedAmount and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (ia *InstructedAmount) Validate() error {
-	if err := ia.fieldInclusion(); err != nil {
-		return err
-	}
+	return ia.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on InstructedAmount and returns every error found
+func (ia *InstructedAmount) validateAll() base.ErrorList {
+	errs := ia.fieldInclusion()
 	if ia.tag != TagInstructedAmount {
-		return fieldError("tag", ErrValidTagForType, ia.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ia.tag))
 	}
 	if err := ia.isCurrencyCode(ia.CurrencyCode); err != nil {
-		return fieldError("CurrencyCode", err, ia.CurrencyCode)
+		errs.Add(fieldError("CurrencyCode", err, ia.CurrencyCode))
 	}
 	if err := ia.isAmount(ia.Amount); err != nil {
-		return fieldError("Amount", err, ia.Amount)
+		errs.Add(fieldError("Amount", err, ia.Amount))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ia *InstructedAmount) fieldInclusion() error {
+func (ia *InstructedAmount) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if ia.Amount == "" {
-		return fieldError("Amount", ErrFieldRequired)
+		errs.Add(fieldError("Amount", ErrFieldRequired))
 	}
 	if ia.CurrencyCode == "" {
-		return fieldError("CurrencyCode", ErrFieldRequired)
+		errs.Add(fieldError("CurrencyCode", ErrFieldRequired))
 
 	}
-	return nil
+	return errs
 }
 
 // CurrencyCodeField gets a string of the CurrencyCode field
@@ -113,3 +119,19 @@ func (ia *InstructedAmount) CurrencyCodeField() string {
 func (ia *InstructedAmount) AmountField() string {
 	return ia.alphaField(ia.Amount, 15)
 }
+
+// Money returns the CurrencyCode and Amount, which uses a decimal comma
+func (ia *InstructedAmount) Money() (Money, error) {
+	return parseDecimalMoney(ia.CurrencyCode, ia.Amount, ',')
+}
+
+// SetMoney sets the CurrencyCode and Amount to m, with a decimal comma
+func (ia *InstructedAmount) SetMoney(m Money) error {
+	amount, err := formatDecimalMoney(m, ',', 15)
+	if err != nil {
+		return err
+	}
+	ia.CurrencyCode = m.Currency
+	ia.Amount = amount
+	return nil
+}
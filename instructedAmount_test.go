@@ -84,3 +84,24 @@ func TestInstructedAmountTagError(t *testing.T) {
 
 	require.EqualError(t, ia.Validate(), fieldError("tag", ErrValidTagForType, ia.tag).Error())
 }
+
+// TestInstructedAmountMoney validates InstructedAmount converts to and from Money
+func TestInstructedAmountMoney(t *testing.T) {
+	ia := mockInstructedAmount()
+
+	m, err := ia.Money()
+	require.NoError(t, err)
+	require.Equal(t, USD(456789), m)
+
+	require.NoError(t, ia.SetMoney(Money{Currency: "JPY", MinorUnits: 1500}))
+	require.Equal(t, "JPY", ia.CurrencyCode)
+	require.Equal(t, "1500", ia.Amount)
+	require.NoError(t, ia.Validate())
+
+	require.NoError(t, ia.SetMoney(USD(5)))
+	require.Equal(t, "0,05", ia.Amount)
+	require.Equal(t, "{3710}USD0,05*", ia.String())
+
+	require.Equal(t, ErrAmountRange, ia.SetMoney(USD(1234567890123456)))
+	require.Equal(t, ErrNonCurrencyCode, ia.SetMoney(Money{Currency: "ZZZ", MinorUnits: 1}))
+}
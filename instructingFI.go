@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // InstructingFI is the instructing financial institution
@@ -35,6 +38,9 @@ func NewInstructingFI() *InstructingFI {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (ifi *InstructingFI) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 7 {
+		return NewTagWrongLengthErr(7, utf8.RuneCountInString(record))
+	}
 	ifi.tag = record[:6]
 	ifi.FinancialInstitution.IdentificationCode = ifi.parseStringField(record[6:7])
 
@@ -89,53 +95,63 @@ func (ifi *InstructingFI) String() string {
 // The first error encountered is returned and stops that parsing.
 // If ID Code is present, Identifier is mandatory and vice versa.
 func (ifi *InstructingFI) Validate() error {
+	return ifi.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on InstructingFI and returns every error found
+func (ifi *InstructingFI) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if ifi.tag != TagInstructingFI {
-		return fieldError("tag", ErrValidTagForType, ifi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ifi.tag))
 	}
 
-	if err := ifi.fieldInclusion(); err != nil {
-		return err
-	}
+	errs = append(errs, ifi.fieldInclusion()...)
 
 	if err := ifi.isIdentificationCode(ifi.FinancialInstitution.IdentificationCode); err != nil {
-		return fieldError("IdentificationCode", err, ifi.FinancialInstitution.IdentificationCode)
+		errs.Add(fieldError("IdentificationCode", err, ifi.FinancialInstitution.IdentificationCode))
 	}
 	// Can only be these Identification Codes
 	switch ifi.FinancialInstitution.IdentificationCode {
 	case
 		"", "B", "C", "D", "F", "U":
 	default:
-		return fieldError("IdentificationCode", ErrIdentificationCode, ifi.FinancialInstitution.IdentificationCode)
+		errs.Add(fieldError("IdentificationCode", ErrIdentificationCode, ifi.FinancialInstitution.IdentificationCode))
 	}
 	if err := ifi.isAlphanumeric(ifi.FinancialInstitution.Identifier); err != nil {
-		return fieldError("Identifier", err, ifi.FinancialInstitution.Identifier)
+		errs.Add(fieldError("Identifier", err, ifi.FinancialInstitution.Identifier))
+	}
+	if ifi.FinancialInstitution.IdentificationCode == FEDRoutingNumber {
+		if err := ifi.isRoutingNumber(ifi.FinancialInstitution.Identifier); err != nil {
+			errs.Add(fieldError("Identifier", err, ifi.FinancialInstitution.Identifier))
+		}
 	}
 
 	if err := ifi.isAlphanumeric(ifi.FinancialInstitution.Name); err != nil {
-		return fieldError("Name", err, ifi.FinancialInstitution.Name)
+		errs.Add(fieldError("Name", err, ifi.FinancialInstitution.Name))
 	}
 	if err := ifi.isAlphanumeric(ifi.FinancialInstitution.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, ifi.FinancialInstitution.Address.AddressLineOne)
+		errs.Add(fieldError("AddressLineOne", err, ifi.FinancialInstitution.Address.AddressLineOne))
 	}
 	if err := ifi.isAlphanumeric(ifi.FinancialInstitution.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, ifi.FinancialInstitution.Address.AddressLineTwo)
+		errs.Add(fieldError("AddressLineTwo", err, ifi.FinancialInstitution.Address.AddressLineTwo))
 	}
 	if err := ifi.isAlphanumeric(ifi.FinancialInstitution.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, ifi.FinancialInstitution.Address.AddressLineThree)
+		errs.Add(fieldError("AddressLineThree", err, ifi.FinancialInstitution.Address.AddressLineThree))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ifi *InstructingFI) fieldInclusion() error {
+func (ifi *InstructingFI) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if ifi.FinancialInstitution.IdentificationCode != "" && ifi.FinancialInstitution.Identifier == "" {
-		return fieldError("Identifier", ErrFieldRequired)
+		errs.Add(fieldError("Identifier", ErrFieldRequired))
 	}
 	if ifi.FinancialInstitution.IdentificationCode == "" && ifi.FinancialInstitution.Identifier != "" {
-		return fieldError("IdentificationCode", ErrFieldRequired)
+		errs.Add(fieldError("IdentificationCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
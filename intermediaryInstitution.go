@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // IntermediaryInstitution is the intermediary institution
@@ -35,6 +38,9 @@ func NewIntermediaryInstitution() *IntermediaryInstitution {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (ii *IntermediaryInstitution) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	ii.tag = record[:6]
 
 	optionalFields := strings.Split(record[6:], "*")
@@ -90,40 +96,45 @@ func (ii *IntermediaryInstitution) String() string {
 // Validate performs WIRE format rule checks on IntermediaryInstitution and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (ii *IntermediaryInstitution) Validate() error {
-	if err := ii.fieldInclusion(); err != nil {
-		return err
-	}
+	return ii.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on IntermediaryInstitution and returns every error found
+func (ii *IntermediaryInstitution) validateAll() base.ErrorList {
+	errs := ii.fieldInclusion()
 	if ii.tag != TagIntermediaryInstitution {
-		return fieldError("tag", ErrValidTagForType, ii.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ii.tag))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, ii.CoverPayment.SwiftFieldTag)
+		errs.Add(fieldError("SwiftFieldTag", err, ii.CoverPayment.SwiftFieldTag))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, ii.CoverPayment.SwiftLineOne)
+		errs.Add(fieldError("SwiftLineOne", err, ii.CoverPayment.SwiftLineOne))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, ii.CoverPayment.SwiftLineTwo)
+		errs.Add(fieldError("SwiftLineTwo", err, ii.CoverPayment.SwiftLineTwo))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, ii.CoverPayment.SwiftLineThree)
+		errs.Add(fieldError("SwiftLineThree", err, ii.CoverPayment.SwiftLineThree))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, ii.CoverPayment.SwiftLineFour)
+		errs.Add(fieldError("SwiftLineFour", err, ii.CoverPayment.SwiftLineFour))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineFive); err != nil {
-		return fieldError("SwiftLineFive", err, ii.CoverPayment.SwiftLineFive)
+		errs.Add(fieldError("SwiftLineFive", err, ii.CoverPayment.SwiftLineFive))
 	}
-	return nil
+	errs = append(errs, ii.validateCoverPaymentParty(ii.CoverPayment)...)
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ii *IntermediaryInstitution) fieldInclusion() error {
+func (ii *IntermediaryInstitution) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if ii.CoverPayment.SwiftLineSix != "" {
-		return fieldError("SwiftLineSix", ErrInvalidProperty, ii.CoverPayment.SwiftLineSix)
+		errs.Add(fieldError("SwiftLineSix", ErrInvalidProperty, ii.CoverPayment.SwiftLineSix))
 	}
-	return nil
+	return errs
 }
 
 // SwiftFieldTagField gets a string of the SwiftFieldTag field
@@ -0,0 +1,1345 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The ISO 20022 types model the subset of each message's elements used by the Fedwire Funds Service, and convert
+// between those messages and the FAIM tag format of FEDWireMessage.
+
+const (
+	// isoDateLayout is the layout of an ISO 20022 ISODate
+	isoDateLayout = "2006-01-02"
+	// isoDateTimeLayout is the layout of an ISO 20022 ISODateTime
+	isoDateTimeLayout = "2006-01-02T15:04:05-07:00"
+	// isoClearingSystemFedwire is the ClearingSystemIdentification code of the Fedwire Funds Service
+	isoClearingSystemFedwire = "FDW"
+	// isoMemberIDABA is the ClearingSystemIdentification code of an ABA routing number
+	isoMemberIDABA = "USABA"
+	// isoMemberIDCHIPS is the ClearingSystemIdentification code of a CHIPS participant
+	isoMemberIDCHIPS = "USPID"
+	// isoNotProvided is the EndToEndIdentification of a message without one
+	isoNotProvided = "NOTPROVIDED"
+)
+
+// fedwireInvalidCharacters matches a character a FEDWireMessage tag cannot hold: the delimiters *, { and } of the
+// FAIM format, or a character outside the alphanumeric character set of its tags
+var fedwireInvalidCharacters = regexp.MustCompile(`[^ \w!"#$%&'()+,\-./:;<>=?@\[\\\]^_|~\x60]`)
+
+// ISOOpts are the elements of an ISO 20022 message which a FEDWireMessage does not hold
+type ISOOpts struct {
+	// CreationTime is the CreationDateTime of the message, which defaults to now
+	CreationTime time.Time `json:"creationTime,omitempty"`
+	// UETR, when not empty, is the Unique End-to-end Transaction Reference of the message
+	UETR string `json:"uetr,omitempty"`
+	// TestProductionCode is the SenderSupplied TestProductionCode of a FEDWireMessage read from ISO 20022, which
+	// does not carry it. The default is EnvironmentProduction.
+	TestProductionCode string `json:"testProductionCode,omitempty"`
+}
+
+// testProductionCode returns the TestProductionCode of opts, defaulting to EnvironmentProduction
+func (opts ISOOpts) testProductionCode() string {
+	if opts.TestProductionCode == "" {
+		return EnvironmentProduction
+	}
+	return opts.TestProductionCode
+}
+
+// creationTime returns the CreationTime of opts in America/New_York
+func (opts ISOOpts) creationTime() (string, error) {
+	loc, err := FedwireLocation()
+	if err != nil {
+		return "", err
+	}
+	t := opts.CreationTime
+	if t.IsZero() {
+		t = time.Now()
+	}
+	return t.In(loc).Format(isoDateTimeLayout), nil
+}
+
+// ConversionWarning is information which could not be converted between a FEDWireMessage and another message
+// format exactly, such as a tag without a corresponding element or a value truncated to fit
+type ConversionWarning struct {
+	// Tag is the FEDWireMessage tag of the information, such as {6000}
+	Tag string `json:"tag,omitempty"`
+	// Field is the path of the information, such as OriginatorToBeneficiary.LineOne or Cdtr.Nm
+	Field string `json:"field,omitempty"`
+	// Message describes what was not converted
+	Message string `json:"message"`
+}
+
+// String returns the tag, field and message of w
+func (w ConversionWarning) String() string {
+	return strings.TrimSpace(strings.Join([]string{w.Tag, w.Field, w.Message}, " "))
+}
+
+// conversionWarnings collects the ConversionWarnings of a conversion
+type conversionWarnings []ConversionWarning
+
+// add appends a warning
+func (ws *conversionWarnings) add(tag, field, format string, args ...interface{}) {
+	*ws = append(*ws, ConversionWarning{Tag: tag, Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// sanitize returns s trimmed, with each character a FEDWireMessage tag cannot hold replaced by a full stop, warning
+// for each character replaced
+func (ws *conversionWarnings) sanitize(tag, field, s string) string {
+	s = strings.TrimSpace(s)
+	replaced := make(map[string]bool)
+	return fedwireInvalidCharacters.ReplaceAllStringFunc(s, func(c string) string {
+		if !replaced[c] {
+			replaced[c] = true
+			ws.add(tag, field, "character %q is replaced by a full stop", c)
+		}
+		return "."
+	})
+}
+
+// truncate returns s sanitized and cut to max characters, without the spaces a cut leaves at its end, warning when it
+// is cut
+func (ws *conversionWarnings) truncate(tag, field, s string, max int) string {
+	s = ws.sanitize(tag, field, s)
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	ws.add(tag, field, "is truncated to %d characters", max)
+	return strings.TrimRightFunc(string([]rune(s)[:max]), unicode.IsSpace)
+}
+
+// untranslated warns for each tag present in fwm which is not in translated
+func (ws *conversionWarnings) untranslated(fwm *FEDWireMessage, translated []string, format string) {
+	for _, tag := range fedWireMessageTagList() {
+		if fwm.hasTag(tag) && !associatedTags(translated).Contains(tag) {
+			ws.add(tag, fedWireMessageFieldName(tag), "is not translated to %s", format)
+		}
+	}
+}
+
+// ISOAmount is an ActiveCurrencyAndAmount
+type ISOAmount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+// newISOAmount returns the ISOAmount of m
+func newISOAmount(m Money) (ISOAmount, error) {
+	s, err := m.decimal('.')
+	if err != nil {
+		return ISOAmount{}, err
+	}
+	return ISOAmount{Currency: m.Currency, Value: s}, nil
+}
+
+// Money returns the Money of a
+func (a ISOAmount) Money() (Money, error) {
+	return parseDecimalMoney(a.Currency, strings.TrimSpace(a.Value), '.')
+}
+
+// newISODecimalAmount returns the ISOAmount of d
+func newISODecimalAmount(d DecimalAmount) ISOAmount {
+	return ISOAmount{Currency: d.Currency, Value: d.decimal('.')}
+}
+
+// DecimalAmount returns the DecimalAmount of a, keeping up to 5 decimal places
+func (a ISOAmount) DecimalAmount() (DecimalAmount, error) {
+	return parseDecimalAmount(a.Currency, strings.TrimSpace(a.Value), '.')
+}
+
+// ISOCodeOrProprietary is a choice of an ISO 20022 code or a proprietary code
+type ISOCodeOrProprietary struct {
+	Code        string `xml:"Cd,omitempty"`
+	Proprietary string `xml:"Prtry,omitempty"`
+}
+
+// ISOPostalAddress is a PostalAddress24
+type ISOPostalAddress struct {
+	AddressType        *ISOCodeOrProprietary `xml:"AdrTp,omitempty"`
+	Department         string                `xml:"Dept,omitempty"`
+	SubDepartment      string                `xml:"SubDept,omitempty"`
+	StreetName         string                `xml:"StrtNm,omitempty"`
+	BuildingNumber     string                `xml:"BldgNb,omitempty"`
+	PostCode           string                `xml:"PstCd,omitempty"`
+	TownName           string                `xml:"TwnNm,omitempty"`
+	CountrySubDivision string                `xml:"CtrySubDvsn,omitempty"`
+	Country            string                `xml:"Ctry,omitempty"`
+	AddressLines       []string              `xml:"AdrLine,omitempty"`
+}
+
+// isoAddressLines returns the ISOPostalAddress of the non-empty lines, or nil without any
+func isoAddressLines(lines ...string) *ISOPostalAddress {
+	var adr ISOPostalAddress
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			adr.AddressLines = append(adr.AddressLines, line)
+		}
+	}
+	if adr.AddressLines == nil {
+		return nil
+	}
+	return &adr
+}
+
+// ISOGenericIdentification is a GenericIdentification of a party, account or financial institution
+type ISOGenericIdentification struct {
+	ID         string                `xml:"Id"`
+	SchemeName *ISOCodeOrProprietary `xml:"SchmeNm,omitempty"`
+	Issuer     string                `xml:"Issr,omitempty"`
+}
+
+// ISODateAndPlaceOfBirth is a DateAndPlaceOfBirth1
+type ISODateAndPlaceOfBirth struct {
+	BirthDate       string `xml:"BirthDt"`
+	ProvinceOfBirth string `xml:"PrvcOfBirth,omitempty"`
+	CityOfBirth     string `xml:"CityOfBirth"`
+	CountryOfBirth  string `xml:"CtryOfBirth"`
+}
+
+// ISOOrganisationIdentification is an OrganisationIdentification29
+type ISOOrganisationIdentification struct {
+	AnyBIC string                     `xml:"AnyBIC,omitempty"`
+	Other  []ISOGenericIdentification `xml:"Othr,omitempty"`
+}
+
+// ISOPersonIdentification is a PersonIdentification13
+type ISOPersonIdentification struct {
+	DateAndPlaceOfBirth *ISODateAndPlaceOfBirth    `xml:"DtAndPlcOfBirth,omitempty"`
+	Other               []ISOGenericIdentification `xml:"Othr,omitempty"`
+}
+
+// ISOPartyIdentification is a Party38Choice
+type ISOPartyIdentification struct {
+	Organisation *ISOOrganisationIdentification `xml:"OrgId,omitempty"`
+	Private      *ISOPersonIdentification       `xml:"PrvtId,omitempty"`
+}
+
+// ISOParty is a PartyIdentification135
+type ISOParty struct {
+	Name               string                  `xml:"Nm,omitempty"`
+	PostalAddress      *ISOPostalAddress       `xml:"PstlAdr,omitempty"`
+	Identification     *ISOPartyIdentification `xml:"Id,omitempty"`
+	CountryOfResidence string                  `xml:"CtryOfRes,omitempty"`
+	ContactDetails     *ISOContact             `xml:"CtctDtls,omitempty"`
+}
+
+// ISOContact is a Contact4
+type ISOContact struct {
+	Name         string            `xml:"Nm,omitempty"`
+	PhoneNumber  string            `xml:"PhneNb,omitempty"`
+	MobileNumber string            `xml:"MobNb,omitempty"`
+	FaxNumber    string            `xml:"FaxNb,omitempty"`
+	EmailAddress string            `xml:"EmailAdr,omitempty"`
+	Other        []ISOOtherContact `xml:"Othr,omitempty"`
+}
+
+// ISOOtherContact is an OtherContact1
+type ISOOtherContact struct {
+	ChannelType string `xml:"ChanlTp"`
+	ID          string `xml:"Id,omitempty"`
+}
+
+// ISOAccount is a CashAccount38
+type ISOAccount struct {
+	ID ISOAccountIdentification `xml:"Id"`
+}
+
+// ISOAccountIdentification is an AccountIdentification4Choice
+type ISOAccountIdentification struct {
+	IBAN  string                    `xml:"IBAN,omitempty"`
+	Other *ISOGenericIdentification `xml:"Othr,omitempty"`
+}
+
+// isoAccount returns the ISOAccount of the account number id
+func isoAccount(id string) *ISOAccount {
+	return &ISOAccount{ID: ISOAccountIdentification{Other: &ISOGenericIdentification{ID: id}}}
+}
+
+// Number returns the IBAN or other identification of the account
+func (a *ISOAccount) Number() string {
+	if a == nil {
+		return ""
+	}
+	if a.ID.IBAN != "" {
+		return a.ID.IBAN
+	}
+	if a.ID.Other != nil {
+		return a.ID.Other.ID
+	}
+	return ""
+}
+
+// ISOClearingSystemMemberIdentification is a ClearingSystemMemberIdentification2
+type ISOClearingSystemMemberIdentification struct {
+	ClearingSystemID ISOCodeOrProprietary `xml:"ClrSysId"`
+	MemberID         string               `xml:"MmbId"`
+}
+
+// ISOFinancialInstitutionIdentification is a FinancialInstitutionIdentification18
+type ISOFinancialInstitutionIdentification struct {
+	BICFI                string                                 `xml:"BICFI,omitempty"`
+	ClearingSystemMember *ISOClearingSystemMemberIdentification `xml:"ClrSysMmbId,omitempty"`
+	Name                 string                                 `xml:"Nm,omitempty"`
+	PostalAddress        *ISOPostalAddress                      `xml:"PstlAdr,omitempty"`
+	Other                *ISOGenericIdentification              `xml:"Othr,omitempty"`
+}
+
+// ISOAgent is a BranchAndFinancialInstitutionIdentification6
+type ISOAgent struct {
+	FinancialInstitution ISOFinancialInstitutionIdentification `xml:"FinInstnId"`
+}
+
+// isoABAAgent returns the ISOAgent of an ABA routing number and short name
+func isoABAAgent(aba, name string) *ISOAgent {
+	return &ISOAgent{FinancialInstitution: ISOFinancialInstitutionIdentification{
+		ClearingSystemMember: &ISOClearingSystemMemberIdentification{
+			ClearingSystemID: ISOCodeOrProprietary{Code: isoMemberIDABA},
+			MemberID:         aba,
+		},
+		Name: strings.TrimSpace(name),
+	}}
+}
+
+// ABA returns the ABA routing number of the agent, when it is identified by one
+func (a *ISOAgent) ABA() string {
+	if a == nil || a.FinancialInstitution.ClearingSystemMember == nil ||
+		a.FinancialInstitution.ClearingSystemMember.ClearingSystemID.Code != isoMemberIDABA {
+		return ""
+	}
+	return a.FinancialInstitution.ClearingSystemMember.MemberID
+}
+
+// isoFinancialInstitutionAgent returns the ISOAgent of fi. An IdentificationCode without a corresponding element
+// is kept as the proprietary scheme of an other identification.
+func isoFinancialInstitutionAgent(fi FinancialInstitution) *ISOAgent {
+	agent := &ISOAgent{FinancialInstitution: ISOFinancialInstitutionIdentification{
+		Name:          strings.TrimSpace(fi.Name),
+		PostalAddress: isoAddressLines(fi.Address.AddressLineOne, fi.Address.AddressLineTwo, fi.Address.AddressLineThree),
+	}}
+	id := strings.TrimSpace(fi.Identifier)
+	switch fi.IdentificationCode {
+	case SWIFTBankIdentifierCode:
+		agent.FinancialInstitution.BICFI = id
+	case FEDRoutingNumber:
+		agent.FinancialInstitution.ClearingSystemMember = &ISOClearingSystemMemberIdentification{
+			ClearingSystemID: ISOCodeOrProprietary{Code: isoMemberIDABA},
+			MemberID:         id,
+		}
+	case CHIPSParticipant:
+		agent.FinancialInstitution.ClearingSystemMember = &ISOClearingSystemMemberIdentification{
+			ClearingSystemID: ISOCodeOrProprietary{Code: isoMemberIDCHIPS},
+			MemberID:         id,
+		}
+	default:
+		if id != "" {
+			agent.FinancialInstitution.Other = &ISOGenericIdentification{
+				ID:         id,
+				SchemeName: &ISOCodeOrProprietary{Proprietary: fi.IdentificationCode},
+			}
+		}
+	}
+	return agent
+}
+
+// isoPersonalIdentificationCodes are the ISO 20022 person identification codes of Personal IdentificationCodes
+var isoPersonalIdentificationCodes = map[string]string{
+	PassportNumber:          PICPassportNumber,
+	TaxIdentificationNumber: PICTaxIdentificationNumber,
+	DriversLicenseNumber:    PartyIdentifierDriversLicenseNumber,
+	AlienRegistrationNumber: PICAlienRegistrationNumber,
+}
+
+// isoPersonalParty returns the ISOParty and account of p
+func isoPersonalParty(p Personal) (ISOParty, *ISOAccount) {
+	party := ISOParty{
+		Name:          strings.TrimSpace(p.Name),
+		PostalAddress: isoAddressLines(p.Address.AddressLineOne, p.Address.AddressLineTwo, p.Address.AddressLineThree),
+	}
+	id := strings.TrimSpace(p.Identifier)
+	if id == "" {
+		return party, nil
+	}
+	switch p.IdentificationCode {
+	case DemandDepositAccountNumber:
+		return party, isoAccount(id)
+	case SWIFTBICORBEIANDAccountNumber:
+		// BIC or BEI followed by a slash and the account number
+		if i := strings.Index(id, "/"); i > 0 {
+			party.Identification = &ISOPartyIdentification{Organisation: &ISOOrganisationIdentification{AnyBIC: id[:i]}}
+			return party, isoAccount(id[i+1:])
+		}
+		return party, isoAccount(id)
+	case SWIFTBankIdentifierCode:
+		party.Identification = &ISOPartyIdentification{Organisation: &ISOOrganisationIdentification{AnyBIC: id}}
+	case CorporateIdentification:
+		party.Identification = &ISOPartyIdentification{Organisation: &ISOOrganisationIdentification{
+			Other: []ISOGenericIdentification{{ID: id}},
+		}}
+	case PassportNumber, TaxIdentificationNumber, DriversLicenseNumber, AlienRegistrationNumber:
+		party.Identification = &ISOPartyIdentification{Private: &ISOPersonIdentification{
+			Other: []ISOGenericIdentification{{
+				ID:         id,
+				SchemeName: &ISOCodeOrProprietary{Code: isoPersonalIdentificationCodes[p.IdentificationCode]},
+			}},
+		}}
+	case OtherIdentification:
+		party.Identification = &ISOPartyIdentification{Private: &ISOPersonIdentification{
+			Other: []ISOGenericIdentification{{ID: id}},
+		}}
+	default:
+		party.Identification = &ISOPartyIdentification{Organisation: &ISOOrganisationIdentification{
+			Other: []ISOGenericIdentification{{ID: id, SchemeName: &ISOCodeOrProprietary{Proprietary: p.IdentificationCode}}},
+		}}
+	}
+	return party, nil
+}
+
+// isoOptionFParty returns the ISOParty and account of oof, an OriginatorOptionF or the lines of a SWIFT field 50F
+// identified in warnings by tag and field
+func isoOptionFParty(tag, field string, oof *OriginatorOptionF, ws *conversionWarnings) (ISOParty, *ISOAccount) {
+	var party ISOParty
+	var account *ISOAccount
+	var private ISOPersonIdentification
+	var birth ISODateAndPlaceOfBirth
+
+	if id := strings.TrimSpace(oof.PartyIdentifier); strings.HasPrefix(id, "/") {
+		account = isoAccount(id[1:])
+	} else if i := strings.Index(id, "/"); i > 0 {
+		private.Other = append(private.Other, ISOGenericIdentification{
+			ID:         id[i+1:],
+			SchemeName: &ISOCodeOrProprietary{Code: id[:i]},
+		})
+	}
+	var names, lines []string
+	fields := []struct{ name, value string }{
+		{field + ".Name", oof.Name},
+		{field + ".LineOne", oof.LineOne},
+		{field + ".LineTwo", oof.LineTwo},
+		{field + ".LineThree", oof.LineThree},
+	}
+	for _, f := range fields {
+		code, value := optionFLineCode(f.value)
+		switch code {
+		case "":
+			continue
+		case OptionFName:
+			names = append(names, value)
+		case OptionFAddress:
+			lines = append(lines, value)
+		case OptionFCountryTown:
+			// CC/Town
+			if len(value) > 3 && value[2] == '/' {
+				if party.PostalAddress == nil {
+					party.PostalAddress = &ISOPostalAddress{}
+				}
+				party.PostalAddress.Country = value[:2]
+				party.PostalAddress.TownName = value[3:]
+			} else {
+				lines = append(lines, value)
+			}
+		case OptionFDOB:
+			if t, err := time.Parse(cycleDateLayout, value); err == nil {
+				birth.BirthDate = t.Format(isoDateLayout)
+			} else {
+				ws.add(tag, f.name, "is not a CCYYMMDD date of birth")
+			}
+		case OptionFBirthPlace:
+			// CC/City
+			if len(value) > 3 && value[2] == '/' {
+				birth.CountryOfBirth = value[:2]
+				birth.CityOfBirth = value[3:]
+			} else {
+				birth.CityOfBirth = value
+			}
+		case OptionFCustomerIdentificationNumber:
+			private.Other = append(private.Other, ISOGenericIdentification{
+				ID:         value,
+				SchemeName: &ISOCodeOrProprietary{Code: PICCustomerNumber},
+			})
+		case OptionFNationalIdentityNumber:
+			private.Other = append(private.Other, ISOGenericIdentification{
+				ID:         value,
+				SchemeName: &ISOCodeOrProprietary{Code: PICNationalIdentityNumber},
+			})
+		default:
+			ws.add(tag, f.name, "has no corresponding element")
+		}
+	}
+	party.Name = ws.truncate(tag, field+".Name", strings.Join(names, " "), 140)
+	if len(lines) > 0 {
+		if party.PostalAddress == nil {
+			party.PostalAddress = &ISOPostalAddress{}
+		}
+		party.PostalAddress.AddressLines = lines
+	}
+	if birth != (ISODateAndPlaceOfBirth{}) {
+		if birth.BirthDate == "" || birth.CityOfBirth == "" || birth.CountryOfBirth == "" {
+			ws.add(tag, field, "has an incomplete date and place of birth")
+		} else {
+			private.DateAndPlaceOfBirth = &birth
+		}
+	}
+	if private.DateAndPlaceOfBirth != nil || private.Other != nil {
+		party.Identification = &ISOPartyIdentification{Private: &private}
+	}
+	return party, account
+}
+
+// optionFLineCode returns the line code and value of an OriginatorOptionF line, such as 1 and SMITH JOHN for
+// 1/SMITH JOHN
+func optionFLineCode(line string) (string, string) {
+	line = strings.TrimSpace(line)
+	if len(line) < 2 || line[1] != '/' {
+		return "", ""
+	}
+	return line[:1], strings.TrimSpace(line[2:])
+}
+
+// isoJoinLines returns the non-empty lines joined by spaces
+func isoJoinLines(lines ...string) string {
+	var nonEmpty []string
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			nonEmpty = append(nonEmpty, line)
+		}
+	}
+	return strings.Join(nonEmpty, " ")
+}
+
+// isoUnstructuredRemittance returns the RemittanceInformation of the non-empty lines, each an unstructured
+// remittance, or nil without any
+func isoUnstructuredRemittance(lines ...string) *ISORemittanceInformation {
+	var rmt ISORemittanceInformation
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			rmt.Unstructured = append(rmt.Unstructured, line)
+		}
+	}
+	if rmt.Unstructured == nil {
+		return nil
+	}
+	return &rmt
+}
+
+// isoTypeCode returns the TypeCode usually sent with the BusinessFunctionCode bfc, which ISO 20022 messages do not
+// carry
+func isoTypeCode(bfc string) string {
+	switch bfc {
+	case CheckSameDaySettlement, DepositSendersAccount, FEDFundsReturned, FEDFundsSold, BankDrawDownRequest:
+		return SettlementTransfer
+	}
+	return FundsTransfer
+}
+
+// isoDate returns the CCYYMMDD date s as an ISODate
+func isoDate(s string) (string, error) {
+	t, err := time.Parse(cycleDateLayout, s)
+	if err != nil {
+		return "", ErrValidDate
+	}
+	return t.Format(isoDateLayout), nil
+}
+
+// isoDecimal returns a FAIM decimal comma number, such as an ExchangeRate, with a decimal point
+func isoDecimal(s string) string {
+	s = strings.Replace(strings.TrimSpace(s), ",", ".", 1)
+	s = strings.TrimLeft(s, "0")
+	if s == "" || s[0] == '.' {
+		s = "0" + s
+	}
+	return strings.TrimSuffix(s, ".")
+}
+
+// marshalISODocument returns the XML of an ISO 20022 Document with an XML declaration
+func marshalISODocument(doc interface{}) ([]byte, error) {
+	bs, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), bs...), nil
+}
+
+// ISOGroupHeader is the GroupHeader of a pacs message
+type ISOGroupHeader struct {
+	MessageID        string                    `xml:"MsgId"`
+	CreationDateTime string                    `xml:"CreDtTm"`
+	NumberOfTxs      string                    `xml:"NbOfTxs,omitempty"`
+	SettlementInfo   *ISOSettlementInstruction `xml:"SttlmInf,omitempty"`
+}
+
+// ISOSettlementInstruction is a SettlementInstruction7
+type ISOSettlementInstruction struct {
+	SettlementMethod string                `xml:"SttlmMtd"`
+	ClearingSystem   *ISOCodeOrProprietary `xml:"ClrSys,omitempty"`
+}
+
+// newISOGroupHeader returns the GroupHeader of a single transaction settled through the Fedwire Funds Service
+func newISOGroupHeader(messageID string, opts ISOOpts) (ISOGroupHeader, error) {
+	created, err := opts.creationTime()
+	if err != nil {
+		return ISOGroupHeader{}, err
+	}
+	return ISOGroupHeader{
+		MessageID:        messageID,
+		CreationDateTime: created,
+		NumberOfTxs:      "1",
+		SettlementInfo: &ISOSettlementInstruction{
+			SettlementMethod: "CLRG",
+			ClearingSystem:   &ISOCodeOrProprietary{Code: isoClearingSystemFedwire},
+		},
+	}, nil
+}
+
+// ISOPaymentIdentification is a PaymentIdentification7
+type ISOPaymentIdentification struct {
+	InstructionID string `xml:"InstrId,omitempty"`
+	EndToEndID    string `xml:"EndToEndId"`
+	UETR          string `xml:"UETR,omitempty"`
+}
+
+// ISOPaymentTypeInformation is a PaymentTypeInformation28
+type ISOPaymentTypeInformation struct {
+	LocalInstrument *ISOCodeOrProprietary `xml:"LclInstrm,omitempty"`
+	CategoryPurpose *ISOCodeOrProprietary `xml:"CtgyPurp,omitempty"`
+}
+
+// ISOOriginalGroupInformation identifies the message of an original transaction by its MessageIdentification,
+// the IMAD of a FEDWireMessage, and its MessageNameIdentification, such as pacs.008.001.08
+type ISOOriginalGroupInformation struct {
+	OriginalMessageID     string `xml:"OrgnlMsgId"`
+	OriginalMessageNameID string `xml:"OrgnlMsgNmId"`
+}
+
+// ISOTransactionParty is a Party40Choice, a party identified as a person or organisation, or as a financial
+// institution
+type ISOTransactionParty struct {
+	Party *ISOParty `xml:"Pty,omitempty"`
+	Agent *ISOAgent `xml:"Agt,omitempty"`
+}
+
+// ISOTransactionParties are the debtor and creditor of a transaction, their accounts and their agents
+type ISOTransactionParties struct {
+	Debtor          *ISOTransactionParty `xml:"Dbtr,omitempty"`
+	DebtorAccount   *ISOAccount          `xml:"DbtrAcct,omitempty"`
+	DebtorAgent     *ISOAgent            `xml:"DbtrAgt,omitempty"`
+	CreditorAgent   *ISOAgent            `xml:"CdtrAgt,omitempty"`
+	Creditor        *ISOTransactionParty `xml:"Cdtr,omitempty"`
+	CreditorAccount *ISOAccount          `xml:"CdtrAcct,omitempty"`
+}
+
+// ISOOriginalTransactionReference is an OriginalTransactionReference28, the payment type and parties of an
+// original transaction
+type ISOOriginalTransactionReference struct {
+	PaymentType *ISOPaymentTypeInformation `xml:"PmtTpInf,omitempty"`
+	ISOTransactionParties
+}
+
+// ISOReasonInformation is the reason for a return, cancellation or status, a code recognised from its additional
+// information when it has one
+type ISOReasonInformation struct {
+	Reason         *ISOCodeOrProprietary `xml:"Rsn,omitempty"`
+	AdditionalInfo []string              `xml:"AddtlInf,omitempty"`
+}
+
+// isoOriginalMessageNameID returns the MessageNameIdentification of the message which fwm, a reversal or request
+// for reversal, refers to: a pacs.009 for a transfer between financial institutions and otherwise a pacs.008
+func (fwm *FEDWireMessage) isoOriginalMessageNameID() string {
+	if fwm.isPacs009() {
+		return pacs009MessageNameID
+	}
+	return pacs008MessageNameID
+}
+
+// isoOriginalGroupInformation returns the OriginalGroupInformation and OriginalInterbankSettlementDate of fwm, a
+// reversal or request for reversal, from its PreviousMessageIdentifier, the IMAD of the original message
+func (fwm *FEDWireMessage) isoOriginalGroupInformation() (ISOOriginalGroupInformation, string, error) {
+	if fwm.PreviousMessageIdentifier == nil || strings.TrimSpace(fwm.PreviousMessageIdentifier.PreviousMessageIdentifier) == "" {
+		return ISOOriginalGroupInformation{}, "", fieldError("PreviousMessageIdentifier", ErrFieldRequired)
+	}
+	id := strings.TrimSpace(fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	imad, err := fedwireIMAD("PreviousMessageIdentifier.PreviousMessageIdentifier", id)
+	if err != nil {
+		return ISOOriginalGroupInformation{}, "", err
+	}
+	date, err := isoDate(imad.InputCycleDate)
+	if err != nil {
+		return ISOOriginalGroupInformation{}, "", fieldError("PreviousMessageIdentifier.PreviousMessageIdentifier", err, id)
+	}
+	info := ISOOriginalGroupInformation{OriginalMessageID: id, OriginalMessageNameID: fwm.isoOriginalMessageNameID()}
+	return info, date, nil
+}
+
+// isoTransactionParties returns the Originator, Beneficiary and their financial institutions as the parties of
+// fwm. They are financial institutions for a transfer between financial institutions, and the Originator is
+// otherwise instructing when fwm has none.
+func (fwm *FEDWireMessage) isoTransactionParties(instructing *ISOAgent, ws *conversionWarnings) ISOTransactionParties {
+	var parties ISOTransactionParties
+	agents := fwm.isPacs009()
+	switch {
+	case fwm.Originator != nil && agents:
+		agent, account := isoPersonalAgent(fwm.Originator.Personal)
+		parties.Debtor, parties.DebtorAccount = &ISOTransactionParty{Agent: &agent}, account
+	case fwm.Originator != nil:
+		party, account := isoPersonalParty(fwm.Originator.Personal)
+		parties.Debtor, parties.DebtorAccount = &ISOTransactionParty{Party: &party}, account
+	case fwm.OriginatorOptionF != nil:
+		party, account := isoOptionFParty(TagOriginatorOptionF, "OriginatorOptionF", fwm.OriginatorOptionF, ws)
+		parties.Debtor, parties.DebtorAccount = &ISOTransactionParty{Party: &party}, account
+	default:
+		parties.Debtor = &ISOTransactionParty{Agent: instructing}
+	}
+	if fwm.OriginatorFI != nil {
+		parties.DebtorAgent = isoFinancialInstitutionAgent(fwm.OriginatorFI.FinancialInstitution)
+	}
+	if fwm.BeneficiaryFI != nil {
+		parties.CreditorAgent = isoFinancialInstitutionAgent(fwm.BeneficiaryFI.FinancialInstitution)
+	}
+	switch {
+	case fwm.Beneficiary != nil && agents:
+		agent, account := isoPersonalAgent(fwm.Beneficiary.Personal)
+		parties.Creditor, parties.CreditorAccount = &ISOTransactionParty{Agent: &agent}, account
+	case fwm.Beneficiary != nil:
+		party, account := isoPersonalParty(fwm.Beneficiary.Personal)
+		parties.Creditor, parties.CreditorAccount = &ISOTransactionParty{Party: &party}, account
+	}
+	return parties
+}
+
+// ISO 20022 Document namespaces of the messages ReadISO20022 converts
+const (
+	pacs008Namespace = "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"
+	pacs009Namespace = "urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08"
+	pacs004Namespace = "urn:iso:std:iso:20022:tech:xsd:pacs.004.001.10"
+	camt056Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.056.001.08"
+	pain013Namespace = "urn:iso:std:iso:20022:tech:xsd:pain.013.001.07"
+	pain014Namespace = "urn:iso:std:iso:20022:tech:xsd:pain.014.001.07"
+)
+
+// MessageNameIdentifications of the messages a FEDWireMessage converts to, which a reversal, request for reversal,
+// drawdown response or status report refers to as its original message
+const (
+	pacs008MessageNameID = "pacs.008.001.08"
+	pacs009MessageNameID = "pacs.009.001.08"
+	pacs004MessageNameID = "pacs.004.001.10"
+	camt056MessageNameID = "camt.056.001.08"
+	pain013MessageNameID = "pain.013.001.07"
+	pain014MessageNameID = "pain.014.001.07"
+)
+
+// ReadISO20022 reads an ISO 20022 Document, a pacs.008, pacs.009, pacs.004, camt.056, pain.013 or pain.014 message,
+// and converts it to a FEDWireMessage.
+//
+// Elements without a corresponding tag, and values truncated to fit one, are returned as warnings. The
+// TestProductionCode of opts sets the environment of the FEDWireMessage.
+func ReadISO20022(r io.Reader, opts ISOOpts) (FEDWireMessage, []ConversionWarning, error) {
+	bs, err := ioutil.ReadAll(r)
+	if err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	ns, err := isoDocumentNamespace(bs)
+	if err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	switch ns {
+	case pacs008Namespace:
+		var doc Pacs008Document
+		if err := xml.Unmarshal(bs, &doc); err != nil {
+			return FEDWireMessage{}, nil, err
+		}
+		return doc.FEDWireMessage(opts)
+	case pacs009Namespace:
+		var doc Pacs009Document
+		if err := xml.Unmarshal(bs, &doc); err != nil {
+			return FEDWireMessage{}, nil, err
+		}
+		return doc.FEDWireMessage(opts)
+	case pacs004Namespace:
+		var doc Pacs004Document
+		if err := xml.Unmarshal(bs, &doc); err != nil {
+			return FEDWireMessage{}, nil, err
+		}
+		return doc.FEDWireMessage(opts)
+	case camt056Namespace:
+		var doc Camt056Document
+		if err := xml.Unmarshal(bs, &doc); err != nil {
+			return FEDWireMessage{}, nil, err
+		}
+		return doc.FEDWireMessage(opts)
+	case pain013Namespace:
+		var doc Pain013Document
+		if err := xml.Unmarshal(bs, &doc); err != nil {
+			return FEDWireMessage{}, nil, err
+		}
+		return doc.FEDWireMessage(opts)
+	case pain014Namespace:
+		var doc Pain014Document
+		if err := xml.Unmarshal(bs, &doc); err != nil {
+			return FEDWireMessage{}, nil, err
+		}
+		return doc.FEDWireMessage(opts)
+	}
+	return FEDWireMessage{}, nil, fieldError("Document", ErrISODocument, ns)
+}
+
+// isoDocumentNamespace returns the namespace of the root element of an XML document
+func isoDocumentNamespace(bs []byte) (string, error) {
+	d := xml.NewDecoder(bytes.NewReader(bs))
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return "", err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			return start.Name.Space, nil
+		}
+	}
+}
+
+// newISOFEDWireMessage returns the tags shared by the FEDWireMessage of each ISO 20022 payment message: the IMAD of
+// its MessageIdentification, its interbank settlement amount, its instructing and instructed agents as the sender
+// and receiver depository institutions, and the references of id.
+func newISOFEDWireMessage(header ISOGroupHeader, id ISOPaymentIdentification, amount ISOAmount, instructing, instructed *ISOAgent, opts ISOOpts, ws *conversionWarnings) (FEDWireMessage, error) {
+	fwm := FEDWireMessage{}
+	if err := fwm.setISOMessageID("GrpHdr.MsgId", header.MessageID, opts); err != nil {
+		return fwm, err
+	}
+	if err := fwm.setISOAmount("IntrBkSttlmAmt", amount); err != nil {
+		return fwm, err
+	}
+	if err := fwm.setISODepositoryInstitutions("InstgAgt", instructing, "InstdAgt", instructed, ws); err != nil {
+		return fwm, err
+	}
+	fwm.setISOReferences("PmtId.InstrId", id.InstructionID, "PmtId.EndToEndId", id.EndToEndID, ws)
+	if strings.TrimSpace(id.UETR) != "" {
+		ws.add("", "PmtId.UETR", "has no corresponding tag")
+	}
+	return fwm, nil
+}
+
+// setISOMessageID sets the IMAD of fwm to that of an ISO 20022 message identification, with a SenderSupplied whose
+// UserRequestCorrelation is the InputSequenceNumber and TestProductionCode is that of opts, which ISO 20022
+// messages do not carry
+func (fwm *FEDWireMessage) setISOMessageID(field, messageID string, opts ISOOpts) error {
+	imad, err := fedwireIMAD(field, messageID)
+	if err != nil {
+		return err
+	}
+	fwm.InputMessageAccountabilityData = imad
+	fwm.SenderSupplied = NewSenderSupplied()
+	fwm.SenderSupplied.UserRequestCorrelation = imad.InputSequenceNumber
+	fwm.SenderSupplied.TestProductionCode = opts.testProductionCode()
+	return nil
+}
+
+// setISOAmount sets the Amount of fwm to an ISO 20022 amount in US dollars
+func (fwm *FEDWireMessage) setISOAmount(field string, amount ISOAmount) error {
+	m, err := amount.Money()
+	if err != nil {
+		return fieldError(field, err, amount.Value)
+	}
+	if m.Currency != "USD" {
+		return fieldError(field, ErrNonCurrencyCode, m.Currency)
+	}
+	fwm.Amount = NewAmount()
+	if err := fwm.Amount.SetCents(m.MinorUnits); err != nil {
+		return fieldError(field, err, amount.Value)
+	}
+	return nil
+}
+
+// setISODepositoryInstitutions sets the sender and receiver depository institutions of fwm to the instructing and
+// instructed agents of an ISO 20022 message, which must be identified by ABA routing numbers
+func (fwm *FEDWireMessage) setISODepositoryInstitutions(instructingField string, instructing *ISOAgent, instructedField string, instructed *ISOAgent, ws *conversionWarnings) error {
+	aba := instructing.ABA()
+	if aba == "" {
+		return fieldError(instructingField, ErrFieldRequired)
+	}
+	fwm.SenderDepositoryInstitution = NewSenderDepositoryInstitution()
+	fwm.SenderDepositoryInstitution.SenderABANumber = aba
+	fwm.SenderDepositoryInstitution.SenderShortName = ws.truncate(TagSenderDepositoryInstitution,
+		instructingField+".FinInstnId.Nm", instructing.FinancialInstitution.Name, 18)
+	if aba = instructed.ABA(); aba == "" {
+		return fieldError(instructedField, ErrFieldRequired)
+	}
+	fwm.ReceiverDepositoryInstitution = NewReceiverDepositoryInstitution()
+	fwm.ReceiverDepositoryInstitution.ReceiverABANumber = aba
+	fwm.ReceiverDepositoryInstitution.ReceiverShortName = ws.truncate(TagReceiverDepositoryInstitution,
+		instructedField+".FinInstnId.Nm", instructed.FinancialInstitution.Name, 18)
+	return nil
+}
+
+// setISOReferences sets the SenderReference and BeneficiaryReference of fwm to the instruction and end to end
+// identifications of an ISO 20022 message. An end to end identification of NOTPROVIDED is not a reference.
+func (fwm *FEDWireMessage) setISOReferences(instructionField, instructionID, endToEndField, endToEndID string, ws *conversionWarnings) {
+	if ref := strings.TrimSpace(instructionID); ref != "" {
+		fwm.SenderReference = NewSenderReference()
+		fwm.SenderReference.SenderReference = ws.truncate(TagSenderReference, instructionField, ref, 16)
+	}
+	if ref := strings.TrimSpace(endToEndID); ref != "" && ref != isoNotProvided {
+		fwm.BeneficiaryReference = NewBeneficiaryReference()
+		fwm.BeneficiaryReference.BeneficiaryReference = ws.truncate(TagBeneficiaryReference, endToEndField, ref, 16)
+	}
+}
+
+// fedwireIMAD returns the InputMessageAccountabilityData of an ISO 20022 MessageIdentification, which the Fedwire
+// Funds Service forms from the input cycle date, input source and input sequence number
+func fedwireIMAD(field, messageID string) (*InputMessageAccountabilityData, error) {
+	messageID = strings.TrimSpace(messageID)
+	if len(messageID) != 22 {
+		return nil, fieldError(field, ErrInvalidProperty, messageID)
+	}
+	if _, err := time.Parse(cycleDateLayout, messageID[:8]); err != nil {
+		return nil, fieldError(field, ErrValidDate, messageID)
+	}
+	imad := NewInputMessageAccountabilityData()
+	imad.InputCycleDate = messageID[:8]
+	imad.InputSource = messageID[8:16]
+	imad.InputSequenceNumber = messageID[16:]
+	return imad, nil
+}
+
+// setISOReversal sets the TypeSubType, BusinessFunctionCode and PreviousMessageIdentifier of fwm, a reversal or
+// request for reversal of the original message info, with subTypeCode or priorDaySubTypeCode when the original was
+// sent on an earlier cycle date. The BusinessFunctionCode is purpose when it permits the TypeSubType, and otherwise
+// that of the original message type: a BankTransfer for a pacs.009 and a CustomerTransfer for a pacs.008, or
+// fallback when that does not permit the TypeSubType.
+func (fwm *FEDWireMessage) setISOReversal(field string, info ISOOriginalGroupInformation, purpose *ISOCodeOrProprietary, subTypeCode, priorDaySubTypeCode, fallback string) error {
+	id := strings.TrimSpace(info.OriginalMessageID)
+	original, err := fedwireIMAD(field+".OrgnlMsgId", id)
+	if err != nil {
+		return err
+	}
+	if original.InputCycleDate != fwm.InputMessageAccountabilityData.InputCycleDate {
+		subTypeCode = priorDaySubTypeCode
+	}
+	permits := func(code string) bool {
+		rule, ok := businessFunctionCodeRule(code)
+		return ok && associatedTypeSubTypes(rule.TypeSubTypes).Contains(isoTypeCode(code)+subTypeCode)
+	}
+	code := CustomerTransfer
+	if strings.HasPrefix(info.OriginalMessageNameID, "pacs.009") {
+		code = BankTransfer
+	}
+	switch {
+	case purpose != nil && permits(purpose.Proprietary):
+		code = purpose.Proprietary
+	case !permits(code) && fallback != "":
+		code = fallback
+	}
+	fwm.setISOBusinessFunctionCode(code)
+	fwm.TypeSubType.SubTypeCode = subTypeCode
+	fwm.PreviousMessageIdentifier = NewPreviousMessageIdentifier()
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = id
+	return nil
+}
+
+// setISOTransactionParties sets the Originator, Beneficiary and their financial institutions of fwm to parties.
+// A debtor or creditor which is the instructing or instructed agent without an account is not a party of fwm.
+func (fwm *FEDWireMessage) setISOTransactionParties(field string, parties ISOTransactionParties, instructing, instructed *ISOAgent, ws *conversionWarnings) {
+	bicAndAccount := fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus
+	if p := parties.Debtor; p != nil {
+		switch {
+		case p.Party != nil:
+			fwm.Originator = NewOriginator()
+			fwm.Originator.Personal = fedwirePersonal(TagOriginator, field+".Dbtr.Pty", *p.Party, parties.DebtorAccount, ws)
+		case p.Agent != nil && (parties.DebtorAccount != nil || !sameISOAgent(p.Agent, instructing)):
+			fwm.Originator = NewOriginator()
+			fwm.Originator.Personal = Personal(fedwireFinancialInstitution(TagOriginator, field+".Dbtr.Agt", p.Agent,
+				parties.DebtorAccount, bicAndAccount, ws))
+		}
+	}
+	if agent := parties.DebtorAgent; agent != nil {
+		fwm.OriginatorFI = NewOriginatorFI()
+		fwm.OriginatorFI.FinancialInstitution = fedwireFinancialInstitution(TagOriginatorFI, field+".DbtrAgt", agent,
+			nil, true, ws)
+	}
+	if agent := parties.CreditorAgent; agent != nil {
+		fwm.BeneficiaryFI = NewBeneficiaryFI()
+		fwm.BeneficiaryFI.FinancialInstitution = fedwireFinancialInstitution(TagBeneficiaryFI, field+".CdtrAgt", agent,
+			nil, true, ws)
+	}
+	if p := parties.Creditor; p != nil {
+		switch {
+		case p.Party != nil:
+			fwm.Beneficiary = NewBeneficiary()
+			fwm.Beneficiary.Personal = fedwirePersonal(TagBeneficiary, field+".Cdtr.Pty", *p.Party, parties.CreditorAccount, ws)
+		case p.Agent != nil && (parties.CreditorAccount != nil || !sameISOAgent(p.Agent, instructed)):
+			fwm.Beneficiary = NewBeneficiary()
+			fwm.Beneficiary.Personal = Personal(fedwireFinancialInstitution(TagBeneficiary, field+".Cdtr.Agt", p.Agent,
+				parties.CreditorAccount, bicAndAccount, ws))
+		}
+	}
+}
+
+// setISOBusinessFunctionCode sets the BusinessFunctionCode of fwm to code, with the TypeCode usually sent with it
+// and SubTypeCode BasicFundsTransfer
+func (fwm *FEDWireMessage) setISOBusinessFunctionCode(code string) {
+	fwm.BusinessFunctionCode = NewBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = code
+	fwm.TypeSubType = NewTypeSubType()
+	fwm.TypeSubType.TypeCode = isoTypeCode(code)
+	fwm.TypeSubType.SubTypeCode = BasicFundsTransfer
+}
+
+// fedwireLocalInstrument returns the LocalInstrument of an ISO 20022 local instrument, which is a
+// LocalInstrumentCode or otherwise the ProprietaryCode of a ProprietaryLocalInstrumentCode, or nil without one
+func fedwireLocalInstrument(li *ISOCodeOrProprietary, ws *conversionWarnings) *LocalInstrument {
+	if li == nil {
+		return nil
+	}
+	code := strings.TrimSpace(li.Proprietary)
+	if code == "" {
+		code = strings.TrimSpace(li.Code)
+	}
+	if code == "" {
+		return nil
+	}
+	local := NewLocalInstrument()
+	if code != ProprietaryLocalInstrumentCode && (&validator{}).isLocalInstrumentCode(code) == nil {
+		local.LocalInstrumentCode = code
+		return local
+	}
+	local.LocalInstrumentCode = ProprietaryLocalInstrumentCode
+	local.ProprietaryCode = ws.truncate(TagLocalInstrument, "PmtTpInf.LclInstrm", code, 35)
+	return local
+}
+
+// fedwireDependentTags are the tags checked by removeISOUnpermittedTags which require other tags, in an order where
+// a tag is checked after those it requires
+var fedwireDependentTags = []struct {
+	tag      string
+	validate func(*FEDWireMessage) error
+}{
+	{TagBeneficiaryFI, (*FEDWireMessage).validateBeneficiaryFI},
+	{TagBeneficiaryIntermediaryFI, (*FEDWireMessage).validateBeneficiaryIntermediaryFI},
+	{TagOriginatorFI, (*FEDWireMessage).validateOriginatorFI},
+	{TagInstructingFI, (*FEDWireMessage).validateInstructingFI},
+	{TagExchangeRate, (*FEDWireMessage).validateExchangeRate},
+	{TagFIIntermediaryFI, (*FEDWireMessage).validateFIIntermediaryFI},
+	{TagFIIntermediaryFIAdvice, (*FEDWireMessage).validateFIIntermediaryFIAdvice},
+	{TagFIBeneficiaryFI, (*FEDWireMessage).validateFIBeneficiaryFI},
+	{TagFIBeneficiaryFIAdvice, (*FEDWireMessage).validateFIBeneficiaryFIAdvice},
+	{TagFIBeneficiary, (*FEDWireMessage).validateFIBeneficiary},
+	{TagFIBeneficiaryAdvice, (*FEDWireMessage).validateFIBeneficiaryAdvice},
+	{TagFIPaymentMethodToBeneficiary, (*FEDWireMessage).validateFIPaymentMethodToBeneficiary},
+}
+
+// removeISOUnpermittedTags removes the tags of fwm converted from an ISO 20022 message which its
+// BusinessFunctionCode, LocalInstrument or other tags do not permit, warning for each. A LocalInstrument without
+// the tags it requires is removed first, leaving a CustomerTransferPlus without {7xxx} and {8xxx} tags.
+func (fwm *FEDWireMessage) removeISOUnpermittedTags(ws *conversionWarnings) {
+	rule, ok := fwm.businessFunctionCodeRule()
+	if !ok {
+		return
+	}
+	if li := fwm.LocalInstrument; li != nil {
+		if liRule, ok := fwm.localInstrumentRule(); ok {
+			for _, tag := range liRule.Mandatory {
+				if !fwm.hasTag(tag) {
+					ws.add(TagLocalInstrument, "LocalInstrument", "%s is not translated without %s", li.LocalInstrumentCode,
+						fedWireMessageFieldName(tag))
+					fwm.LocalInstrument = nil
+					break
+				}
+			}
+		}
+	}
+	fwm.removeISOTags(rule.Prohibited, ws, "is not permitted with BusinessFunctionCode %s", rule.BusinessFunctionCode)
+	if rule.LocalInstruments != nil {
+		if fwm.LocalInstrument == nil {
+			fwm.removeISOTags(joinTags(coverPaymentTags, remittanceTags), ws, "is not permitted without a LocalInstrument")
+		} else if liRule, ok := fwm.localInstrumentRule(); ok {
+			fwm.removeISOTags(liRule.Prohibited, ws, "is not permitted with LocalInstrument %s", liRule.LocalInstrumentCode)
+		}
+	}
+	for _, dependent := range fedwireDependentTags {
+		if !fwm.hasTag(dependent.tag) {
+			continue
+		}
+		if err := dependent.validate(fwm); err != nil {
+			fwm.removeISOTags([]string{dependent.tag}, ws, "is not translated: %v", err)
+		}
+	}
+}
+
+// removeISOTags removes each of tags present in fwm, warning with format and args
+func (fwm *FEDWireMessage) removeISOTags(tags []string, ws *conversionWarnings, format string, args ...interface{}) {
+	for _, tag := range tags {
+		if v := fwm.tagValue(tag); !v.IsNil() {
+			ws.add(tag, fedWireMessageFieldName(tag), format, args...)
+			v.Set(reflect.Zero(v.Type()))
+		}
+	}
+}
+
+// sameISOAgent returns true if a and b identify the same financial institution
+func sameISOAgent(a, b *ISOAgent) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	fa, fb := a.FinancialInstitution, b.FinancialInstitution
+	if fa.BICFI != fb.BICFI || (fa.ClearingSystemMember == nil) != (fb.ClearingSystemMember == nil) ||
+		(fa.Other == nil) != (fb.Other == nil) {
+		return false
+	}
+	if fa.ClearingSystemMember != nil && *fa.ClearingSystemMember != *fb.ClearingSystemMember {
+		return false
+	}
+	if fa.Other != nil && fa.Other.ID != fb.Other.ID {
+		return false
+	}
+	return fa.BICFI != "" || fa.ClearingSystemMember != nil || fa.Other != nil
+}
+
+// fedwireFinancialInstitution returns the FinancialInstitution of agent and its account. An agent identified by a
+// BIC and an account is SWIFTBICORBEIANDAccountNumber when bicAndAccount is true, and an agent identified only by
+// an account is DemandDepositAccountNumber.
+func fedwireFinancialInstitution(tag, field string, agent *ISOAgent, account *ISOAccount, bicAndAccount bool, ws *conversionWarnings) FinancialInstitution {
+	fin := agent.FinancialInstitution
+	fi := FinancialInstitution{
+		Name:    ws.truncate(tag, field+".FinInstnId.Nm", fin.Name, 35),
+		Address: fedwireAddress(tag, field+".FinInstnId.PstlAdr", fin.PostalAddress, ws),
+	}
+	switch {
+	case fin.BICFI != "":
+		fi.IdentificationCode = SWIFTBankIdentifierCode
+		fi.Identifier = strings.TrimSpace(fin.BICFI)
+	case fin.ClearingSystemMember != nil:
+		switch code := fin.ClearingSystemMember.ClearingSystemID.Code; code {
+		case isoMemberIDABA:
+			fi.IdentificationCode = FEDRoutingNumber
+		case isoMemberIDCHIPS:
+			fi.IdentificationCode = CHIPSParticipant
+		default:
+			ws.add(tag, field+".FinInstnId.ClrSysMmbId", "clearing system %s is not translated", code)
+		}
+		if fi.IdentificationCode != "" {
+			fi.Identifier = strings.TrimSpace(fin.ClearingSystemMember.MemberID)
+		}
+	case fin.Other != nil:
+		if s := fin.Other.SchemeName; s != nil && s.Proprietary != "" && (&validator{}).isIdentificationCode(s.Proprietary) == nil {
+			fi.IdentificationCode = s.Proprietary
+			fi.Identifier = ws.truncate(tag, field+".FinInstnId.Othr.Id", fin.Other.ID, 34)
+		} else {
+			ws.add(tag, field+".FinInstnId.Othr", "is not translated")
+		}
+	}
+	if number := strings.TrimSpace(account.Number()); number != "" {
+		switch {
+		case fi.IdentificationCode == "":
+			fi.IdentificationCode = DemandDepositAccountNumber
+			fi.Identifier = ws.truncate(tag, field+"Acct", number, 34)
+		case fi.IdentificationCode == SWIFTBankIdentifierCode && bicAndAccount:
+			fi.IdentificationCode = SWIFTBICORBEIANDAccountNumber
+			fi.Identifier = ws.truncate(tag, field+"Acct", fi.Identifier+"/"+number, 34)
+		default:
+			ws.add(tag, field+"Acct", "is not translated with identification %s", fi.IdentificationCode)
+		}
+	}
+	return fi
+}
+
+// fedwirePersonal returns the Personal of an ISO 20022 party and its account. The party is identified by its
+// account, with its BIC when it has one, and otherwise by its first identification.
+func fedwirePersonal(tag, field string, party ISOParty, account *ISOAccount, ws *conversionWarnings) Personal {
+	p := Personal{
+		Name:    ws.truncate(tag, field+".Nm", party.Name, 35),
+		Address: fedwireAddress(tag, field+".PstlAdr", party.PostalAddress, ws),
+	}
+	type identification struct{ code, id string }
+	var ids []identification
+	if id := party.Identification; id != nil {
+		if org := id.Organisation; org != nil {
+			if org.AnyBIC != "" {
+				ids = append(ids, identification{SWIFTBankIdentifierCode, org.AnyBIC})
+			}
+			for _, other := range org.Other {
+				code := CorporateIdentification
+				if s := other.SchemeName; s != nil && s.Proprietary != "" && (&validator{}).isIdentificationCode(s.Proprietary) == nil {
+					code = s.Proprietary
+				}
+				ids = append(ids, identification{code, other.ID})
+			}
+		}
+		if private := id.Private; private != nil {
+			if private.DateAndPlaceOfBirth != nil {
+				ws.add(tag, field+".Id.PrvtId.DtAndPlcOfBirth", "is not translated")
+			}
+			for _, other := range private.Other {
+				code := OtherIdentification
+				if s := other.SchemeName; s != nil {
+					for personal, iso := range isoPersonalIdentificationCodes {
+						if s.Code == iso {
+							code = personal
+						}
+					}
+				}
+				ids = append(ids, identification{code, other.ID})
+			}
+		}
+	}
+	if number := strings.TrimSpace(account.Number()); number != "" {
+		if len(ids) > 0 && ids[0].code == SWIFTBankIdentifierCode {
+			ids[0] = identification{SWIFTBICORBEIANDAccountNumber, ids[0].id + "/" + number}
+		} else {
+			ids = append([]identification{{DemandDepositAccountNumber, number}}, ids...)
+		}
+	}
+	if len(ids) > 0 {
+		p.IdentificationCode = ids[0].code
+		p.Identifier = ws.truncate(tag, field+".Id", ids[0].id, 34)
+		for _, id := range ids[1:] {
+			ws.add(tag, field+".Id", "identification %s is not translated", strings.TrimSpace(id.id))
+		}
+	}
+	if party.CountryOfResidence != "" {
+		ws.add(tag, field+".CtryOfRes", "is not translated")
+	}
+	if party.ContactDetails != nil {
+		ws.add(tag, field+".CtctDtls", "is not translated")
+	}
+	return p
+}
+
+// fedwireAddress returns the Address of adr. Its address lines are followed by its department, building and
+// street, town with its subdivision and post code, and country, which are joined onto fewer lines when there are
+// more than three.
+func fedwireAddress(tag, field string, adr *ISOPostalAddress, ws *conversionWarnings) Address {
+	lines := fedwireAddressLines(tag, field, adr, 3, ws)
+	lines = append(lines, "", "", "")
+	return Address{AddressLineOne: lines[0], AddressLineTwo: lines[1], AddressLineThree: lines[2]}
+}
+
+// fedwireAddressLines returns adr as at most count lines of 35 characters, as described by fedwireAddress
+func fedwireAddressLines(tag, field string, adr *ISOPostalAddress, count int, ws *conversionWarnings) []string {
+	if adr == nil {
+		return nil
+	}
+	if adr.AddressType != nil {
+		ws.add(tag, field+".AdrTp", "is not translated")
+	}
+	town := joinNonEmpty(", ", adr.TownName, adr.CountrySubDivision)
+	parts := append([]string{}, adr.AddressLines...)
+	parts = append(parts,
+		joinNonEmpty(", ", adr.Department, adr.SubDepartment),
+		joinNonEmpty(" ", adr.BuildingNumber, adr.StreetName),
+		joinNonEmpty(" ", town, adr.PostCode),
+		adr.Country,
+	)
+	return fedwirePackLines(tag, field, parts, 35, count, ws)
+}
+
+// fedwirePackLines returns the non-empty parts as at most count lines of width characters. When there are more
+// parts than lines, parts are joined by commas onto a line while they fit, and the parts which still do not fit
+// are joined onto the last line.
+func fedwirePackLines(tag, field string, parts []string, width, count int, ws *conversionWarnings) []string {
+	var nonEmpty []string
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	lines := nonEmpty
+	if len(nonEmpty) > count {
+		lines = nil
+		for _, part := range nonEmpty {
+			n := len(lines)
+			if n > 0 && utf8.RuneCountInString(lines[n-1])+2+utf8.RuneCountInString(part) <= width {
+				lines[n-1] += ", " + part
+			} else {
+				lines = append(lines, part)
+			}
+		}
+		if len(lines) > count {
+			lines = append(lines[:count-1], strings.Join(lines[count-1:], ", "))
+		}
+	}
+	for i := range lines {
+		lines[i] = ws.truncate(tag, field, lines[i], width)
+	}
+	return lines
+}
+
+// fedwireTextLines returns texts sanitized as lines of the widths, each text beginning a new line and broken at a
+// space where one fits. Text beyond the last line is truncated with a warning.
+func fedwireTextLines(tag, field string, texts []string, widths []int, ws *conversionWarnings) []string {
+	var lines []string
+	truncated := false
+	for _, text := range texts {
+		rest := []rune(ws.sanitize(tag, field, text))
+		for len(rest) > 0 {
+			if len(lines) == len(widths) {
+				truncated = true
+				break
+			}
+			width := widths[len(lines)]
+			if len(rest) <= width {
+				lines = append(lines, string(rest))
+				break
+			}
+			cut := width
+			if rest[width] != ' ' {
+				for i := width - 1; i > 0; i-- {
+					if rest[i] == ' ' {
+						cut = i
+						break
+					}
+				}
+			}
+			lines = append(lines, strings.TrimSpace(string(rest[:cut])))
+			rest = []rune(strings.TrimSpace(string(rest[cut:])))
+		}
+	}
+	if truncated {
+		ws.add(tag, field, "is truncated to %d lines", len(widths))
+	}
+	return lines
+}
+
+// setLines sets each of fields to the corresponding line, leaving those without one empty
+func setLines(lines []string, fields ...*string) {
+	for i, f := range fields {
+		if i < len(lines) {
+			*f = lines[i]
+		}
+	}
+}
+
+// joinNonEmpty returns the non-empty values trimmed and joined by sep
+func joinNonEmpty(sep string, values ...string) string {
+	var nonEmpty []string
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			nonEmpty = append(nonEmpty, v)
+		}
+	}
+	return strings.Join(nonEmpty, sep)
+}
+
+// fedwireUnstructuredRemittance returns the OriginatorToBeneficiary of the unstructured remittance of rmt, or nil
+// without one
+func fedwireUnstructuredRemittance(rmt *ISORemittanceInformation, ws *conversionWarnings) *OriginatorToBeneficiary {
+	if rmt == nil {
+		return nil
+	}
+	lines := fedwireTextLines(TagOriginatorToBeneficiary, "RmtInf.Ustrd", rmt.Unstructured, []int{35, 35, 35, 35}, ws)
+	if lines == nil {
+		return nil
+	}
+	ob := NewOriginatorToBeneficiary()
+	setLines(lines, &ob.LineOne, &ob.LineTwo, &ob.LineThree, &ob.LineFour)
+	return ob
+}
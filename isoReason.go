@@ -0,0 +1,156 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+)
+
+// isoReasonCode is an ISO 20022 external reason code, recognised in the free text of a ServiceMessage by the code
+// itself or by one of its keywords
+type isoReasonCode struct {
+	code        string
+	description string
+	keywords    []string
+}
+
+// isoReturnReasons are the ExternalReturnReason1Codes recognised for a pacs.004 payment return
+var isoReturnReasons = []isoReasonCode{
+	{"DUPL", "DUPLICATE PAYMENT", []string{"DUPLICATE"}},
+	{"FRAD", "FRAUDULENT ORIGIN", []string{"FRAUD"}},
+	{"FOCR", "FOLLOWING CANCELLATION REQUEST", []string{"CANCELLATION REQUEST", "REQUEST FOR REVERSAL"}},
+	{"CUST", "REQUESTED BY CUSTOMER", []string{"REQUESTED BY CUSTOMER", "CUSTOMER REQUEST"}},
+	{"AC04", "CLOSED ACCOUNT NUMBER", []string{"CLOSED ACCOUNT", "ACCOUNT CLOSED"}},
+	{"AC06", "BLOCKED ACCOUNT", []string{"BLOCKED ACCOUNT", "ACCOUNT BLOCKED", "FROZEN"}},
+	{"AC01", "INCORRECT ACCOUNT NUMBER", []string{"INCORRECT ACCOUNT", "INVALID ACCOUNT", "WRONG ACCOUNT", "NO SUCH ACCOUNT"}},
+	{"BE01", "INCONSISTENT WITH END CUSTOMER", []string{"NAME MISMATCH", "INCONSISTENT WITH END CUSTOMER"}},
+	{"AM09", "WRONG AMOUNT", []string{"WRONG AMOUNT", "INCORRECT AMOUNT"}},
+	{"RC01", "BANK IDENTIFIER INCORRECT", []string{"BANK IDENTIFIER", "INCORRECT ABA", "INVALID ABA"}},
+	{"RR04", "REGULATORY REASON", []string{"REGULATORY", "SANCTION"}},
+	{"UPAY", "UNDUE PAYMENT", []string{"UNDUE"}},
+	{"TECH", "TECHNICAL PROBLEM", []string{"TECHNICAL"}},
+}
+
+// isoCancellationReasons are the ExternalCancellationReason1Codes recognised for a camt.056 cancellation request
+var isoCancellationReasons = []isoReasonCode{
+	{"DUPL", "DUPLICATE PAYMENT", []string{"DUPLICATE"}},
+	{"FRAD", "FRAUDULENT ORIGIN", []string{"FRAUD"}},
+	{"CUST", "REQUESTED BY CUSTOMER", []string{"REQUESTED BY CUSTOMER", "CUSTOMER REQUEST"}},
+	{"AC03", "INVALID CREDITOR ACCOUNT NUMBER", []string{"INCORRECT ACCOUNT", "INVALID ACCOUNT", "WRONG ACCOUNT"}},
+	{"AM09", "WRONG AMOUNT", []string{"WRONG AMOUNT", "INCORRECT AMOUNT"}},
+	{"CURR", "INCORRECT CURRENCY", []string{"INCORRECT CURRENCY", "WRONG CURRENCY"}},
+	{"AGNT", "INCORRECT AGENT", []string{"INCORRECT AGENT", "WRONG BANK", "INCORRECT BANK"}},
+	{"UPAY", "UNDUE PAYMENT", []string{"UNDUE"}},
+	{"TECH", "TECHNICAL PROBLEM", []string{"TECHNICAL"}},
+}
+
+// recognizeISOReason returns the reason of reasons which text begins with, such as DUPL or /DUPL/, or otherwise
+// the first whose keyword text contains
+func recognizeISOReason(text string, reasons []isoReasonCode) (isoReasonCode, bool) {
+	text = strings.ToUpper(text)
+	if fields := strings.Fields(strings.Replace(text, "/", " ", 2)); len(fields) > 0 {
+		for _, reason := range reasons {
+			if strings.TrimRight(fields[0], ":.,") == reason.code {
+				return reason, true
+			}
+		}
+	}
+	for _, reason := range reasons {
+		for _, keyword := range reason.keywords {
+			if strings.Contains(text, keyword) {
+				return reason, true
+			}
+		}
+	}
+	return isoReasonCode{}, false
+}
+
+// isoStatusReasons are the ExternalStatusReason1Codes recognised for the refusal of a pain.013 drawdown request
+var isoStatusReasons = []isoReasonCode{
+	{"AM04", "INSUFFICIENT FUNDS", []string{"INSUFFICIENT", "NSF"}},
+	{"DUPL", "DUPLICATE PAYMENT", []string{"DUPLICATE"}},
+	{"AC04", "CLOSED ACCOUNT NUMBER", []string{"CLOSED ACCOUNT", "ACCOUNT CLOSED"}},
+	{"AC06", "BLOCKED ACCOUNT", []string{"BLOCKED ACCOUNT", "ACCOUNT BLOCKED", "FROZEN"}},
+	{"AC01", "INCORRECT ACCOUNT NUMBER", []string{"INCORRECT ACCOUNT", "INVALID ACCOUNT", "WRONG ACCOUNT", "NO SUCH ACCOUNT"}},
+	{"AG01", "TRANSACTION FORBIDDEN", []string{"FORBIDDEN", "NOT AUTHORIZED", "UNAUTHORIZED", "NO AUTHORITY"}},
+	{"MD07", "END CUSTOMER DECEASED", []string{"DECEASED"}},
+	{"RR04", "REGULATORY REASON", []string{"REGULATORY", "SANCTION"}},
+	{"FRAD", "FRAUDULENT ORIGIN", []string{"FRAUD"}},
+	{"MS02", "NOT SPECIFIED REASON CUSTOMER GENERATED", []string{"DECLINED BY CUSTOMER", "CUSTOMER DECLINED", "REFUSED BY CUSTOMER"}},
+}
+
+// isoServiceMessageReason returns the ServiceMessage of fwm as the reason of a return or cancellation, or nil
+// without a ServiceMessage
+func (fwm *FEDWireMessage) isoServiceMessageReason(reasons []isoReasonCode) []ISOReasonInformation {
+	sm := fwm.ServiceMessage
+	if sm == nil {
+		return nil
+	}
+	return isoReasonInformation(reasons, sm.LineOne, sm.LineTwo, sm.LineThree, sm.LineFour, sm.LineFive, sm.LineSix,
+		sm.LineSeven, sm.LineEight, sm.LineNine, sm.LineTen, sm.LineEleven, sm.LineTwelve)
+}
+
+// isoReasonInformation returns the non-empty lines as a reason, coded when one of reasons is recognised in their
+// text, or nil without any
+func isoReasonInformation(reasons []isoReasonCode, lines ...string) []ISOReasonInformation {
+	var info ISOReasonInformation
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			info.AdditionalInfo = append(info.AdditionalInfo, line)
+		}
+	}
+	if info.AdditionalInfo == nil {
+		return nil
+	}
+	if reason, ok := recognizeISOReason(isoJoinLines(info.AdditionalInfo...), reasons); ok {
+		info.Reason = &ISOCodeOrProprietary{Code: reason.code}
+	}
+	return []ISOReasonInformation{info}
+}
+
+// fedwireReasonTexts returns the text of each reason. The additional information of a reason is its text,
+// preceded by its reason code and description when the code is not recognised in that text.
+func fedwireReasonTexts(infos []ISOReasonInformation, reasons []isoReasonCode) []string {
+	var texts []string
+	for _, info := range infos {
+		var lines []string
+		for _, line := range info.AdditionalInfo {
+			if line = strings.TrimSpace(line); line != "" {
+				lines = append(lines, line)
+			}
+		}
+		if rsn := info.Reason; rsn != nil {
+			code := strings.TrimSpace(rsn.Code)
+			if code == "" {
+				code = strings.TrimSpace(rsn.Proprietary)
+			}
+			if reason, ok := recognizeISOReason(isoJoinLines(lines...), reasons); code != "" && (!ok || reason.code != code) {
+				for _, reason := range reasons {
+					if reason.code == code {
+						code += " " + reason.description
+						break
+					}
+				}
+				lines = append([]string{code}, lines...)
+			}
+		}
+		texts = append(texts, lines...)
+	}
+	return texts
+}
+
+// fedwireServiceMessage returns the ServiceMessage of the reasons for a return or cancellation, or nil without
+// any
+func fedwireServiceMessage(field string, infos []ISOReasonInformation, reasons []isoReasonCode, ws *conversionWarnings) *ServiceMessage {
+	texts := fedwireReasonTexts(infos, reasons)
+	if texts == nil {
+		return nil
+	}
+	lines := fedwireTextLines(TagServiceMessage, field, texts, []int{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35}, ws)
+	sm := NewServiceMessage()
+	setLines(lines, &sm.LineOne, &sm.LineTwo, &sm.LineThree, &sm.LineFour, &sm.LineFive, &sm.LineSix, &sm.LineSeven,
+		&sm.LineEight, &sm.LineNine, &sm.LineTen, &sm.LineEleven, &sm.LineTwelve)
+	return sm
+}
@@ -0,0 +1,49 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecognizeISOReason(t *testing.T) {
+	cases := map[string]string{
+		"DUPL":                          "DUPL",
+		"/FRAD/ suspected":              "FRAD",
+		"AC04: account closed":          "AC04",
+		"Duplicate of an earlier wire":  "DUPL",
+		"Beneficiary account is frozen": "AC06",
+		"Per customer request":          "CUST",
+		"Invalid account number":        "AC01",
+		"Please call":                   "",
+		"DUPLEX printer":                "",
+	}
+	for text, code := range cases {
+		reason, ok := recognizeISOReason(text, isoReturnReasons)
+		require.Equal(t, code != "", ok, text)
+		require.Equal(t, code, reason.code, text)
+	}
+
+	// the cancellation reason of an incorrect account differs from the return reason
+	reason, ok := recognizeISOReason("Wrong account", isoCancellationReasons)
+	require.True(t, ok)
+	require.Equal(t, "AC03", reason.code)
+}
+
+func TestFedwireServiceMessage(t *testing.T) {
+	var ws conversionWarnings
+	require.Nil(t, fedwireServiceMessage("RtrRsnInf", nil, isoReturnReasons, &ws))
+
+	sm := fedwireServiceMessage("RtrRsnInf", []ISOReasonInformation{
+		{Reason: &ISOCodeOrProprietary{Code: "DUPL"}, AdditionalInfo: []string{"Duplicate payment"}},
+		{Reason: &ISOCodeOrProprietary{Code: "TECH"}, AdditionalInfo: []string{"Sent in error"}},
+	}, isoReturnReasons, &ws)
+	require.Equal(t, "Duplicate payment", sm.LineOne)
+	require.Equal(t, "TECH TECHNICAL PROBLEM", sm.LineTwo)
+	require.Equal(t, "Sent in error", sm.LineThree)
+	require.Empty(t, ws)
+}
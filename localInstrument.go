@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // LocalInstrument is the LocalInstrument of the wire
@@ -37,13 +40,12 @@ func NewLocalInstrument() *LocalInstrument {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (li *LocalInstrument) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 10 {
+		return NewTagWrongLengthErr(10, utf8.RuneCountInString(record))
+	}
 	li.tag = record[:6]
 	li.LocalInstrumentCode = li.parseStringField(record[6:10])
-	if delim := strings.IndexByte(record, '*'); delim > 0 {
-		li.ProprietaryCode = li.parseStringField(record[10:delim])
-	} else {
-		li.ProprietaryCode = li.parseStringField(record[10:])
-	}
+	li.ProprietaryCode = li.parseVariableStringField(record[10:])
 	return nil
 }
 
@@ -76,29 +78,33 @@ func (li *LocalInstrument) String() string {
 // Validate performs WIRE format rule checks on LocalInstrument and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (li *LocalInstrument) Validate() error {
-	if err := li.fieldInclusion(); err != nil {
-		return err
-	}
+	return li.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on LocalInstrument and returns every error found
+func (li *LocalInstrument) validateAll() base.ErrorList {
+	errs := li.fieldInclusion()
 	if li.tag != TagLocalInstrument {
-		return fieldError("tag", ErrValidTagForType, li.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, li.tag))
 	}
 	if err := li.isLocalInstrumentCode(li.LocalInstrumentCode); err != nil {
-		return fieldError("LocalInstrumentCode", err, li.LocalInstrumentCode)
+		errs.Add(fieldError("LocalInstrumentCode", err, li.LocalInstrumentCode))
 	}
 	if err := li.isAlphanumeric(li.ProprietaryCode); err != nil {
-		return fieldError("ProprietaryCode", err, li.ProprietaryCode)
+		errs.Add(fieldError("ProprietaryCode", err, li.ProprietaryCode))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
 // ProprietaryCode is only allowed if LocalInstrument Code is PROP
-func (li *LocalInstrument) fieldInclusion() error {
+func (li *LocalInstrument) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if li.LocalInstrumentCode != ProprietaryLocalInstrumentCode && li.ProprietaryCode != "" {
-		return fieldError("ProprietaryCode", ErrInvalidProperty, li.ProprietaryCode)
+		errs.Add(fieldError("ProprietaryCode", ErrInvalidProperty, li.ProprietaryCode))
 	}
-	return nil
+	return errs
 }
 
 // LocalInstrumentCodeField gets a string of LocalInstrumentCode field
@@ -0,0 +1,202 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"github.com/moov-io/base"
+)
+
+// LocalInstrumentRule lists the tags which are mandatory, optional and prohibited in a CustomerTransferPlus
+// FEDWireMessage for a LocalInstrumentCode. Every {7xxx} and {8xxx} tag appears in exactly one of Mandatory,
+// Optional or Prohibited.
+type LocalInstrumentRule struct {
+	// LocalInstrumentCode is the LocalInstrumentCode the rule applies to, empty for a message without a
+	// LocalInstrument
+	LocalInstrumentCode string `json:"localInstrumentCode"`
+	// Mandatory are the tags which must be present
+	Mandatory []string `json:"mandatory"`
+	// Optional are the {7xxx} and {8xxx} tags which may be present
+	Optional []string `json:"optional"`
+	// Prohibited are the tags which must not be present
+	Prohibited []string `json:"prohibited"`
+}
+
+// associatedTags models the tags which may be present alongside a code
+type associatedTags []string
+
+// Contains returns true if the target tag is in the associations list
+func (t associatedTags) Contains(target string) bool {
+	for _, tag := range t {
+		if tag == target {
+			return true
+		}
+	}
+	return false
+}
+
+// coverPaymentTags contains the {7xxx} cover payment tags
+var coverPaymentTags = associatedTags{
+	TagCurrencyInstructedAmount,
+	TagOrderingCustomer,
+	TagOrderingInstitution,
+	TagIntermediaryInstitution,
+	TagInstitutionAccount,
+	TagBeneficiaryCustomer,
+	TagRemittance,
+	TagSenderToReceiver,
+}
+
+// structuredRemittanceTags contains the {8300} to {8750} structured remittance tags
+var structuredRemittanceTags = associatedTags{
+	TagRemittanceOriginator,
+	TagRemittanceBeneficiary,
+	TagPrimaryRemittanceDocument,
+	TagActualAmountPaid,
+	TagGrossAmountRemittanceDocument,
+	TagAmountNegotiatedDiscount,
+	TagAdjustment,
+	TagDateRemittanceDocument,
+	TagSecondaryRemittanceDocument,
+	TagRemittanceFreeText,
+}
+
+// remittanceTags contains the {8xxx} unstructured addenda and remittance tags
+var remittanceTags = joinTags(associatedTags{TagUnstructuredAddenda, TagRelatedRemittance}, structuredRemittanceTags)
+
+// localInstrumentRules models the tags permitted for each LocalInstrumentCode of a CustomerTransferPlus, from
+// section 15 (Business Function Code Reference) of the Format Reference Guide. The {7xxx} and {8xxx} tags not listed
+// as Mandatory or Optional for a LocalInstrumentCode are Prohibited with it.
+var localInstrumentRules = []LocalInstrumentRule{
+	// without a LocalInstrument, the {7xxx} tags are optional and the {8xxx} tags are prohibited
+	newLocalInstrumentRule(LocalInstrumentRule{
+		Optional: coverPaymentTags,
+	}),
+	newLocalInstrumentRule(LocalInstrumentRule{
+		LocalInstrumentCode: ANSIX12format,
+		Mandatory:           []string{TagUnstructuredAddenda},
+	}),
+	newLocalInstrumentRule(LocalInstrumentRule{
+		LocalInstrumentCode: SequenceBCoverPaymentStructured,
+		Mandatory:           []string{TagBeneficiaryReference, TagOrderingCustomer, TagBeneficiaryCustomer},
+		Optional: []string{TagCurrencyInstructedAmount, TagOrderingInstitution, TagIntermediaryInstitution,
+			TagInstitutionAccount, TagRemittance, TagSenderToReceiver},
+		Prohibited: []string{TagCharges, TagInstructedAmount, TagExchangeRate},
+	}),
+	newLocalInstrumentRule(LocalInstrumentRule{
+		LocalInstrumentCode: GeneralXMLformat,
+		Mandatory:           []string{TagUnstructuredAddenda},
+	}),
+	newLocalInstrumentRule(LocalInstrumentRule{
+		LocalInstrumentCode: ISO20022XMLformat,
+		Mandatory:           []string{TagUnstructuredAddenda},
+	}),
+	newLocalInstrumentRule(LocalInstrumentRule{
+		LocalInstrumentCode: NarrativeText,
+		Mandatory:           []string{TagUnstructuredAddenda},
+	}),
+	newLocalInstrumentRule(LocalInstrumentRule{
+		LocalInstrumentCode: ProprietaryLocalInstrumentCode,
+	}),
+	newLocalInstrumentRule(LocalInstrumentRule{
+		LocalInstrumentCode: RemittanceInformationStructured,
+		Mandatory: []string{TagRemittanceOriginator, TagRemittanceBeneficiary, TagPrimaryRemittanceDocument,
+			TagActualAmountPaid},
+		Optional: []string{TagGrossAmountRemittanceDocument, TagAmountNegotiatedDiscount, TagAdjustment,
+			TagDateRemittanceDocument, TagSecondaryRemittanceDocument, TagRemittanceFreeText},
+	}),
+	newLocalInstrumentRule(LocalInstrumentRule{
+		LocalInstrumentCode: RelatedRemittanceInformation,
+		Mandatory:           []string{TagRelatedRemittance},
+	}),
+	newLocalInstrumentRule(LocalInstrumentRule{
+		LocalInstrumentCode: STP820format,
+		Mandatory:           []string{TagUnstructuredAddenda},
+	}),
+	newLocalInstrumentRule(LocalInstrumentRule{
+		LocalInstrumentCode: SWIFTfield70,
+		Mandatory:           []string{TagUnstructuredAddenda},
+	}),
+	newLocalInstrumentRule(LocalInstrumentRule{
+		LocalInstrumentCode: UNEDIFACTformat,
+		Mandatory:           []string{TagUnstructuredAddenda},
+	}),
+}
+
+// newLocalInstrumentRule returns rule with each {7xxx} and {8xxx} tag it does not list added to Prohibited
+func newLocalInstrumentRule(rule LocalInstrumentRule) LocalInstrumentRule {
+	listed := joinTags(rule.Mandatory, rule.Optional, rule.Prohibited)
+	for _, tag := range joinTags(coverPaymentTags, remittanceTags) {
+		if !listed.Contains(tag) {
+			rule.Prohibited = append(rule.Prohibited, tag)
+		}
+	}
+	return rule
+}
+
+// localInstrumentRule returns the LocalInstrumentRule for code
+func localInstrumentRule(code string) (LocalInstrumentRule, bool) {
+	for _, rule := range localInstrumentRules {
+		if rule.LocalInstrumentCode == code {
+			return rule, true
+		}
+	}
+	return LocalInstrumentRule{}, false
+}
+
+// localInstrumentRule returns the LocalInstrumentRule for the LocalInstrument of fwm, or the rule without a
+// LocalInstrumentCode when fwm has no LocalInstrument
+func (fwm *FEDWireMessage) localInstrumentRule() (LocalInstrumentRule, bool) {
+	if fwm.LocalInstrument == nil {
+		return localInstrumentRule("")
+	}
+	if fwm.LocalInstrument.LocalInstrumentCode == "" {
+		// a blank LocalInstrumentCode is reported by LocalInstrument.Validate
+		return LocalInstrumentRule{}, false
+	}
+	return localInstrumentRule(fwm.LocalInstrument.LocalInstrumentCode)
+}
+
+// checkMandatoryLocalInstrumentTags checks for the tags required by the LocalInstrument of a CustomerTransferPlus
+// If LocalInstrument = ProprietaryLocalInstrumentCode, then LocalInstrument Element 02 is mandatory.
+func (fwm *FEDWireMessage) checkMandatoryLocalInstrumentTags() error {
+	rule, ok := fwm.localInstrumentRule()
+	if !ok {
+		// an invalid LocalInstrumentCode is reported by LocalInstrument.Validate
+		return nil
+	}
+	var errs base.ErrorList
+	for _, tag := range rule.Mandatory {
+		if !fwm.hasTag(tag) {
+			errs.Add(fieldError(fedWireMessageFieldName(tag), ErrFieldRequired))
+		}
+	}
+	if rule.LocalInstrumentCode == ProprietaryLocalInstrumentCode && fwm.LocalInstrument.ProprietaryCode == "" {
+		errs.Add(fieldError("ProprietaryCode", ErrFieldRequired))
+	}
+	return errorList(errs)
+}
+
+// checkLocalInstrumentTags ensures no tag prohibited by the LocalInstrument of a CustomerTransferPlus is present,
+// returning an ErrInvalidPropertyForProperty naming each tag which is, or ErrNotPermitted without a
+// LocalInstrument.
+func (fwm *FEDWireMessage) checkLocalInstrumentTags() error {
+	rule, ok := fwm.localInstrumentRule()
+	if !ok {
+		// an invalid LocalInstrumentCode is reported by LocalInstrument.Validate
+		return nil
+	}
+	var errs base.ErrorList
+	for _, tag := range rule.Prohibited {
+		switch {
+		case !fwm.hasTag(tag):
+		case fwm.LocalInstrument == nil:
+			errs.Add(fieldError(fedWireMessageFieldName(tag), ErrNotPermitted, tag))
+		default:
+			errs.Add(NewErrInvalidPropertyForProperty(fedWireMessageFieldName(tag), tag,
+				"LocalInstrumentCode", rule.LocalInstrumentCode))
+		}
+	}
+	return errorList(errs)
+}
@@ -0,0 +1,145 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+// mockLocalInstrumentTagsData creates a CustomerTransferPlus FEDWireMessage holding every {7xxx} and {8xxx} tag
+func mockLocalInstrumentTagsData(code string) FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	li := mockLocalInstrument()
+	li.LocalInstrumentCode = code
+	fwm.LocalInstrument = li
+	fwm.CurrencyInstructedAmount = mockCurrencyInstructedAmount()
+	fwm.OrderingCustomer = mockOrderingCustomer()
+	fwm.OrderingInstitution = mockOrderingInstitution()
+	fwm.IntermediaryInstitution = mockIntermediaryInstitution()
+	fwm.InstitutionAccount = mockInstitutionAccount()
+	fwm.BeneficiaryCustomer = mockBeneficiaryCustomer()
+	fwm.Remittance = mockRemittance()
+	fwm.SenderToReceiver = mockSenderToReceiver()
+	fwm.UnstructuredAddenda = mockUnstructuredAddenda()
+	fwm.RelatedRemittance = mockRelatedRemittance()
+	fwm.RemittanceOriginator = mockRemittanceOriginator()
+	fwm.RemittanceBeneficiary = mockRemittanceBeneficiary()
+	fwm.PrimaryRemittanceDocument = mockPrimaryRemittanceDocument()
+	fwm.ActualAmountPaid = mockActualAmountPaid()
+	fwm.GrossAmountRemittanceDocument = mockGrossAmountRemittanceDocument()
+	fwm.AmountNegotiatedDiscount = mockAmountNegotiatedDiscount()
+	fwm.Adjustment = mockAdjustment()
+	fwm.DateRemittanceDocument = mockDateRemittanceDocument()
+	fwm.SecondaryRemittanceDocument = mockSecondaryRemittanceDocument()
+	fwm.RemittanceFreeText = mockRemittanceFreeText()
+	return fwm
+}
+
+// TestFEDWireMessage_checkLocalInstrumentTags validates each LocalInstrumentCode only permits its own {7xxx} and {8xxx} tags
+func TestFEDWireMessage_checkLocalInstrumentTags(t *testing.T) {
+	for _, rule := range localInstrumentRules {
+		fwm := mockLocalInstrumentTagsData(rule.LocalInstrumentCode)
+		if rule.LocalInstrumentCode == "" {
+			fwm.LocalInstrument = nil
+		}
+		permitted := joinTags(rule.Mandatory, rule.Optional)
+		var present int
+		for _, tag := range rule.Prohibited {
+			if fwm.hasTag(tag) {
+				present++
+			}
+		}
+
+		err := fwm.checkLocalInstrumentTags()
+
+		errs, ok := err.(base.ErrorList)
+		require.True(t, ok, "%s: %T", rule.LocalInstrumentCode, err)
+		require.Len(t, errs, present, rule.LocalInstrumentCode)
+		for _, err := range errs {
+			if fwm.LocalInstrument == nil {
+				e, ok := err.(*FieldError)
+				require.True(t, ok, "%T", err)
+				require.Equal(t, ErrNotPermitted, e.Err)
+				require.False(t, permitted.Contains(e.Value.(string)), "%v", err)
+				continue
+			}
+			e, ok := err.(ErrInvalidPropertyForProperty)
+			require.True(t, ok, "%s: %T", rule.LocalInstrumentCode, err)
+			require.False(t, permitted.Contains(e.PropertyValue), "%s: %v", rule.LocalInstrumentCode, err)
+			require.Equal(t, rule.LocalInstrumentCode, e.SecondPropertyValue)
+		}
+	}
+}
+
+// TestLocalInstrumentRules validates each rule lists every {7xxx} and {8xxx} tag exactly once
+func TestLocalInstrumentRules(t *testing.T) {
+	tags := joinTags(coverPaymentTags, remittanceTags)
+	require.Len(t, tags, 20)
+	for _, rule := range localInstrumentRules {
+		listed := joinTags(rule.Mandatory, rule.Optional, rule.Prohibited)
+		for _, tag := range tags {
+			n := 0
+			for _, l := range listed {
+				if l == tag {
+					n++
+				}
+			}
+			require.Equal(t, 1, n, "%s: %s", rule.LocalInstrumentCode, tag)
+		}
+	}
+}
+
+// TestFEDWireMessage_checkLocalInstrumentTagsNames validates the error names the tag and the LocalInstrumentCode
+func TestFEDWireMessage_checkLocalInstrumentTagsNames(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	li := mockLocalInstrument()
+	li.LocalInstrumentCode = SequenceBCoverPaymentStructured
+	fwm.LocalInstrument = li
+	fwm.OrderingCustomer = mockOrderingCustomer()
+	fwm.RemittanceOriginator = mockRemittanceOriginator()
+
+	err := fwm.checkLocalInstrumentTags()
+
+	require.EqualError(t, err, "RemittanceOriginator: {8300} is not valid for LocalInstrumentCode: COVS")
+
+	li.LocalInstrumentCode = ANSIX12format
+	fwm.OrderingCustomer = nil
+	fwm.RemittanceOriginator = nil
+	fwm.UnstructuredAddenda = mockUnstructuredAddenda()
+	fwm.RelatedRemittance = mockRelatedRemittance()
+
+	err = fwm.checkLocalInstrumentTags()
+
+	require.EqualError(t, err, "RelatedRemittance: {8250} is not valid for LocalInstrumentCode: ANSI")
+
+	fwm.RelatedRemittance = nil
+
+	require.NoError(t, fwm.checkLocalInstrumentTags())
+}
+
+// TestFEDWireMessage_checkLocalInstrumentTagsMissing validates the {8xxx} tags are not permitted without a
+// LocalInstrument, and the tags are not checked with an unknown LocalInstrumentCode
+func TestFEDWireMessage_checkLocalInstrumentTagsMissing(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	fwm.OrderingCustomer = mockOrderingCustomer()
+	fwm.UnstructuredAddenda = mockUnstructuredAddenda()
+
+	require.EqualError(t, fwm.checkLocalInstrumentTags(),
+		fieldError("UnstructuredAddenda", ErrNotPermitted, TagUnstructuredAddenda).Error())
+
+	fwm.UnstructuredAddenda = nil
+
+	require.NoError(t, fwm.checkLocalInstrumentTags())
+
+	fwm = mockLocalInstrumentTagsData("ZZZZ")
+
+	require.NoError(t, fwm.checkLocalInstrumentTags())
+}
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // MessageDisposition is the message disposition of the wire
@@ -43,12 +46,16 @@ func NewMessageDisposition() *MessageDisposition {
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
-func (md *MessageDisposition) Parse(record string) {
+func (md *MessageDisposition) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 11 {
+		return NewTagWrongLengthErr(11, utf8.RuneCountInString(record))
+	}
 	md.tag = record[:6]
 	md.FormatVersion = md.parseStringField(record[6:8])
 	md.TestProductionCode = md.parseStringField(record[8:9])
 	md.MessageDuplicationCode = md.parseStringField(record[9:10])
 	md.MessageStatusIndicator = md.parseStringField(record[10:11])
+	return nil
 }
 
 func (md *MessageDisposition) UnmarshalJSON(data []byte) error {
@@ -80,11 +87,16 @@ func (md *MessageDisposition) String() string {
 // Validate performs WIRE format rule checks on MessageDisposition and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (md *MessageDisposition) Validate() error {
-	// Currently no validation as the FED is responsible for the values
+	return md.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on MessageDisposition and returns every error found
+func (md *MessageDisposition) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if md.tag != TagMessageDisposition {
-		return fieldError("tag", ErrValidTagForType, md.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, md.tag))
 	}
-	return nil
+	return errs
 }
 
 // MessageDispositionFormatVersionField gets a string of the FormatVersion field
@@ -0,0 +1,202 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/currency"
+)
+
+// Money is an amount of a currency held as an integer number of the currency's minor units, such as cents for USD
+// or yen for JPY, so amounts convert between the conventions of each tag without floating point rounding.
+type Money struct {
+	// Currency is the ISO 4217 currency code, such as USD
+	Currency string `json:"currency"`
+	// MinorUnits is the amount in the currency's minor units, such as 123456 for $1,234.56
+	MinorUnits int64 `json:"minorUnits"`
+}
+
+// USD returns Money of cents in US Dollars
+func USD(cents int64) Money {
+	return Money{Currency: "USD", MinorUnits: cents}
+}
+
+// String returns the currency code and the amount with a decimal point, such as USD 1234.56
+func (m Money) String() string {
+	s, err := m.decimal('.')
+	if err != nil {
+		return fmt.Sprintf("%s %d", m.Currency, m.MinorUnits)
+	}
+	return m.Currency + " " + s
+}
+
+// decimal returns the amount of m with marker separating the minor units, such as 1234,56 when marker is a
+// decimal comma. Currencies without minor units, such as JPY, have no marker.
+func (m Money) decimal(marker byte) (string, error) {
+	scale, err := currencyMinorUnits(m.Currency)
+	if err != nil {
+		return "", err
+	}
+	if m.MinorUnits < 0 {
+		return "", ErrAmountRange
+	}
+	s := strconv.FormatInt(m.MinorUnits, 10)
+	if scale == 0 {
+		return s, nil
+	}
+	if len(s) <= scale {
+		s = strings.Repeat("0", scale-len(s)+1) + s
+	}
+	return s[:len(s)-scale] + string(marker) + s[len(s)-scale:], nil
+}
+
+// currencyMinorUnits returns the number of decimal places of the ISO 4217 currency code
+func currencyMinorUnits(code string) (int, error) {
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		return 0, ErrNonCurrencyCode
+	}
+	scale, _ := currency.Standard.Rounding(unit)
+	return scale, nil
+}
+
+// parseDecimalMoney parses s, an amount of the currency code with marker separating the whole and fractional
+// units, such as 1234,56. Fractional digits beyond the currency's minor units must be zero.
+func parseDecimalMoney(code, s string, marker byte) (Money, error) {
+	scale, err := currencyMinorUnits(code)
+	if err != nil {
+		return Money{}, err
+	}
+	whole, fraction := s, ""
+	if i := strings.IndexByte(s, marker); i >= 0 {
+		whole, fraction = s[:i], s[i+1:]
+	}
+	if whole == "" || !isDigits(whole) || (fraction != "" && !isDigits(fraction)) {
+		return Money{}, ErrNonAmount
+	}
+	if len(fraction) > scale {
+		if strings.Trim(fraction[scale:], "0") != "" {
+			return Money{}, ErrAmountPrecision
+		}
+		fraction = fraction[:scale]
+	}
+	fraction += strings.Repeat("0", scale-len(fraction))
+	n, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil {
+		return Money{}, ErrAmountRange
+	}
+	return Money{Currency: code, MinorUnits: n}, nil
+}
+
+// DecimalAmount is an amount of a currency held as an integer number of units of 10^-Scale, such as 123456789 with
+// a Scale of 5 for USD 1234.56789. It holds the amounts of the remittance tags, which permit more decimal places than
+// the currency's minor units.
+type DecimalAmount struct {
+	// Currency is the ISO 4217 currency code, such as USD
+	Currency string `json:"currency"`
+	// Units is the amount in units of 10^-Scale
+	Units int64 `json:"units"`
+	// Scale is the number of decimal places of the amount
+	Scale int `json:"scale"`
+}
+
+// maxDecimalAmountScale is the number of decimal places permitted in a remittance amount
+const maxDecimalAmountScale = 5
+
+// String returns the currency code and the amount with a decimal point, such as USD 1234.56789
+func (d DecimalAmount) String() string {
+	return d.Currency + " " + d.decimal('.')
+}
+
+// decimal returns the amount of d with marker separating its Scale decimal places
+func (d DecimalAmount) decimal(marker byte) string {
+	s := strconv.FormatInt(d.Units, 10)
+	if d.Scale <= 0 {
+		return s
+	}
+	if len(s) <= d.Scale {
+		s = strings.Repeat("0", d.Scale-len(s)+1) + s
+	}
+	return s[:len(s)-d.Scale] + string(marker) + s[len(s)-d.Scale:]
+}
+
+// Money returns the Money of d. Decimal places beyond the currency's minor units must be zero.
+func (d DecimalAmount) Money() (Money, error) {
+	if d.Units < 0 {
+		return Money{}, ErrAmountRange
+	}
+	return parseDecimalMoney(d.Currency, d.decimal('.'), '.')
+}
+
+// parseDecimalAmount parses s, an amount of the currency code with marker separating the whole units from at most
+// maxDecimalAmountScale decimal places, keeping the decimal places given
+func parseDecimalAmount(code, s string, marker byte) (DecimalAmount, error) {
+	if _, err := currencyMinorUnits(code); err != nil {
+		return DecimalAmount{}, err
+	}
+	whole, fraction := s, ""
+	if i := strings.IndexByte(s, marker); i >= 0 {
+		whole, fraction = s[:i], s[i+1:]
+	}
+	if whole == "" || !isDigits(whole) || (fraction != "" && !isDigits(fraction)) {
+		return DecimalAmount{}, ErrNonAmount
+	}
+	if len(fraction) > maxDecimalAmountScale {
+		if strings.Trim(fraction[maxDecimalAmountScale:], "0") != "" {
+			return DecimalAmount{}, ErrAmountPrecision
+		}
+		fraction = fraction[:maxDecimalAmountScale]
+	}
+	n, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil {
+		return DecimalAmount{}, ErrAmountRange
+	}
+	return DecimalAmount{Currency: code, Units: n, Scale: len(fraction)}, nil
+}
+
+// formatDecimalAmount returns the amount of d with marker separating its decimal places, which must fit in max
+// characters
+func formatDecimalAmount(d DecimalAmount, marker byte, max int) (string, error) {
+	if _, err := currencyMinorUnits(d.Currency); err != nil {
+		return "", err
+	}
+	if d.Units < 0 || d.Scale < 0 {
+		return "", ErrAmountRange
+	}
+	if d.Scale > maxDecimalAmountScale {
+		return "", ErrAmountPrecision
+	}
+	s := d.decimal(marker)
+	if len(s) > max {
+		return "", ErrAmountRange
+	}
+	return s, nil
+}
+
+// formatDecimalMoney returns the amount of m with marker separating the minor units, which must fit in max
+// characters
+func formatDecimalMoney(m Money, marker byte, max int) (string, error) {
+	s, err := m.decimal(marker)
+	if err != nil {
+		return "", err
+	}
+	if len(s) > max {
+		return "", ErrAmountRange
+	}
+	return s, nil
+}
+
+// isDigits returns true if s only holds the ASCII digits 0-9
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,126 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoney_String(t *testing.T) {
+	require.Equal(t, "USD 1234.56", USD(123456).String())
+	require.Equal(t, "USD 0.05", USD(5).String())
+	require.Equal(t, "JPY 1500", Money{Currency: "JPY", MinorUnits: 1500}.String())
+	require.Equal(t, "ZZZ 12", Money{Currency: "ZZZ", MinorUnits: 12}.String())
+}
+
+func TestParseDecimalMoney(t *testing.T) {
+	cases := []struct {
+		code, amount string
+		marker       byte
+		expected     Money
+		err          error
+	}{
+		{"USD", "1234,56", ',', USD(123456), nil},
+		{"USD", "1234,5", ',', USD(123450), nil},
+		{"USD", "1234", ',', USD(123400), nil},
+		{"USD", "0,99", ',', USD(99), nil},
+		{"USD", "1234.56000", '.', USD(123456), nil},
+		{"JPY", "1500", ',', Money{Currency: "JPY", MinorUnits: 1500}, nil},
+		{"USD", "1234.56789", '.', Money{}, ErrAmountPrecision},
+		{"USD", ",99", ',', Money{}, ErrNonAmount},
+		{"USD", "1.234,56", ',', Money{}, ErrNonAmount},
+		{"USD", "12,3,4", ',', Money{}, ErrNonAmount},
+		{"USD", "99999999999999999999", ',', Money{}, ErrAmountRange},
+		{"ZZZ", "1,00", ',', Money{}, ErrNonCurrencyCode},
+	}
+	for _, tc := range cases {
+		m, err := parseDecimalMoney(tc.code, tc.amount, tc.marker)
+		require.Equal(t, tc.err, err, tc.amount)
+		require.Equal(t, tc.expected, m, tc.amount)
+	}
+}
+
+func TestParseSendersCharge(t *testing.T) {
+	m, err := ParseSendersCharge("USD0,99")
+	require.NoError(t, err)
+	require.Equal(t, USD(99), m)
+
+	_, err = ParseSendersCharge("USD")
+	require.Equal(t, ErrNonAmount, err)
+
+	s, err := FormatSendersCharge(USD(123456))
+	require.NoError(t, err)
+	require.Equal(t, "USD1234,56", s)
+
+	_, err = FormatSendersCharge(USD(-1))
+	require.Equal(t, ErrAmountRange, err)
+	_, err = FormatSendersCharge(USD(1234567890123))
+	require.Equal(t, ErrAmountRange, err)
+}
+
+func TestParseDecimalAmount(t *testing.T) {
+	cases := []struct {
+		code, amount string
+		expected     DecimalAmount
+		err          error
+	}{
+		{"USD", "1234.56789", DecimalAmount{Currency: "USD", Units: 123456789, Scale: 5}, nil},
+		{"USD", "1234.5", DecimalAmount{Currency: "USD", Units: 12345, Scale: 1}, nil},
+		{"USD", "1234", DecimalAmount{Currency: "USD", Units: 1234}, nil},
+		{"USD", "1.2345600", DecimalAmount{Currency: "USD", Units: 123456, Scale: 5}, nil},
+		{"USD", "1.234567", DecimalAmount{}, ErrAmountPrecision},
+		{"USD", ".99", DecimalAmount{}, ErrNonAmount},
+		{"USD", "99999999999999999999", DecimalAmount{}, ErrAmountRange},
+		{"ZZZ", "1.00", DecimalAmount{}, ErrNonCurrencyCode},
+	}
+	for _, tc := range cases {
+		d, err := parseDecimalAmount(tc.code, tc.amount, '.')
+		require.Equal(t, tc.err, err, tc.amount)
+		require.Equal(t, tc.expected, d, tc.amount)
+	}
+}
+
+func TestDecimalAmount_Money(t *testing.T) {
+	d := DecimalAmount{Currency: "USD", Units: 123456789, Scale: 5}
+	require.Equal(t, "USD 1234.56789", d.String())
+	_, err := d.Money()
+	require.Equal(t, ErrAmountPrecision, err)
+
+	_, err = DecimalAmount{Currency: "USD", Units: 5, Scale: 3}.Money()
+	require.Equal(t, ErrAmountPrecision, err)
+	m, err := DecimalAmount{Currency: "USD", Units: 50, Scale: 3}.Money()
+	require.NoError(t, err)
+	require.Equal(t, USD(5), m)
+}
+
+func TestRemittanceAmountMoney(t *testing.T) {
+	ra := RemittanceAmount{CurrencyCode: "USD", Amount: "1234.56"}
+
+	m, err := ra.Money()
+	require.NoError(t, err)
+	require.Equal(t, USD(123456), m)
+
+	require.NoError(t, ra.SetMoney(Money{Currency: "EUR", MinorUnits: 1}))
+	require.Equal(t, RemittanceAmount{CurrencyCode: "EUR", Amount: "0.01"}, ra)
+
+	aap := mockActualAmountPaid()
+	require.NoError(t, aap.RemittanceAmount.SetMoney(USD(250)))
+	require.NoError(t, aap.Validate())
+	require.Equal(t, "2.50", aap.RemittanceAmount.Amount)
+
+	// the Amount may have up to 5 decimal places
+	ra = RemittanceAmount{CurrencyCode: "USD", Amount: "1234.56789"}
+	_, err = ra.Money()
+	require.Equal(t, ErrAmountPrecision, err)
+	d, err := ra.DecimalAmount()
+	require.NoError(t, err)
+	require.Equal(t, DecimalAmount{Currency: "USD", Units: 123456789, Scale: 5}, d)
+
+	require.NoError(t, ra.SetDecimalAmount(DecimalAmount{Currency: "EUR", Units: 1, Scale: 4}))
+	require.Equal(t, RemittanceAmount{CurrencyCode: "EUR", Amount: "0.0001"}, ra)
+	require.Equal(t, ErrAmountPrecision, ra.SetDecimalAmount(DecimalAmount{Currency: "EUR", Units: 1, Scale: 6}))
+}
@@ -0,0 +1,365 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+	"time"
+)
+
+// mt103Format names the MT103 message in ConversionWarnings
+const mt103Format = "MT103"
+
+// mt103Tags are the tags of a FEDWireMessage which ToMT103 translates
+var mt103Tags = []string{
+	TagSenderSupplied, TagTypeSubType, TagInputMessageAccountabilityData, TagAmount, TagSenderDepositoryInstitution,
+	TagReceiverDepositoryInstitution, TagBusinessFunctionCode, TagSenderReference, TagCharges, TagInstructedAmount,
+	TagExchangeRate, TagBeneficiaryIntermediaryFI, TagBeneficiaryFI, TagBeneficiary, TagOriginator,
+	TagOriginatorOptionF, TagOriginatorFI, TagOriginatorToBeneficiary, TagFIAdditionalFIToFI,
+}
+
+// ToMT103 converts fwm, a CustomerTransfer or CustomerTransferPlus with SubTypeCode BasicFundsTransfer which is not
+// a cover payment, to a SWIFT MT103 forwarding it internationally. The Sender and Receiver of the message, the BICs
+// of the forwarding and next institutions, are those of opts.
+//
+// The SenderReference of fwm is field 20, and its InputCycleDate and Amount the value date and interbank settled
+// amount of field 32A. The InstructedAmount and ExchangeRate are fields 33B and 36, the Originator or
+// OriginatorOptionF, OriginatorFI, BeneficiaryIntermediaryFI, BeneficiaryFI and Beneficiary fields 50a, 52a, 56a,
+// 57a and 59a, and the OriginatorToBeneficiary field 70. The Charges are the details of charges and sender's
+// charges, fields 71A and 71F, with SHA details without them, and the FIAdditionalFIToFI is field 72.
+//
+// Tags without a corresponding field, and values truncated to fit one, are returned as warnings.
+func (fwm *FEDWireMessage) ToMT103(opts SWIFTOpts) (*SWIFTMessage, []ConversionWarning, error) {
+	if fwm.BusinessFunctionCode != nil {
+		code := fwm.BusinessFunctionCode.BusinessFunctionCode
+		if code != CustomerTransfer && (code != CustomerTransferPlus || fwm.isCoverPayment()) {
+			return nil, nil, fieldError("BusinessFunctionCode.BusinessFunctionCode", ErrSWIFTMessageType, code)
+		}
+	}
+	if fwm.TypeSubType != nil && fwm.TypeSubType.SubTypeCode != BasicFundsTransfer {
+		return nil, nil, fieldError("TypeSubType.SubTypeCode", ErrSWIFTMessageType, fwm.TypeSubType.SubTypeCode)
+	}
+	if err := fwm.mandatoryFields(); err != nil {
+		return nil, nil, err
+	}
+
+	var ws conversionWarnings
+	msg := &SWIFTMessage{Type: SWIFTMT103, Sender: opts.Sender, Receiver: opts.Receiver}
+	msg.add("20", fwm.swiftReference(&ws))
+	msg.add("23B", "CRED")
+	settlement, err := fwm.swiftSettlement()
+	if err != nil {
+		return nil, nil, err
+	}
+	msg.add("32A", settlement)
+	if err := fwm.swiftInstructedAmount(msg); err != nil {
+		return nil, nil, err
+	}
+	switch {
+	case fwm.Originator != nil:
+		msg.addField(swiftPersonalField(TagOriginator, "50", "K", fwm.Originator.Personal, &ws))
+	case fwm.OriginatorOptionF != nil:
+		msg.addField(swiftOptionFField(fwm.OriginatorOptionF, &ws))
+	}
+	if fwm.OriginatorFI != nil {
+		msg.addField(swiftFinancialInstitutionField(TagOriginatorFI, "52", fwm.OriginatorFI.FinancialInstitution, false, &ws))
+	}
+	if fwm.BeneficiaryIntermediaryFI != nil {
+		msg.addField(swiftFinancialInstitutionField(TagBeneficiaryIntermediaryFI, "56",
+			fwm.BeneficiaryIntermediaryFI.FinancialInstitution, true, &ws))
+	}
+	if fwm.BeneficiaryFI != nil {
+		msg.addField(swiftFinancialInstitutionField(TagBeneficiaryFI, "57", fwm.BeneficiaryFI.FinancialInstitution, true, &ws))
+	}
+	if fwm.Beneficiary != nil {
+		msg.addField(swiftPersonalField(TagBeneficiary, "59", "", fwm.Beneficiary.Personal, &ws))
+	}
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		msg.add("70", swiftTextLines(TagOriginatorToBeneficiary, "70",
+			[]string{ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour}, 4, &ws)...)
+	}
+	if err := fwm.swiftCharges(msg); err != nil {
+		return nil, nil, err
+	}
+	if fwm.FIAdditionalFIToFI != nil {
+		msg.add("72", swiftAdditionalFIToFI(TagFIAdditionalFIToFI, "72", fwm.FIAdditionalFIToFI.AdditionalFIToFI, &ws)...)
+	}
+	ws.untranslated(fwm, mt103Tags, mt103Format)
+	fwm.swiftSenderSuppliedWarnings(mt103Format, &ws)
+	return msg, ws, nil
+}
+
+// swiftReference returns the SenderReference of fwm as the sender's reference of a SWIFT message, or NONREF
+// without one
+func (fwm *FEDWireMessage) swiftReference(ws *conversionWarnings) string {
+	if fwm.SenderReference != nil {
+		if ref := swiftText(TagSenderReference, "20", fwm.SenderReference.SenderReference, 16, ws); ref != "" {
+			return ref
+		}
+	}
+	return swiftNoReference
+}
+
+// swiftSettlement returns the value date, currency and interbank settled amount of fwm, field 32A of a SWIFT message
+func (fwm *FEDWireMessage) swiftSettlement() (string, error) {
+	date, err := swiftValueDate(fwm.InputMessageAccountabilityData.InputCycleDate)
+	if err != nil {
+		return "", fieldError("InputMessageAccountabilityData.InputCycleDate", err,
+			fwm.InputMessageAccountabilityData.InputCycleDate)
+	}
+	m, err := fwm.Amount.Money()
+	if err != nil {
+		return "", fieldError("Amount.Amount", err, fwm.Amount.Amount)
+	}
+	amount, err := swiftCurrencyAmount(m)
+	if err != nil {
+		return "", fieldError("Amount.Amount", err, fwm.Amount.Amount)
+	}
+	return date + amount, nil
+}
+
+// swiftInstructedAmount adds the InstructedAmount and ExchangeRate of fwm to msg as fields 33B and 36
+func (fwm *FEDWireMessage) swiftInstructedAmount(msg *SWIFTMessage) error {
+	if fwm.InstructedAmount != nil {
+		m, err := fwm.InstructedAmount.Money()
+		if err != nil {
+			return fieldError("InstructedAmount.Amount", err, fwm.InstructedAmount.Amount)
+		}
+		amount, err := swiftCurrencyAmount(m)
+		if err != nil {
+			return fieldError("InstructedAmount.Amount", err, fwm.InstructedAmount.Amount)
+		}
+		msg.add("33B", amount)
+	}
+	if fwm.ExchangeRate != nil {
+		if rate := strings.TrimSpace(fwm.ExchangeRate.ExchangeRate); rate != "" {
+			if !strings.Contains(rate, ",") {
+				rate += ","
+			}
+			msg.add("36", rate)
+		}
+	}
+	return nil
+}
+
+// swiftCharges adds the details of charges and sender's charges of fwm to msg as fields 71A and 71F
+func (fwm *FEDWireMessage) swiftCharges(msg *SWIFTMessage) error {
+	if fwm.Charges == nil || fwm.Charges.ChargeDetails != CDBeneficiary {
+		msg.add("71A", "SHA")
+	} else {
+		msg.add("71A", "BEN")
+	}
+	if fwm.Charges == nil {
+		return nil
+	}
+	charges, err := fwm.Charges.SendersCharges()
+	if err != nil {
+		return fieldError("Charges", err)
+	}
+	for _, m := range charges {
+		charge, err := swiftCurrencyAmount(m)
+		if err != nil {
+			return fieldError("Charges", err, m.String())
+		}
+		msg.add("71F", charge)
+	}
+	return nil
+}
+
+// swiftOptionFField returns the OriginatorOptionF oof as field 50F, its party identifier followed by its numbered
+// name and address lines
+func swiftOptionFField(oof *OriginatorOptionF, ws *conversionWarnings) SWIFTField {
+	f := SWIFTField{Tag: "50F", Lines: []string{swiftText(TagOriginatorOptionF, "50F", oof.PartyIdentifier, 35, ws)}}
+	f.Lines = append(f.Lines, swiftTextLines(TagOriginatorOptionF, "50F",
+		[]string{oof.Name, oof.LineOne, oof.LineTwo, oof.LineThree}, 4, ws)...)
+	return f
+}
+
+// swiftAdditionalFIToFI returns the lines of additional information from one financial institution to another
+func swiftAdditionalFIToFI(tag, field string, info AdditionalFIToFI, ws *conversionWarnings) []string {
+	return swiftTextLines(tag, field, []string{info.LineOne, info.LineTwo, info.LineThree, info.LineFour, info.LineFive,
+		info.LineSix}, 6, ws)
+}
+
+// fedwireMT103 converts msg, an MT103, to a CustomerTransferPlus with SubTypeCode BasicFundsTransfer, translating
+// its fields as ToMT103 does
+func (msg *SWIFTMessage) fedwireMT103(opts SWIFTOpts) (FEDWireMessage, []ConversionWarning, error) {
+	fwm, err := newSWIFTFEDWireMessage(opts)
+	if err != nil {
+		return fwm, nil, err
+	}
+	fwm.TypeSubType = NewTypeSubType()
+	fwm.TypeSubType.TypeCode = FundsTransfer
+	fwm.TypeSubType.SubTypeCode = BasicFundsTransfer
+	fwm.BusinessFunctionCode = NewBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+
+	var ws conversionWarnings
+	var charges []Money
+	for _, f := range msg.Fields {
+		f = f.sanitize(&ws)
+		switch f.Tag {
+		case "20":
+			fwm.setSWIFTReference(f, &ws)
+		case "23B":
+			if code := f.value(); code != "CRED" {
+				ws.add("", f.Tag, "%s is not translated", code)
+			}
+		case "32A":
+			if err := fwm.setSWIFTSettlement(f, &ws); err != nil {
+				return fwm, nil, err
+			}
+		case "33B":
+			m, err := parseSWIFTCurrencyAmount(f.value())
+			if err != nil {
+				return fwm, nil, fieldError(f.Tag, err, f.value())
+			}
+			fwm.InstructedAmount = NewInstructedAmount()
+			if err := fwm.InstructedAmount.SetMoney(m); err != nil {
+				return fwm, nil, fieldError(f.Tag, err, f.value())
+			}
+		case "36":
+			fwm.ExchangeRate = NewExchangeRate()
+			fwm.ExchangeRate.ExchangeRate = ws.truncate(TagExchangeRate, f.Tag, f.value(), 12)
+		case "50A", "50K":
+			fwm.Originator = NewOriginator()
+			fwm.Originator.Personal = fedwireSWIFTPersonal(TagOriginator, f, &ws)
+		case "50F":
+			fwm.OriginatorOptionF = fedwireSWIFTOptionF(f, &ws)
+		case "52A", "52D":
+			fwm.OriginatorFI = NewOriginatorFI()
+			fwm.OriginatorFI.FinancialInstitution = fedwireSWIFTFinancialInstitution(TagOriginatorFI, f, &ws)
+		case "56A", "56C", "56D":
+			fwm.BeneficiaryIntermediaryFI = NewBeneficiaryIntermediaryFI()
+			fwm.BeneficiaryIntermediaryFI.FinancialInstitution = fedwireSWIFTFinancialInstitution(TagBeneficiaryIntermediaryFI, f, &ws)
+		case "57A", "57B", "57C", "57D":
+			fwm.BeneficiaryFI = NewBeneficiaryFI()
+			fwm.BeneficiaryFI.FinancialInstitution = fedwireSWIFTFinancialInstitution(TagBeneficiaryFI, f, &ws)
+		case "59", "59A", "59F":
+			fwm.Beneficiary = NewBeneficiary()
+			fwm.Beneficiary.Personal = fedwireSWIFTPersonal(TagBeneficiary, f, &ws)
+		case "70":
+			fwm.OriginatorToBeneficiary = NewOriginatorToBeneficiary()
+			ob := fwm.OriginatorToBeneficiary
+			setLines(fedwireSWIFTLines(TagOriginatorToBeneficiary, f.Tag, f.Lines, 35, 4, &ws),
+				&ob.LineOne, &ob.LineTwo, &ob.LineThree, &ob.LineFour)
+		case "71A":
+			fwm.setSWIFTChargeDetails(f, &ws)
+		case "71F":
+			m, err := parseSWIFTCurrencyAmount(f.value())
+			if err != nil {
+				return fwm, nil, fieldError(f.Tag, err, f.value())
+			}
+			charges = append(charges, m)
+		case "72":
+			fwm.FIAdditionalFIToFI = NewFIAdditionalFIToFI()
+			setSWIFTAdditionalFIToFI(TagFIAdditionalFIToFI, f, &fwm.FIAdditionalFIToFI.AdditionalFIToFI, &ws)
+		default:
+			ws.add("", f.Tag, "has no corresponding tag")
+		}
+	}
+	if fwm.Amount == nil {
+		return fwm, nil, fieldError("32A", ErrFieldRequired)
+	}
+	if fwm.Originator == nil && fwm.OriginatorOptionF == nil {
+		return fwm, nil, fieldError("50a", ErrFieldRequired)
+	}
+	if fwm.Beneficiary == nil {
+		return fwm, nil, fieldError("59a", ErrFieldRequired)
+	}
+	if err := fwm.setSWIFTSendersCharges(charges, &ws); err != nil {
+		return fwm, nil, err
+	}
+	fwm.removeISOUnpermittedTags(&ws)
+	return fwm, ws, nil
+}
+
+// setSWIFTReference sets the SenderReference of fwm to the sender's reference of a SWIFT message, field 20, unless
+// it is NONREF
+func (fwm *FEDWireMessage) setSWIFTReference(f SWIFTField, ws *conversionWarnings) {
+	if ref := f.value(); ref != "" && ref != swiftNoReference {
+		fwm.SenderReference = NewSenderReference()
+		fwm.SenderReference.SenderReference = ws.truncate(TagSenderReference, f.Tag, ref, 16)
+	}
+}
+
+// setSWIFTSettlement sets the Amount of fwm to the interbank settled amount of field 32A, which must be in US
+// dollars, warning when its value date is not the InputCycleDate
+func (fwm *FEDWireMessage) setSWIFTSettlement(f SWIFTField, ws *conversionWarnings) error {
+	value := f.value()
+	if len(value) < 10 {
+		return fieldError(f.Tag, ErrNonAmount, value)
+	}
+	date, err := time.Parse(swiftValueDateLayout, value[:6])
+	if err != nil {
+		return fieldError(f.Tag, ErrValidDate, value)
+	}
+	if cycleDate := date.Format(cycleDateLayout); cycleDate != fwm.InputMessageAccountabilityData.InputCycleDate {
+		ws.add(TagInputMessageAccountabilityData, f.Tag, "value date %s is not the InputCycleDate", cycleDate)
+	}
+	m, err := parseSWIFTCurrencyAmount(value[6:])
+	if err != nil {
+		return fieldError(f.Tag, err, value)
+	}
+	if m.Currency != "USD" {
+		return fieldError(f.Tag, ErrNonCurrencyCode, m.Currency)
+	}
+	fwm.Amount = NewAmount()
+	if err := fwm.Amount.SetCents(m.MinorUnits); err != nil {
+		return fieldError(f.Tag, err, value)
+	}
+	return nil
+}
+
+// setSWIFTChargeDetails sets the ChargeDetails of fwm to the details of charges of field 71A: BEN to CDBeneficiary
+// and SHA to CDShared, while OUR has no ChargeDetails
+func (fwm *FEDWireMessage) setSWIFTChargeDetails(f SWIFTField, ws *conversionWarnings) {
+	switch code := f.value(); code {
+	case "BEN", "SHA":
+		if fwm.Charges == nil {
+			fwm.Charges = NewCharges()
+		}
+		fwm.Charges.ChargeDetails = CDShared
+		if code == "BEN" {
+			fwm.Charges.ChargeDetails = CDBeneficiary
+		}
+	default:
+		ws.add(TagCharges, f.Tag, "%s is not translated", code)
+	}
+}
+
+// setSWIFTSendersCharges sets the SendersCharges of fwm to the sender's charges of fields 71F
+func (fwm *FEDWireMessage) setSWIFTSendersCharges(charges []Money, ws *conversionWarnings) error {
+	if len(charges) == 0 {
+		return nil
+	}
+	if fwm.Charges == nil {
+		ws.add(TagCharges, "71F", "is not translated without BEN or SHA details of charges")
+		return nil
+	}
+	if len(charges) > len(sendersChargesFieldNames) {
+		ws.add(TagCharges, "71F", "is truncated to %d charges", len(sendersChargesFieldNames))
+		charges = charges[:len(sendersChargesFieldNames)]
+	}
+	if err := fwm.Charges.SetSendersCharges(charges...); err != nil {
+		return fieldError("71F", err)
+	}
+	return nil
+}
+
+// fedwireSWIFTOptionF returns the OriginatorOptionF of field 50F, whose party identifier and numbered lines have
+// the same format
+func fedwireSWIFTOptionF(f SWIFTField, ws *conversionWarnings) *OriginatorOptionF {
+	oof := NewOriginatorOptionF()
+	lines := fedwireSWIFTLines(TagOriginatorOptionF, f.Tag, f.Lines, 35, 5, ws)
+	setLines(lines, &oof.PartyIdentifier, &oof.Name, &oof.LineOne, &oof.LineTwo, &oof.LineThree)
+	return oof
+}
+
+// setSWIFTAdditionalFIToFI sets the lines of info to those of a SWIFT field
+func setSWIFTAdditionalFIToFI(tag string, f SWIFTField, info *AdditionalFIToFI, ws *conversionWarnings) {
+	setLines(fedwireSWIFTLines(tag, f.Tag, f.Lines, 35, 6, ws), &info.LineOne, &info.LineTwo, &info.LineThree,
+		&info.LineFour, &info.LineFive, &info.LineSix)
+}
@@ -0,0 +1,292 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockMT103CustomerTransfer creates a CustomerTransferPlus whose tags each have a corresponding MT103 field
+func mockMT103CustomerTransfer() FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20190502"
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	fwm.SenderReference = mockSenderReference()
+	fwm.InstructedAmount = NewInstructedAmount()
+	fwm.InstructedAmount.CurrencyCode = "EUR"
+	fwm.InstructedAmount.Amount = "4400,00"
+	fwm.ExchangeRate = NewExchangeRate()
+	fwm.ExchangeRate.ExchangeRate = "1,1224"
+	fwm.Originator = mockOriginator()
+	fwm.Originator.Personal.IdentificationCode = DemandDepositAccountNumber
+	fwm.Originator.Personal.Identifier = "1234567890"
+	fwm.OriginatorFI = mockOriginatorFI()
+	fwm.OriginatorFI.FinancialInstitution.IdentificationCode = FEDRoutingNumber
+	fwm.OriginatorFI.FinancialInstitution.Identifier = "121042882"
+	fwm.BeneficiaryIntermediaryFI = NewBeneficiaryIntermediaryFI()
+	fwm.BeneficiaryIntermediaryFI.FinancialInstitution.IdentificationCode = SWIFTBankIdentifierCode
+	fwm.BeneficiaryIntermediaryFI.FinancialInstitution.Identifier = "DEUTDEFF"
+	fwm.BeneficiaryFI = mockBeneficiaryFI()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.IdentificationCode = DemandDepositAccountNumber
+	fwm.Beneficiary.Personal.Identifier = "DE89370400440532013000"
+	fwm.OriginatorToBeneficiary = mockOriginatorToBeneficiary()
+	fwm.Charges = NewCharges()
+	fwm.Charges.ChargeDetails = CDShared
+	fwm.Charges.SendersChargesOne = "USD12,50"
+	fwm.FIAdditionalFIToFI = mockFIAdditionalFIToFI()
+	return fwm
+}
+
+// mockSWIFTOpts creates the SWIFTOpts of fwm, forwarded from CHASUS33 to DEUTDEFFXXX
+func mockSWIFTOpts(fwm FEDWireMessage) SWIFTOpts {
+	return SWIFTOpts{
+		Sender:                         "CHASUS33",
+		Receiver:                       "DEUTDEFFXXX",
+		InputMessageAccountabilityData: fwm.InputMessageAccountabilityData,
+		SenderDepositoryInstitution:    fwm.SenderDepositoryInstitution,
+		ReceiverDepositoryInstitution:  fwm.ReceiverDepositoryInstitution,
+	}
+}
+
+// readSWIFT writes msg and reads it back with opts as a FEDWireMessage which must validate and read back unchanged
+// from a file
+func readSWIFT(t *testing.T, msg *SWIFTMessage, opts SWIFTOpts) (FEDWireMessage, []ConversionWarning) {
+	t.Helper()
+	bs, err := msg.Bytes()
+	require.NoError(t, err)
+	fwm, warnings, err := ReadSWIFT(bytes.NewReader(bs), opts)
+	require.NoError(t, err)
+	require.Equal(t, fwm, writeReadFEDWireMessage(t, fwm))
+	return fwm, warnings
+}
+
+func TestToMT103(t *testing.T) {
+	fwm := mockMT103CustomerTransfer()
+	msg, warnings, err := fwm.ToMT103(mockSWIFTOpts(fwm))
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+	require.Equal(t, SWIFTMT103, msg.Type)
+	require.Equal(t, "CHASUS33", msg.Sender)
+	require.Equal(t, "DEUTDEFFXXX", msg.Receiver)
+
+	var tags []string
+	for _, f := range msg.Fields {
+		tags = append(tags, f.Tag)
+	}
+	require.Equal(t, []string{"20", "23B", "32A", "33B", "36", "50K", "52D", "56A", "57D", "59", "70", "71A", "71F", "72"}, tags)
+	require.Equal(t, []string{"190502USD12345,67"}, msg.field("32A").Lines)
+	require.Equal(t, []string{"EUR4400,00"}, msg.field("33B").Lines)
+	require.Equal(t, []string{"/1234567890", "Name", "Address One", "Address Two", "Address Three"}, msg.field("50K").Lines)
+	require.Equal(t, "//FW121042882", msg.field("52D").value())
+	require.Equal(t, []string{"DEUTDEFF"}, msg.field("56A").Lines)
+	require.Equal(t, "/123456789", msg.field("57D").value())
+	require.Equal(t, "/DE89370400440532013000", msg.field("59").value())
+	require.Equal(t, []string{"SHA"}, msg.field("71A").Lines)
+	require.Equal(t, []string{"USD12,50"}, msg.field("71F").Lines)
+}
+
+func TestToMT103_messageType(t *testing.T) {
+	fwm := mockMT103CustomerTransfer()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BankTransfer
+	_, _, err := fwm.ToMT103(mockSWIFTOpts(fwm))
+	require.EqualError(t, err, fieldError("BusinessFunctionCode.BusinessFunctionCode", ErrSWIFTMessageType, BankTransfer).Error())
+
+	fwm = mockMT103CustomerTransfer()
+	fwm.LocalInstrument = NewLocalInstrument()
+	fwm.LocalInstrument.LocalInstrumentCode = SequenceBCoverPaymentStructured
+	_, _, err = fwm.ToMT103(mockSWIFTOpts(fwm))
+	require.Error(t, err)
+
+	fwm = mockMT103CustomerTransfer()
+	fwm.TypeSubType.SubTypeCode = ReversalTransfer
+	_, _, err = fwm.ToMT103(mockSWIFTOpts(fwm))
+	require.EqualError(t, err, fieldError("TypeSubType.SubTypeCode", ErrSWIFTMessageType, ReversalTransfer).Error())
+}
+
+func TestToMT103_warnings(t *testing.T) {
+	fwm := mockMT103CustomerTransfer()
+	fwm.SenderReference = nil
+	fwm.Originator.Personal.IdentificationCode = PassportNumber
+	fwm.Beneficiary.Personal.Name = "Name & Co"
+	fwm.BeneficiaryIntermediaryFI.FinancialInstitution.Name = "Deutsche Bank"
+	fwm.BeneficiaryReference = mockBeneficiaryReference()
+	msg, warnings, err := fwm.ToMT103(mockSWIFTOpts(fwm))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{swiftNoReference}, msg.field("20").Lines)
+	require.Equal(t, []string{"Name", "Address One", "Address Two", "Address Three"}, msg.field("50K").Lines)
+	require.Equal(t, "Name . Co", msg.field("59").Lines[1])
+	require.Contains(t, warnings, ConversionWarning{Tag: TagOriginator, Field: "50K", Message: "IdentificationCode 1 is not translated"})
+	require.Contains(t, warnings, ConversionWarning{Tag: TagBeneficiary, Field: "59",
+		Message: "has characters outside the SWIFT X character set replaced"})
+	require.Contains(t, warnings, ConversionWarning{Tag: TagBeneficiaryIntermediaryFI, Field: "56A",
+		Message: "Name and Address are not translated with a BIC"})
+	require.Contains(t, warnings, ConversionWarning{Tag: TagBeneficiaryReference, Field: "BeneficiaryReference",
+		Message: "is not translated to MT103"})
+}
+
+func TestMT103_TestProductionCode(t *testing.T) {
+	fwm := mockMT103CustomerTransfer()
+	fwm.SenderSupplied.TestProductionCode = EnvironmentTest
+	msg, warnings, err := fwm.ToMT103(mockSWIFTOpts(fwm))
+	require.NoError(t, err)
+	require.Equal(t, []ConversionWarning{{
+		Tag:     TagSenderSupplied,
+		Field:   "SenderSupplied.TestProductionCode",
+		Message: "test is not translated to MT103",
+	}}, warnings)
+
+	read, _ := readSWIFT(t, msg, mockSWIFTOpts(fwm))
+	require.Equal(t, EnvironmentProduction, read.SenderSupplied.TestProductionCode)
+
+	opts := mockSWIFTOpts(fwm)
+	opts.TestProductionCode = EnvironmentTest
+	read, _ = readSWIFT(t, msg, opts)
+	require.Equal(t, EnvironmentTest, read.SenderSupplied.TestProductionCode)
+}
+
+func TestMT103_FEDWireMessage(t *testing.T) {
+	fwm := mockMT103CustomerTransfer()
+	msg, _, err := fwm.ToMT103(mockSWIFTOpts(fwm))
+	require.NoError(t, err)
+	read, warnings := readSWIFT(t, msg, mockSWIFTOpts(fwm))
+	require.Empty(t, warnings)
+
+	require.Equal(t, fwm.InputMessageAccountabilityData, read.InputMessageAccountabilityData)
+	require.Equal(t, fwm.InputMessageAccountabilityData.InputSequenceNumber, read.SenderSupplied.UserRequestCorrelation)
+	require.Equal(t, fwm.TypeSubType, read.TypeSubType)
+	require.Equal(t, CustomerTransferPlus, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, fwm.Amount, read.Amount)
+	require.Equal(t, fwm.SenderDepositoryInstitution, read.SenderDepositoryInstitution)
+	require.Equal(t, fwm.ReceiverDepositoryInstitution, read.ReceiverDepositoryInstitution)
+	require.Equal(t, fwm.SenderReference, read.SenderReference)
+	require.Equal(t, fwm.InstructedAmount, read.InstructedAmount)
+	require.Equal(t, fwm.ExchangeRate, read.ExchangeRate)
+	require.Equal(t, fwm.Originator, read.Originator)
+	require.Equal(t, fwm.OriginatorFI, read.OriginatorFI)
+	require.Equal(t, fwm.BeneficiaryIntermediaryFI, read.BeneficiaryIntermediaryFI)
+	require.Equal(t, fwm.BeneficiaryFI, read.BeneficiaryFI)
+	require.Equal(t, fwm.Beneficiary, read.Beneficiary)
+	require.Equal(t, fwm.OriginatorToBeneficiary, read.OriginatorToBeneficiary)
+	require.Equal(t, fwm.Charges, read.Charges)
+	require.Equal(t, fwm.FIAdditionalFIToFI, read.FIAdditionalFIToFI)
+}
+
+func TestMT103_FEDWireMessage_optionF(t *testing.T) {
+	fwm := mockMT103CustomerTransfer()
+	fwm.Originator = nil
+	fwm.OriginatorOptionF = mockOriginatorOptionF()
+	fwm.Beneficiary.Personal.IdentificationCode = SWIFTBICORBEIANDAccountNumber
+	fwm.Beneficiary.Personal.Identifier = "DEUTDEFF/DE89370400440532013000"
+	fwm.Beneficiary.Personal.Name = ""
+	fwm.Beneficiary.Personal.Address = Address{}
+	msg, warnings, err := fwm.ToMT103(mockSWIFTOpts(fwm))
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+	require.Equal(t, []string{"/DE89370400440532013000", "DEUTDEFF"}, msg.field("59A").Lines)
+
+	read, _ := readSWIFT(t, msg, mockSWIFTOpts(fwm))
+	require.Equal(t, fwm.OriginatorOptionF, read.OriginatorOptionF)
+	require.Nil(t, read.Originator)
+	require.Equal(t, fwm.Beneficiary, read.Beneficiary)
+}
+
+// TestMT103_FEDWireMessage_characters ensures characters outside the SWIFT X character set are replaced, so they can
+// neither forge a tag nor make the written file unreadable
+func TestMT103_FEDWireMessage_characters(t *testing.T) {
+	fwm := mockMT103CustomerTransfer()
+	msg, _, err := fwm.ToMT103(mockSWIFTOpts(fwm))
+	require.NoError(t, err)
+	msg.field("50K").Lines[1] = "X{4200}D666*EVIL*"
+	msg.field("70").Lines[0] = "Zoë Müller"
+	read, warnings, err := msg.FEDWireMessage(mockSWIFTOpts(fwm))
+	require.NoError(t, err)
+	read = writeReadFEDWireMessage(t, read)
+
+	require.Equal(t, "X.4200.D666.EVIL.", read.Originator.Personal.Name)
+	require.Equal(t, fwm.Beneficiary, read.Beneficiary)
+	require.Equal(t, "Zo. M.ller", read.OriginatorToBeneficiary.LineOne)
+	require.Equal(t, []ConversionWarning{
+		{Field: "50K", Message: "has characters outside the SWIFT X character set replaced"},
+		{Field: "70", Message: "has characters outside the SWIFT X character set replaced"},
+	}, warnings)
+}
+
+// mt103Sample is an MT103 received from a correspondent, with fields and options which do not round trip
+const mt103Sample = `{1:F01DEUTDEFFAXXX0000000000}{2:I103CHASUS33AXXXN}{3:{121:8a562c67-ca16-48ba-b074-65581be6f011}}{4:
+:20:REF-4456
+:13C:/SNDTIME/1249+0200
+:23B:CRED
+:32A:190502USD1000,
+:50F:/DE89370400440532013000
+1/MUELLER HANS
+2/HAUPTSTRASSE 1
+3/DE/FRANKFURT
+:52A:DEUTDEFF
+:57A://FW021000021
+CHASUS33
+:59F:/998877665
+1/JOHN DOE
+2/1 MAIN STREET
+3/US/NEW YORK, NY 10001
+6/US/12345678/CUST
+:70:INVOICE 4456
+:71A:OUR
+:71G:USD5,
+-}{5:{CHK:123456789ABC}}`
+
+func TestReadSWIFT_MT103(t *testing.T) {
+	fwm := mockMT103CustomerTransfer()
+	read, warnings, err := ReadSWIFT(strings.NewReader(mt103Sample), mockSWIFTOpts(fwm))
+	require.NoError(t, err)
+	require.Equal(t, read, writeReadFEDWireMessage(t, read))
+
+	require.Equal(t, "REF-4456", read.SenderReference.SenderReference)
+	require.Equal(t, "000000100000", read.Amount.Amount)
+	require.Equal(t, "/DE89370400440532013000", read.OriginatorOptionF.PartyIdentifier)
+	require.Equal(t, "1/MUELLER HANS", read.OriginatorOptionF.Name)
+	require.Equal(t, "3/DE/FRANKFURT", read.OriginatorOptionF.LineTwo)
+	require.Equal(t, FinancialInstitution{IdentificationCode: SWIFTBankIdentifierCode, Identifier: "DEUTDEFF"},
+		read.OriginatorFI.FinancialInstitution)
+	require.Equal(t, FinancialInstitution{IdentificationCode: FEDRoutingNumber, Identifier: "021000021"},
+		read.BeneficiaryFI.FinancialInstitution)
+	require.Equal(t, Personal{
+		IdentificationCode: DemandDepositAccountNumber,
+		Identifier:         "998877665",
+		Name:               "JOHN DOE",
+		Address:            Address{AddressLineOne: "1 MAIN STREET", AddressLineTwo: "US/NEW YORK, NY 10001"},
+	}, read.Beneficiary.Personal)
+	require.Equal(t, "INVOICE 4456", read.OriginatorToBeneficiary.LineOne)
+	require.Nil(t, read.Charges)
+
+	require.Equal(t, []ConversionWarning{
+		{Field: "13C", Message: "has no corresponding tag"},
+		{Tag: TagBeneficiaryFI, Field: "57A", Message: "BIC CHASUS33 is not translated with identification F"},
+		{Tag: TagBeneficiary, Field: "59F", Message: "line 6/US/12345678/CUST is not translated"},
+		{Tag: TagCharges, Field: "71A", Message: "OUR is not translated"},
+		{Field: "71G", Message: "has no corresponding tag"},
+	}, warnings)
+}
+
+func TestReadSWIFT_MT103Required(t *testing.T) {
+	fwm := mockMT103CustomerTransfer()
+	opts := mockSWIFTOpts(fwm)
+	opts.ReceiverDepositoryInstitution = nil
+	_, _, err := ReadSWIFT(strings.NewReader(mt103Sample), opts)
+	require.EqualError(t, err, fieldError("ReceiverDepositoryInstitution", ErrFieldRequired).Error())
+
+	sample := strings.Replace(mt103Sample, ":32A:190502USD1000,", ":32A:190502EUR1000,", 1)
+	_, _, err = ReadSWIFT(strings.NewReader(sample), mockSWIFTOpts(fwm))
+	require.EqualError(t, err, fieldError("32A", ErrNonCurrencyCode, "EUR").Error())
+
+	sample = strings.Replace(mt103Sample, ":59F:", ":77B:", 1)
+	_, _, err = ReadSWIFT(strings.NewReader(sample), mockSWIFTOpts(fwm))
+	require.EqualError(t, err, fieldError("59a", ErrFieldRequired).Error())
+}
@@ -0,0 +1,321 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+)
+
+// mt202COVFormat names the MT202COV message in ConversionWarnings
+const mt202COVFormat = "MT202COV"
+
+// mt202COVTags are the tags of a FEDWireMessage which ToMT202COV translates
+var mt202COVTags = []string{
+	TagSenderSupplied, TagTypeSubType, TagInputMessageAccountabilityData, TagAmount, TagSenderDepositoryInstitution,
+	TagReceiverDepositoryInstitution, TagBusinessFunctionCode, TagSenderReference, TagLocalInstrument,
+	TagBeneficiaryReference, TagOriginator, TagBeneficiaryIntermediaryFI, TagBeneficiaryFI, TagBeneficiary,
+	TagFIAdditionalFIToFI, TagCurrencyInstructedAmount, TagOrderingCustomer, TagOrderingInstitution,
+	TagIntermediaryInstitution, TagInstitutionAccount, TagBeneficiaryCustomer, TagRemittance, TagSenderToReceiver,
+}
+
+// ToMT202COV converts fwm, a CustomerTransferPlus cover payment with SubTypeCode BasicFundsTransfer, to a SWIFT
+// MT202COV. The Sender and Receiver of the message, the BICs of the forwarding and next institutions, are those of
+// opts.
+//
+// Sequence A, the transfer between financial institutions, has the SenderReference and BeneficiaryReference of fwm
+// as fields 20 and 21, and its InputCycleDate and Amount as the value date and interbank settled amount of field
+// 32A. The Originator, BeneficiaryIntermediaryFI, BeneficiaryFI and Beneficiary are fields 52a, 56a, 57a and 58a,
+// and the FIAdditionalFIToFI field 72. Sequence B, the customer credit transfer it covers, has the {7xxx} tags:
+// the OrderingCustomer, OrderingInstitution, IntermediaryInstitution, InstitutionAccount and BeneficiaryCustomer
+// are fields 50a, 52a, 56a, 57a and 59a with the option of their SwiftFieldTag, the Remittance and SenderToReceiver
+// fields 70 and 72, and the CurrencyInstructedAmount field 33B.
+//
+// Tags without a corresponding field, and values truncated to fit one, are returned as warnings.
+func (fwm *FEDWireMessage) ToMT202COV(opts SWIFTOpts) (*SWIFTMessage, []ConversionWarning, error) {
+	if fwm.BusinessFunctionCode != nil && fwm.BusinessFunctionCode.BusinessFunctionCode != CustomerTransferPlus {
+		return nil, nil, fieldError("BusinessFunctionCode.BusinessFunctionCode", ErrSWIFTMessageType,
+			fwm.BusinessFunctionCode.BusinessFunctionCode)
+	}
+	if !fwm.isCoverPayment() {
+		return nil, nil, fieldError("LocalInstrument", ErrSWIFTMessageType)
+	}
+	if fwm.TypeSubType != nil && fwm.TypeSubType.SubTypeCode != BasicFundsTransfer {
+		return nil, nil, fieldError("TypeSubType.SubTypeCode", ErrSWIFTMessageType, fwm.TypeSubType.SubTypeCode)
+	}
+	if err := fwm.mandatoryFields(); err != nil {
+		return nil, nil, err
+	}
+	if fwm.Beneficiary == nil {
+		return nil, nil, fieldError("Beneficiary", ErrFieldRequired)
+	}
+	if fwm.OrderingCustomer == nil {
+		return nil, nil, fieldError("OrderingCustomer", ErrFieldRequired)
+	}
+	if fwm.BeneficiaryCustomer == nil {
+		return nil, nil, fieldError("BeneficiaryCustomer", ErrFieldRequired)
+	}
+
+	var ws conversionWarnings
+	msg := &SWIFTMessage{Type: SWIFTMT202COV, Sender: opts.Sender, Receiver: opts.Receiver}
+	msg.add("20", fwm.swiftReference(&ws))
+	related := ""
+	if fwm.BeneficiaryReference != nil {
+		related = swiftText(TagBeneficiaryReference, "21", fwm.BeneficiaryReference.BeneficiaryReference, 16, &ws)
+	}
+	if related == "" {
+		related = swiftNoReference
+	}
+	msg.add("21", related)
+	settlement, err := fwm.swiftSettlement()
+	if err != nil {
+		return nil, nil, err
+	}
+	msg.add("32A", settlement)
+	if fwm.Originator != nil {
+		msg.addField(swiftInstitutionPartyField(TagOriginator, "52", fwm.Originator.Personal, &ws))
+	}
+	if fwm.BeneficiaryIntermediaryFI != nil {
+		msg.addField(swiftFinancialInstitutionField(TagBeneficiaryIntermediaryFI, "56",
+			fwm.BeneficiaryIntermediaryFI.FinancialInstitution, false, &ws))
+	}
+	if fwm.BeneficiaryFI != nil {
+		msg.addField(swiftFinancialInstitutionField(TagBeneficiaryFI, "57", fwm.BeneficiaryFI.FinancialInstitution, false, &ws))
+	}
+	msg.addField(swiftInstitutionPartyField(TagBeneficiary, "58", fwm.Beneficiary.Personal, &ws))
+	if fwm.FIAdditionalFIToFI != nil {
+		msg.add("72", swiftAdditionalFIToFI(TagFIAdditionalFIToFI, "72", fwm.FIAdditionalFIToFI.AdditionalFIToFI, &ws)...)
+	}
+
+	// sequence B, the underlying customer credit transfer
+	msg.addField(swiftCoverPaymentField(TagOrderingCustomer, "50", fwm.OrderingCustomer.CoverPayment, &ws, "K", "A", "F"))
+	if t := fwm.OrderingInstitution; t != nil {
+		msg.addField(swiftCoverPaymentField(TagOrderingInstitution, "52", t.CoverPayment, &ws, "D", "A"))
+	}
+	if t := fwm.IntermediaryInstitution; t != nil {
+		msg.addField(swiftCoverPaymentField(TagIntermediaryInstitution, "56", t.CoverPayment, &ws, "D", "A", "C"))
+	}
+	if t := fwm.InstitutionAccount; t != nil {
+		msg.addField(swiftCoverPaymentField(TagInstitutionAccount, "57", t.CoverPayment, &ws, "D", "A", "B", "C"))
+	}
+	msg.addField(swiftCoverPaymentField(TagBeneficiaryCustomer, "59", fwm.BeneficiaryCustomer.CoverPayment, &ws, "", "A", "F"))
+	if t := fwm.Remittance; t != nil {
+		msg.addField(swiftCoverPaymentNarrativeField(TagRemittance, "70", t.CoverPayment, 4, &ws))
+	}
+	if t := fwm.SenderToReceiver; t != nil {
+		msg.addField(swiftCoverPaymentNarrativeField(TagSenderToReceiver, "72", t.CoverPayment, 6, &ws))
+	}
+	if t := fwm.CurrencyInstructedAmount; t != nil && t.CurrencyCode != "" {
+		m, err := t.Money()
+		if err != nil {
+			return nil, nil, fieldError("CurrencyInstructedAmount.Amount", err, t.Amount)
+		}
+		amount, err := swiftCurrencyAmount(m)
+		if err != nil {
+			return nil, nil, fieldError("CurrencyInstructedAmount.Amount", err, t.Amount)
+		}
+		msg.add("33B", amount)
+	}
+	ws.untranslated(fwm, mt202COVTags, mt202COVFormat)
+	fwm.swiftSenderSuppliedWarnings(mt202COVFormat, &ws)
+	return msg, ws, nil
+}
+
+// swiftInstitutionPartyField returns p, a financial institution party of a transfer between financial
+// institutions, as the SWIFT field number: option A for a BIC, optionally with an account, and otherwise option D
+func swiftInstitutionPartyField(tag, number string, p Personal, ws *conversionWarnings) SWIFTField {
+	if p.IdentificationCode == SWIFTBICORBEIANDAccountNumber {
+		return swiftPersonalField(tag, number, "D", p, ws)
+	}
+	return swiftFinancialInstitutionField(tag, number, FinancialInstitution(p), false, ws)
+}
+
+// swiftCoverPaymentField returns cp, a party, as the SWIFT field number with the option of
+// swiftCoverPaymentOption, cut to the lines of that option: one for option C, two for options A and B, and
+// otherwise four lines of name and address, or numbered lines for option F, after an optional account or party
+// identifier line.
+func swiftCoverPaymentField(tag, number string, cp CoverPayment, ws *conversionWarnings, options ...string) SWIFTField {
+	f := SWIFTField{Tag: number + swiftCoverPaymentOption(tag, number, cp, ws, options...)}
+	lines := swiftTextLines(tag, f.Tag, swiftLines(cp), 6, ws)
+	count := 4
+	switch f.option() {
+	case "A", "B":
+		count = 2
+	case "C":
+		count = 1
+	case "F":
+		if len(lines) > 0 {
+			if code, _ := optionFLineCode(lines[0]); code == "" {
+				count++
+			}
+		}
+	default:
+		if len(lines) > 0 && strings.HasPrefix(lines[0], "/") {
+			count++
+		}
+	}
+	if len(lines) > count {
+		ws.add(tag, f.Tag, "is truncated to %d lines", count)
+		lines = lines[:count]
+	}
+	f.Lines = lines
+	return f
+}
+
+// swiftCoverPaymentNarrativeField returns cp as the SWIFT narrative field number, without an option, cut to count
+// lines
+func swiftCoverPaymentNarrativeField(tag, number string, cp CoverPayment, count int, ws *conversionWarnings) SWIFTField {
+	f := SWIFTField{Tag: number + swiftCoverPaymentOption(tag, number, cp, ws, "")}
+	f.Lines = swiftTextLines(tag, f.Tag, swiftLines(cp), count, ws)
+	return f
+}
+
+// swiftCoverPaymentOption returns the option of the SWIFT field number of cp: that of its SwiftFieldTag when it is
+// one of options, and otherwise the first of options
+func swiftCoverPaymentOption(tag, number string, cp CoverPayment, ws *conversionWarnings, options ...string) string {
+	option := options[0]
+	if swiftTag := strings.ToUpper(strings.TrimSpace(cp.SwiftFieldTag)); swiftTag != "" {
+		valid := false
+		if strings.HasPrefix(swiftTag, number) {
+			for _, o := range options {
+				valid = valid || swiftTag[len(number):] == o
+			}
+		}
+		if valid {
+			option = swiftTag[len(number):]
+		} else {
+			ws.add(tag, number+option, "SwiftFieldTag %s is not translated", swiftTag)
+		}
+	}
+	return option
+}
+
+// fedwireMT202COV converts msg, an MT202COV, to a CustomerTransferPlus cover payment with SubTypeCode
+// BasicFundsTransfer, translating its fields as ToMT202COV does. Sequence B starts with its field 50a.
+func (msg *SWIFTMessage) fedwireMT202COV(opts SWIFTOpts) (FEDWireMessage, []ConversionWarning, error) {
+	fwm, err := newSWIFTFEDWireMessage(opts)
+	if err != nil {
+		return fwm, nil, err
+	}
+	fwm.TypeSubType = NewTypeSubType()
+	fwm.TypeSubType.TypeCode = FundsTransfer
+	fwm.TypeSubType.SubTypeCode = BasicFundsTransfer
+	fwm.BusinessFunctionCode = NewBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	fwm.LocalInstrument = NewLocalInstrument()
+	fwm.LocalInstrument.LocalInstrumentCode = SequenceBCoverPaymentStructured
+
+	var ws conversionWarnings
+	sequenceB := false
+	for _, f := range msg.Fields {
+		f = f.sanitize(&ws)
+		if strings.HasPrefix(f.Tag, "50") {
+			sequenceB = true
+		}
+		if sequenceB {
+			if err := fwm.setSWIFTCoverPayment(f, &ws); err != nil {
+				return fwm, nil, err
+			}
+			continue
+		}
+		switch f.Tag {
+		case "20":
+			fwm.setSWIFTReference(f, &ws)
+		case "21":
+			fwm.BeneficiaryReference = NewBeneficiaryReference()
+			fwm.BeneficiaryReference.BeneficiaryReference = ws.truncate(TagBeneficiaryReference, f.Tag, f.value(), 16)
+		case "32A":
+			if err := fwm.setSWIFTSettlement(f, &ws); err != nil {
+				return fwm, nil, err
+			}
+		case "52A", "52D":
+			fwm.Originator = NewOriginator()
+			fwm.Originator.Personal = fedwireSWIFTInstitutionParty(TagOriginator, f, &ws)
+		case "56A", "56D":
+			fwm.BeneficiaryIntermediaryFI = NewBeneficiaryIntermediaryFI()
+			fwm.BeneficiaryIntermediaryFI.FinancialInstitution = fedwireSWIFTFinancialInstitution(TagBeneficiaryIntermediaryFI, f, &ws)
+		case "57A", "57B", "57D":
+			fwm.BeneficiaryFI = NewBeneficiaryFI()
+			fwm.BeneficiaryFI.FinancialInstitution = fedwireSWIFTFinancialInstitution(TagBeneficiaryFI, f, &ws)
+		case "58A", "58D":
+			fwm.Beneficiary = NewBeneficiary()
+			fwm.Beneficiary.Personal = fedwireSWIFTInstitutionParty(TagBeneficiary, f, &ws)
+		case "72":
+			fwm.FIAdditionalFIToFI = NewFIAdditionalFIToFI()
+			setSWIFTAdditionalFIToFI(TagFIAdditionalFIToFI, f, &fwm.FIAdditionalFIToFI.AdditionalFIToFI, &ws)
+		default:
+			ws.add("", f.Tag, "has no corresponding tag")
+		}
+	}
+	if fwm.Amount == nil {
+		return fwm, nil, fieldError("32A", ErrFieldRequired)
+	}
+	if fwm.Beneficiary == nil {
+		return fwm, nil, fieldError("58a", ErrFieldRequired)
+	}
+	if fwm.OrderingCustomer == nil {
+		return fwm, nil, fieldError("50a", ErrFieldRequired)
+	}
+	if fwm.BeneficiaryCustomer == nil {
+		return fwm, nil, fieldError("59a", ErrFieldRequired)
+	}
+	fwm.removeISOUnpermittedTags(&ws)
+	return fwm, ws, nil
+}
+
+// fedwireSWIFTInstitutionParty returns the Personal of a financial institution party of a transfer between financial
+// institutions: option A, a BIC with an optional account, and otherwise option D
+func fedwireSWIFTInstitutionParty(tag string, f SWIFTField, ws *conversionWarnings) Personal {
+	if f.option() == "A" {
+		return fedwireSWIFTPersonal(tag, f, ws)
+	}
+	return Personal(fedwireSWIFTFinancialInstitution(tag, f, ws))
+}
+
+// setSWIFTCoverPayment sets the {7xxx} cover payment tag of fwm corresponding to f, a field of sequence B of an
+// MT202COV, whose tag is the SwiftFieldTag
+func (fwm *FEDWireMessage) setSWIFTCoverPayment(f SWIFTField, ws *conversionWarnings) error {
+	coverPayment := func(tag string, count int) CoverPayment {
+		cp := CoverPayment{SwiftFieldTag: f.Tag}
+		setLines(fedwireSWIFTLines(tag, f.Tag, f.Lines, 35, count, ws), &cp.SwiftLineOne, &cp.SwiftLineTwo,
+			&cp.SwiftLineThree, &cp.SwiftLineFour, &cp.SwiftLineFive, &cp.SwiftLineSix)
+		return cp
+	}
+	switch f.Tag {
+	case "50A", "50F", "50K":
+		fwm.OrderingCustomer = NewOrderingCustomer()
+		fwm.OrderingCustomer.CoverPayment = coverPayment(TagOrderingCustomer, 5)
+	case "52A", "52D":
+		fwm.OrderingInstitution = NewOrderingInstitution()
+		fwm.OrderingInstitution.CoverPayment = coverPayment(TagOrderingInstitution, 5)
+	case "56A", "56C", "56D":
+		fwm.IntermediaryInstitution = NewIntermediaryInstitution()
+		fwm.IntermediaryInstitution.CoverPayment = coverPayment(TagIntermediaryInstitution, 5)
+	case "57A", "57B", "57C", "57D":
+		fwm.InstitutionAccount = NewInstitutionAccount()
+		fwm.InstitutionAccount.CoverPayment = coverPayment(TagInstitutionAccount, 5)
+	case "59", "59A", "59F":
+		fwm.BeneficiaryCustomer = NewBeneficiaryCustomer()
+		fwm.BeneficiaryCustomer.CoverPayment = coverPayment(TagBeneficiaryCustomer, 5)
+	case "70":
+		fwm.Remittance = NewRemittance()
+		fwm.Remittance.CoverPayment = coverPayment(TagRemittance, 4)
+	case "72":
+		fwm.SenderToReceiver = NewSenderToReceiver()
+		fwm.SenderToReceiver.CoverPayment = coverPayment(TagSenderToReceiver, 6)
+	case "33B":
+		m, err := parseSWIFTCurrencyAmount(f.value())
+		if err != nil {
+			return fieldError(f.Tag, err, f.value())
+		}
+		fwm.CurrencyInstructedAmount = NewCurrencyInstructedAmount()
+		fwm.CurrencyInstructedAmount.SwiftFieldTag = f.Tag
+		if err := fwm.CurrencyInstructedAmount.SetMoney(m); err != nil {
+			return fieldError(f.Tag, err, f.value())
+		}
+	default:
+		ws.add("", f.Tag, "has no corresponding tag in sequence B")
+	}
+	return nil
+}
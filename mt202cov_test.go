@@ -0,0 +1,215 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockMT202COVCoverPayment creates a CustomerTransferPlus cover payment whose tags each have a corresponding
+// MT202COV field
+func mockMT202COVCoverPayment() FEDWireMessage {
+	fwm := mockPacs009CoverPayment()
+	fwm.Beneficiary.Personal.Name = ""
+	fwm.Beneficiary.Personal.Address = Address{}
+	fwm.FIAdditionalFIToFI = mockFIAdditionalFIToFI()
+	fwm.Remittance.CoverPayment.SwiftFieldTag = "70"
+	fwm.SenderToReceiver.CoverPayment.SwiftFieldTag = "72"
+	return fwm
+}
+
+func TestToMT202COV(t *testing.T) {
+	fwm := mockMT202COVCoverPayment()
+	msg, warnings, err := fwm.ToMT202COV(mockSWIFTOpts(fwm))
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+	require.Equal(t, SWIFTMT202COV, msg.Type)
+
+	var tags []string
+	for _, f := range msg.Fields {
+		tags = append(tags, f.Tag)
+	}
+	require.Equal(t, []string{"20", "21", "32A", "52D", "57D", "58A", "72", "50F", "52A", "56D", "57A", "59", "70",
+		"72", "33B"}, tags)
+	require.Equal(t, []string{"Reference"}, msg.field("21").Lines)
+	require.Equal(t, []string{"201005USD12345,67"}, msg.field("32A").Lines)
+	require.Equal(t, []string{"//FW021000021", "Originator Bank", "Address One", "Address Two", "Address Three"},
+		msg.field("52D").Lines)
+	require.Equal(t, []string{"CITIUS33"}, msg.field("58A").Lines)
+	require.Equal(t, []string{"/12345678", "1/SMITH JOHN", "2/299 PARK AVENUE", "3/US/NEW YORK, NY 10017"},
+		msg.field("50F").Lines)
+	require.Equal(t, []string{"/C/9876", "CHASUS33"}, msg.field("52A").Lines)
+	require.Equal(t, []string{"USD1500,49"}, msg.field("33B").Lines)
+
+	bs, err := msg.Bytes()
+	require.NoError(t, err)
+	require.Contains(t, string(bs), "{3:{119:COV}}")
+}
+
+func TestToMT202COV_messageType(t *testing.T) {
+	fwm := mockMT202COVCoverPayment()
+	fwm.LocalInstrument = nil
+	_, _, err := fwm.ToMT202COV(mockSWIFTOpts(fwm))
+	require.EqualError(t, err, fieldError("LocalInstrument", ErrSWIFTMessageType).Error())
+
+	fwm = mockMT202COVCoverPayment()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransfer
+	_, _, err = fwm.ToMT202COV(mockSWIFTOpts(fwm))
+	require.EqualError(t, err, fieldError("BusinessFunctionCode.BusinessFunctionCode", ErrSWIFTMessageType,
+		CustomerTransfer).Error())
+
+	fwm = mockMT202COVCoverPayment()
+	fwm.BeneficiaryCustomer = nil
+	_, _, err = fwm.ToMT202COV(mockSWIFTOpts(fwm))
+	require.EqualError(t, err, fieldError("BeneficiaryCustomer", ErrFieldRequired).Error())
+}
+
+func TestToMT202COV_fieldOptions(t *testing.T) {
+	fwm := mockMT202COVCoverPayment()
+	fwm.OrderingCustomer.CoverPayment = CoverPayment{SwiftLineOne: "/12345678", SwiftLineTwo: "SMITH JOHN"}
+	fwm.InstitutionAccount.CoverPayment.SwiftFieldTag = "58A"
+	fwm.BeneficiaryCustomer.CoverPayment.SwiftFieldTag = "59F"
+	fwm.BeneficiaryCustomer.CoverPayment.SwiftLineTwo = "1/MULLER GMBH"
+	fwm.BeneficiaryCustomer.CoverPayment.SwiftLineThree = "3/DE/FRANKFURT"
+	msg, warnings, err := fwm.ToMT202COV(mockSWIFTOpts(fwm))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"/12345678", "SMITH JOHN"}, msg.field("50K").Lines)
+	// the InstitutionAccount follows the 57D of the BeneficiaryFI in sequence A
+	require.Equal(t, SWIFTField{Tag: "57D", Lines: []string{"DEUTDEFF"}}, msg.Fields[10])
+	require.Equal(t, []string{"/DE89370400440532013000", "1/MULLER GMBH", "3/DE/FRANKFURT"}, msg.field("59F").Lines)
+	require.Equal(t, []ConversionWarning{
+		{Tag: TagInstitutionAccount, Field: "57D", Message: "SwiftFieldTag 58A is not translated"},
+	}, warnings)
+}
+
+func TestToMT202COV_fieldLines(t *testing.T) {
+	fwm := mockMT202COVCoverPayment()
+	fwm.OrderingCustomer.CoverPayment = CoverPayment{SwiftFieldTag: "50K", SwiftLineOne: "/12345678",
+		SwiftLineTwo: "SMITH JOHN", SwiftLineThree: "299 PARK AVENUE", SwiftLineFour: "NEW YORK",
+		SwiftLineFive: "NY 10017", SwiftLineSix: "US"}
+	fwm.OrderingInstitution.CoverPayment = CoverPayment{SwiftFieldTag: "52A", SwiftLineOne: "/C/9876",
+		SwiftLineTwo: "CHASUS33", SwiftLineThree: "JPMORGAN CHASE"}
+	fwm.BeneficiaryCustomer.CoverPayment = CoverPayment{SwiftFieldTag: "59", SwiftLineOne: "MULLER GMBH",
+		SwiftLineTwo: "TAUNUSANLAGE 12", SwiftLineThree: "60325", SwiftLineFour: "FRANKFURT", SwiftLineFive: "DE"}
+	msg, warnings, err := fwm.ToMT202COV(mockSWIFTOpts(fwm))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"/12345678", "SMITH JOHN", "299 PARK AVENUE", "NEW YORK", "NY 10017"}, msg.field("50K").Lines)
+	require.Equal(t, []string{"/C/9876", "CHASUS33"}, msg.field("52A").Lines)
+	require.Equal(t, []string{"MULLER GMBH", "TAUNUSANLAGE 12", "60325", "FRANKFURT"}, msg.field("59").Lines)
+	require.Equal(t, []ConversionWarning{
+		{Tag: TagOrderingCustomer, Field: "50K", Message: "is truncated to 5 lines"},
+		{Tag: TagOrderingInstitution, Field: "52A", Message: "is truncated to 2 lines"},
+		{Tag: TagBeneficiaryCustomer, Field: "59", Message: "is truncated to 4 lines"},
+	}, warnings)
+}
+
+func TestMT202COV_FEDWireMessage(t *testing.T) {
+	fwm := mockMT202COVCoverPayment()
+	opts := mockSWIFTOpts(fwm)
+	opts.Receiver = "CITIUS33"
+	msg, _, err := fwm.ToMT202COV(opts)
+	require.NoError(t, err)
+	read, warnings := readSWIFT(t, msg, opts)
+	require.Empty(t, warnings)
+
+	require.Equal(t, CustomerTransferPlus, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, SequenceBCoverPaymentStructured, read.LocalInstrument.LocalInstrumentCode)
+	require.Equal(t, fwm.Amount, read.Amount)
+	require.Equal(t, fwm.SenderReference, read.SenderReference)
+	require.Equal(t, fwm.BeneficiaryReference, read.BeneficiaryReference)
+	require.Equal(t, fwm.Originator, read.Originator)
+	require.Equal(t, fwm.BeneficiaryFI, read.BeneficiaryFI)
+	require.Equal(t, fwm.Beneficiary, read.Beneficiary)
+	require.Equal(t, fwm.FIAdditionalFIToFI, read.FIAdditionalFIToFI)
+	require.Equal(t, fwm.OrderingCustomer, read.OrderingCustomer)
+	require.Equal(t, fwm.OrderingInstitution, read.OrderingInstitution)
+	require.Equal(t, fwm.IntermediaryInstitution, read.IntermediaryInstitution)
+	require.Equal(t, fwm.InstitutionAccount, read.InstitutionAccount)
+	require.Equal(t, fwm.BeneficiaryCustomer, read.BeneficiaryCustomer)
+	require.Equal(t, fwm.Remittance, read.Remittance)
+	require.Equal(t, fwm.SenderToReceiver, read.SenderToReceiver)
+	require.Equal(t, fwm.CurrencyInstructedAmount, read.CurrencyInstructedAmount)
+}
+
+// TestMT202COV_FEDWireMessage_characters ensures characters of sequence B outside the SWIFT X character set are
+// replaced in the cover payment tags
+func TestMT202COV_FEDWireMessage_characters(t *testing.T) {
+	fwm := mockMT202COVCoverPayment()
+	opts := mockSWIFTOpts(fwm)
+	opts.Receiver = "CITIUS33"
+	msg, _, err := fwm.ToMT202COV(opts)
+	require.NoError(t, err)
+	msg.field("50F").Lines[1] = "1/X{4200}D666*EVIL*"
+	read, warnings, err := msg.FEDWireMessage(opts)
+	require.NoError(t, err)
+	read = writeReadFEDWireMessage(t, read)
+
+	require.Equal(t, "1/X.4200.D666.EVIL.", read.OrderingCustomer.CoverPayment.SwiftLineTwo)
+	require.Equal(t, fwm.Beneficiary, read.Beneficiary)
+	require.Equal(t, []ConversionWarning{
+		{Field: "50F", Message: "has characters outside the SWIFT X character set replaced"},
+	}, warnings)
+}
+
+// mt202COVSample is an MT202COV received from a correspondent, with fields which do not round trip
+const mt202COVSample = `{1:F01DEUTDEFFAXXX0000000000}{2:I202CHASUS33AXXXN}{3:{119:COV}}{4:
+:20:COV-4456
+:21:REF-4456
+:32A:201005USD1000,
+:52A:DEUTDEFF
+:53A:CITIUS33
+:58A:/998877665
+CHASUS33
+:50K:/DE89370400440532013000
+MUELLER HANS
+HAUPTSTRASSE 1
+FRANKFURT
+:59F:/998877665
+1/JOHN DOE
+2/1 MAIN STREET
+3/US/NEW YORK, NY 10001
+:70:INVOICE 4456
+:71A:SHA
+-}`
+
+func TestReadSWIFT_MT202COV(t *testing.T) {
+	fwm := mockMT202COVCoverPayment()
+	read, warnings, err := ReadSWIFT(strings.NewReader(mt202COVSample), mockSWIFTOpts(fwm))
+	require.NoError(t, err)
+	require.Equal(t, read, writeReadFEDWireMessage(t, read))
+
+	require.Equal(t, "COV-4456", read.SenderReference.SenderReference)
+	require.Equal(t, "REF-4456", read.BeneficiaryReference.BeneficiaryReference)
+	require.Equal(t, "000000100000", read.Amount.Amount)
+	require.Equal(t, Personal{IdentificationCode: SWIFTBankIdentifierCode, Identifier: "DEUTDEFF"}, read.Originator.Personal)
+	require.Equal(t, Personal{IdentificationCode: SWIFTBICORBEIANDAccountNumber, Identifier: "CHASUS33/998877665"},
+		read.Beneficiary.Personal)
+	require.Equal(t, CoverPayment{SwiftFieldTag: "50K", SwiftLineOne: "/DE89370400440532013000",
+		SwiftLineTwo: "MUELLER HANS", SwiftLineThree: "HAUPTSTRASSE 1", SwiftLineFour: "FRANKFURT"},
+		read.OrderingCustomer.CoverPayment)
+	require.Equal(t, CoverPayment{SwiftFieldTag: "59F", SwiftLineOne: "/998877665", SwiftLineTwo: "1/JOHN DOE",
+		SwiftLineThree: "2/1 MAIN STREET", SwiftLineFour: "3/US/NEW YORK, NY 10001"}, read.BeneficiaryCustomer.CoverPayment)
+	require.Equal(t, CoverPayment{SwiftFieldTag: "70", SwiftLineOne: "INVOICE 4456"}, read.Remittance.CoverPayment)
+	require.Equal(t, []ConversionWarning{
+		{Field: "53A", Message: "has no corresponding tag"},
+		{Field: "71A", Message: "has no corresponding tag in sequence B"},
+	}, warnings)
+}
+
+func TestReadSWIFT_MT202COVRequired(t *testing.T) {
+	fwm := mockMT202COVCoverPayment()
+	sample := strings.Replace(mt202COVSample, ":58A:", ":54A:", 1)
+	_, _, err := ReadSWIFT(strings.NewReader(sample), mockSWIFTOpts(fwm))
+	require.EqualError(t, err, fieldError("58a", ErrFieldRequired).Error())
+
+	sample = strings.Replace(mt202COVSample, ":59F:", ":77B:", 1)
+	_, _, err = ReadSWIFT(strings.NewReader(sample), mockSWIFTOpts(fwm))
+	require.EqualError(t, err, fieldError("59a", ErrFieldRequired).Error())
+}
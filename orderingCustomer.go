@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // OrderingCustomer is the ordering customer
@@ -35,6 +38,9 @@ func NewOrderingCustomer() *OrderingCustomer {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (oc *OrderingCustomer) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	oc.tag = record[:6]
 
 	optionalFields := strings.Split(record[6:], "*")
@@ -90,40 +96,45 @@ func (oc *OrderingCustomer) String() string {
 // Validate performs WIRE format rule checks on OrderingCustomer and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (oc *OrderingCustomer) Validate() error {
-	if err := oc.fieldInclusion(); err != nil {
-		return err
-	}
+	return oc.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on OrderingCustomer and returns every error found
+func (oc *OrderingCustomer) validateAll() base.ErrorList {
+	errs := oc.fieldInclusion()
 	if oc.tag != TagOrderingCustomer {
-		return fieldError("tag", ErrValidTagForType, oc.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, oc.tag))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, oc.CoverPayment.SwiftFieldTag)
+		errs.Add(fieldError("SwiftFieldTag", err, oc.CoverPayment.SwiftFieldTag))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, oc.CoverPayment.SwiftLineOne)
+		errs.Add(fieldError("SwiftLineOne", err, oc.CoverPayment.SwiftLineOne))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, oc.CoverPayment.SwiftLineTwo)
+		errs.Add(fieldError("SwiftLineTwo", err, oc.CoverPayment.SwiftLineTwo))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, oc.CoverPayment.SwiftLineThree)
+		errs.Add(fieldError("SwiftLineThree", err, oc.CoverPayment.SwiftLineThree))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, oc.CoverPayment.SwiftLineFour)
+		errs.Add(fieldError("SwiftLineFour", err, oc.CoverPayment.SwiftLineFour))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineFive); err != nil {
-		return fieldError("SwiftLineFive", err, oc.CoverPayment.SwiftLineFive)
+		errs.Add(fieldError("SwiftLineFive", err, oc.CoverPayment.SwiftLineFive))
 	}
-	return nil
+	errs = append(errs, oc.validateCoverPaymentParty(oc.CoverPayment)...)
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (oc *OrderingCustomer) fieldInclusion() error {
+func (oc *OrderingCustomer) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if oc.CoverPayment.SwiftLineSix != "" {
-		return fieldError("SwiftLineSix", ErrInvalidProperty, oc.CoverPayment.SwiftLineSix)
+		errs.Add(fieldError("SwiftLineSix", ErrInvalidProperty, oc.CoverPayment.SwiftLineSix))
 	}
-	return nil
+	return errs
 }
 
 // SwiftFieldTagField gets a string of the SwiftFieldTag field
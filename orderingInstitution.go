@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // OrderingInstitution is the ordering institution
@@ -35,6 +38,9 @@ func NewOrderingInstitution() *OrderingInstitution {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (oi *OrderingInstitution) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	oi.tag = record[:6]
 	optionalFields := strings.Split(record[6:], "*")
 	if len(optionalFields) >= 1 {
@@ -89,40 +95,45 @@ func (oi *OrderingInstitution) String() string {
 // Validate performs WIRE format rule checks on OrderingInstitution and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (oi *OrderingInstitution) Validate() error {
-	if err := oi.fieldInclusion(); err != nil {
-		return err
-	}
+	return oi.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on OrderingInstitution and returns every error found
+func (oi *OrderingInstitution) validateAll() base.ErrorList {
+	errs := oi.fieldInclusion()
 	if oi.tag != TagOrderingInstitution {
-		return fieldError("tag", ErrValidTagForType, oi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, oi.tag))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, oi.CoverPayment.SwiftFieldTag)
+		errs.Add(fieldError("SwiftFieldTag", err, oi.CoverPayment.SwiftFieldTag))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, oi.CoverPayment.SwiftLineOne)
+		errs.Add(fieldError("SwiftLineOne", err, oi.CoverPayment.SwiftLineOne))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, oi.CoverPayment.SwiftLineTwo)
+		errs.Add(fieldError("SwiftLineTwo", err, oi.CoverPayment.SwiftLineTwo))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, oi.CoverPayment.SwiftLineThree)
+		errs.Add(fieldError("SwiftLineThree", err, oi.CoverPayment.SwiftLineThree))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, oi.CoverPayment.SwiftLineFour)
+		errs.Add(fieldError("SwiftLineFour", err, oi.CoverPayment.SwiftLineFour))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineFive); err != nil {
-		return fieldError("SwiftLineFive", err, oi.CoverPayment.SwiftLineFive)
+		errs.Add(fieldError("SwiftLineFive", err, oi.CoverPayment.SwiftLineFive))
 	}
-	return nil
+	errs = append(errs, oi.validateCoverPaymentParty(oi.CoverPayment)...)
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (oi *OrderingInstitution) fieldInclusion() error {
+func (oi *OrderingInstitution) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if oi.CoverPayment.SwiftLineSix != "" {
-		return fieldError("SwiftLineSix", ErrInvalidProperty, oi.CoverPayment.SwiftLineSix)
+		errs.Add(fieldError("SwiftLineSix", ErrInvalidProperty, oi.CoverPayment.SwiftLineSix))
 	}
-	return nil
+	return errs
 }
 
 // SwiftFieldTagField gets a string of the SwiftFieldTag field
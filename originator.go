@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // Originator is the originator of the wire
@@ -35,6 +38,9 @@ func NewOriginator() *Originator {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (o *Originator) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 7 {
+		return NewTagWrongLengthErr(7, utf8.RuneCountInString(record))
+	}
 	o.tag = record[:6]
 	o.Personal.IdentificationCode = o.parseStringField(record[6:7])
 
@@ -88,44 +94,62 @@ func (o *Originator) String() string {
 // Validate performs WIRE format rule checks on Originator and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (o *Originator) Validate() error {
-	if err := o.fieldInclusion(); err != nil {
-		return err
-	}
+	return o.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on Originator and returns every error found
+func (o *Originator) validateAll() base.ErrorList {
+	errs := o.fieldInclusion()
 	if o.tag != TagOriginator {
-		return fieldError("tag", ErrValidTagForType, o.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, o.tag))
 	}
 	// Can be any Identification Code
 	if err := o.isIdentificationCode(o.Personal.IdentificationCode); err != nil {
-		return fieldError("IdentificationCode", err, o.Personal.IdentificationCode)
+		errs.Add(fieldError("IdentificationCode", err, o.Personal.IdentificationCode))
 	}
 	if err := o.isAlphanumeric(o.Personal.Identifier); err != nil {
-		return fieldError("Identifier", err, o.Personal.Identifier)
+		errs.Add(fieldError("Identifier", err, o.Personal.Identifier))
+	}
+	switch o.Personal.IdentificationCode {
+	case FEDRoutingNumber:
+		if err := o.isRoutingNumber(o.Personal.Identifier); err != nil {
+			errs.Add(fieldError("Identifier", err, o.Personal.Identifier))
+		}
+	case SWIFTBankIdentifierCode:
+		if err := o.isBIC(o.Personal.Identifier); err != nil {
+			errs.Add(fieldError("Identifier", err, o.Personal.Identifier))
+		}
+	case SWIFTBICORBEIANDAccountNumber:
+		if err := o.isBICAndAccountNumber(o.Personal.Identifier); err != nil {
+			errs.Add(fieldError("Identifier", err, o.Personal.Identifier))
+		}
 	}
 	if err := o.isAlphanumeric(o.Personal.Name); err != nil {
-		return fieldError("Name", err, o.Personal.Name)
+		errs.Add(fieldError("Name", err, o.Personal.Name))
 	}
 	if err := o.isAlphanumeric(o.Personal.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, o.Personal.Address.AddressLineOne)
+		errs.Add(fieldError("AddressLineOne", err, o.Personal.Address.AddressLineOne))
 	}
 	if err := o.isAlphanumeric(o.Personal.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, o.Personal.Address.AddressLineTwo)
+		errs.Add(fieldError("AddressLineTwo", err, o.Personal.Address.AddressLineTwo))
 	}
 	if err := o.isAlphanumeric(o.Personal.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, o.Personal.Address.AddressLineThree)
+		errs.Add(fieldError("AddressLineThree", err, o.Personal.Address.AddressLineThree))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (o *Originator) fieldInclusion() error {
+func (o *Originator) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if o.Personal.IdentificationCode != "" && o.Personal.Identifier == "" {
-		return fieldError("Identifier", ErrFieldRequired)
+		errs.Add(fieldError("Identifier", ErrFieldRequired))
 	}
 	if o.Personal.IdentificationCode == "" && o.Personal.Identifier != "" {
-		return fieldError("IdentificationCode", ErrFieldRequired)
+		errs.Add(fieldError("IdentificationCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // OriginatorFI is the originator Financial Institution
@@ -35,6 +38,9 @@ func NewOriginatorFI() *OriginatorFI {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (ofi *OriginatorFI) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 7 {
+		return NewTagWrongLengthErr(7, utf8.RuneCountInString(record))
+	}
 	ofi.tag = record[:6]
 	ofi.FinancialInstitution.IdentificationCode = ofi.parseStringField(record[6:7])
 
@@ -89,55 +95,70 @@ func (ofi *OriginatorFI) String() string {
 // The first error encountered is returned and stops that parsing.
 // If ID Code is present, Identifier is mandatory and vice versa.
 func (ofi *OriginatorFI) Validate() error {
+	return ofi.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on OriginatorFI and returns every error found
+func (ofi *OriginatorFI) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if ofi.tag != TagOriginatorFI {
-		return fieldError("tag", ErrValidTagForType, ofi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ofi.tag))
 	}
 
 	// Identification code can be a " " which means its not present
 
-	if err := ofi.fieldInclusion(); err != nil {
-		return err
-	}
+	errs = append(errs, ofi.fieldInclusion()...)
 
 	// Identification code present
 	if err := ofi.isIdentificationCode(ofi.FinancialInstitution.IdentificationCode); err != nil {
-		return fieldError("IdentificationCode", err, ofi.FinancialInstitution.IdentificationCode)
+		errs.Add(fieldError("IdentificationCode", err, ofi.FinancialInstitution.IdentificationCode))
 	}
 	// Can only be these Identification Codes
 	switch ofi.FinancialInstitution.IdentificationCode {
 	case
 		"", "B", "C", "D", "F", "U":
 	default:
-		return fieldError("IdentificationCode", ErrIdentificationCode, ofi.FinancialInstitution.IdentificationCode)
+		errs.Add(fieldError("IdentificationCode", ErrIdentificationCode, ofi.FinancialInstitution.IdentificationCode))
 	}
 	if err := ofi.isAlphanumeric(ofi.FinancialInstitution.Identifier); err != nil {
-		return fieldError("Identifier", err, ofi.FinancialInstitution.Identifier)
+		errs.Add(fieldError("Identifier", err, ofi.FinancialInstitution.Identifier))
+	}
+	switch ofi.FinancialInstitution.IdentificationCode {
+	case FEDRoutingNumber:
+		if err := ofi.isRoutingNumber(ofi.FinancialInstitution.Identifier); err != nil {
+			errs.Add(fieldError("Identifier", err, ofi.FinancialInstitution.Identifier))
+		}
+	case SWIFTBankIdentifierCode:
+		if err := ofi.isBIC(ofi.FinancialInstitution.Identifier); err != nil {
+			errs.Add(fieldError("Identifier", err, ofi.FinancialInstitution.Identifier))
+		}
 	}
 	if err := ofi.isAlphanumeric(ofi.FinancialInstitution.Name); err != nil {
-		return fieldError("Name", err, ofi.FinancialInstitution.Name)
+		errs.Add(fieldError("Name", err, ofi.FinancialInstitution.Name))
 	}
 	if err := ofi.isAlphanumeric(ofi.FinancialInstitution.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, ofi.FinancialInstitution.Address.AddressLineOne)
+		errs.Add(fieldError("AddressLineOne", err, ofi.FinancialInstitution.Address.AddressLineOne))
 	}
 	if err := ofi.isAlphanumeric(ofi.FinancialInstitution.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, ofi.FinancialInstitution.Address.AddressLineTwo)
+		errs.Add(fieldError("AddressLineTwo", err, ofi.FinancialInstitution.Address.AddressLineTwo))
 	}
 	if err := ofi.isAlphanumeric(ofi.FinancialInstitution.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, ofi.FinancialInstitution.Address.AddressLineThree)
+		errs.Add(fieldError("AddressLineThree", err, ofi.FinancialInstitution.Address.AddressLineThree))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ofi *OriginatorFI) fieldInclusion() error {
+func (ofi *OriginatorFI) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if ofi.FinancialInstitution.IdentificationCode != "" && ofi.FinancialInstitution.Identifier == "" {
-		return fieldError("Identifier", ErrFieldRequired)
+		errs.Add(fieldError("Identifier", ErrFieldRequired))
 	}
 	if ofi.FinancialInstitution.IdentificationCode == "" && ofi.FinancialInstitution.Identifier != "" {
-		return fieldError("IdentificationCode", ErrFieldRequired)
+		errs.Add(fieldError("IdentificationCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // OriginatorOptionF is originator option F information
@@ -99,6 +102,9 @@ func NewOriginatorOptionF() *OriginatorOptionF {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (oof *OriginatorOptionF) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	oof.tag = oof.parseStringField(record[:6])
 
 	optionalFields := strings.Split(record[6:], "*")
@@ -150,31 +156,35 @@ func (oof *OriginatorOptionF) String() string {
 // Validate performs WIRE format rule checks on OriginatorOptionF and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (oof *OriginatorOptionF) Validate() error {
-	if err := oof.fieldInclusion(); err != nil {
-		return err
-	}
+	return oof.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on OriginatorOptionF and returns every error found
+func (oof *OriginatorOptionF) validateAll() base.ErrorList {
+	errs := oof.fieldInclusion()
 	if err := oof.validatePartyIdentifier(oof.PartyIdentifier); err != nil {
-		return fieldError("PartyIdentifier", err, oof.PartyIdentifier)
+		errs.Add(fieldError("PartyIdentifier", err, oof.PartyIdentifier))
 	}
 	if err := oof.validateOptionFName(oof.Name); err != nil {
-		return fieldError("Name", err, oof.Name)
+		errs.Add(fieldError("Name", err, oof.Name))
 	}
 	if err := oof.validateOptionFLine(oof.LineOne); err != nil {
-		return fieldError("LineOne", err, oof.LineOne)
+		errs.Add(fieldError("LineOne", err, oof.LineOne))
 	}
 	if err := oof.validateOptionFLine(oof.LineTwo); err != nil {
-		return fieldError("LineTwo", err, oof.LineTwo)
+		errs.Add(fieldError("LineTwo", err, oof.LineTwo))
 	}
 	if err := oof.validateOptionFLine(oof.LineThree); err != nil {
-		return fieldError("LineThree", err, oof.LineThree)
+		errs.Add(fieldError("LineThree", err, oof.LineThree))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (oof *OriginatorOptionF) fieldInclusion() error {
-	return nil
+func (oof *OriginatorOptionF) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
+	return errs
 }
 
 // PartyIdentifierField gets a string of the PartyIdentifier field
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // OriginatorToBeneficiary is the OriginatorToBeneficiary of the wire
@@ -41,6 +44,9 @@ func NewOriginatorToBeneficiary() *OriginatorToBeneficiary {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (ob *OriginatorToBeneficiary) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	ob.tag = record[:6]
 
 	optionalFields := strings.Split(record[6:], "*")
@@ -89,22 +95,28 @@ func (ob *OriginatorToBeneficiary) String() string {
 // The first error encountered is returned and stops that parsing.
 // See latest version of the FAIM manual for Line Limits for Tags {6000} to {6500}.
 func (ob *OriginatorToBeneficiary) Validate() error {
+	return ob.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on OriginatorToBeneficiary and returns every error found
+func (ob *OriginatorToBeneficiary) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if ob.tag != TagOriginatorToBeneficiary {
-		return fieldError("tag", ErrValidTagForType, ob.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ob.tag))
 	}
 	if err := ob.isAlphanumeric(ob.LineOne); err != nil {
-		return fieldError("LineOne", err, ob.LineOne)
+		errs.Add(fieldError("LineOne", err, ob.LineOne))
 	}
 	if err := ob.isAlphanumeric(ob.LineTwo); err != nil {
-		return fieldError("LineTwo", err, ob.LineTwo)
+		errs.Add(fieldError("LineTwo", err, ob.LineTwo))
 	}
 	if err := ob.isAlphanumeric(ob.LineThree); err != nil {
-		return fieldError("LineThree", err, ob.LineThree)
+		errs.Add(fieldError("LineThree", err, ob.LineThree))
 	}
 	if err := ob.isAlphanumeric(ob.LineFour); err != nil {
-		return fieldError("LineFour", err, ob.LineFour)
+		errs.Add(fieldError("LineFour", err, ob.LineFour))
 	}
-	return nil
+	return errs
 }
 
 // LineOneField gets a string of the LineOne field
@@ -7,6 +7,10 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // OutputMessageAccountabilityData is the Output Message Accountability Data (OMAD) of the wire
@@ -44,7 +48,10 @@ func NewOutputMessageAccountabilityData() *OutputMessageAccountabilityData {
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
-func (omad *OutputMessageAccountabilityData) Parse(record string) {
+func (omad *OutputMessageAccountabilityData) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 40 {
+		return NewTagWrongLengthErr(40, utf8.RuneCountInString(record))
+	}
 	omad.tag = record[:6]
 	omad.OutputCycleDate = omad.parseStringField(record[6:14])
 	omad.OutputDestinationID = omad.parseStringField(record[14:22])
@@ -52,6 +59,7 @@ func (omad *OutputMessageAccountabilityData) Parse(record string) {
 	omad.OutputDate = omad.parseStringField(record[28:32])
 	omad.OutputTime = omad.parseStringField(record[32:36])
 	omad.OutputFRBApplicationIdentification = omad.parseStringField(record[36:40])
+	return nil
 }
 
 func (omad *OutputMessageAccountabilityData) UnmarshalJSON(data []byte) error {
@@ -94,11 +102,16 @@ func (omad *OutputMessageAccountabilityData) OMAD() string {
 // Validate performs WIRE format rule checks on OutputMessageAccountabilityData and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (omad *OutputMessageAccountabilityData) Validate() error {
-	// Currently no validation as the FED is responsible for the values
+	return omad.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on OutputMessageAccountabilityData and returns every error found
+func (omad *OutputMessageAccountabilityData) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if omad.tag != TagOutputMessageAccountabilityData {
-		return fieldError("tag", ErrValidTagForType, omad.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, omad.tag))
 	}
-	return nil
+	return errs
 }
 
 // OutputCycleDateField gets a string of the OutputCycleDate field
@@ -130,3 +143,47 @@ func (omad *OutputMessageAccountabilityData) OutputTimeField() string {
 func (omad *OutputMessageAccountabilityData) OutputFRBApplicationIdentificationField() string {
 	return omad.alphaField(omad.OutputFRBApplicationIdentification, 4)
 }
+
+// CycleDate returns the OutputCycleDate as midnight in America/New_York
+func (omad *OutputMessageAccountabilityData) CycleDate() (time.Time, error) {
+	t, err := parseCycleDate(omad.OutputCycleDate)
+	if err != nil {
+		return time.Time{}, fieldError("OutputCycleDate", err, omad.OutputCycleDate)
+	}
+	return t, nil
+}
+
+// SetCycleDate sets the OutputCycleDate to the date of t in America/New_York
+func (omad *OutputMessageAccountabilityData) SetCycleDate(t time.Time) error {
+	s, err := formatCycleDate(t)
+	if err != nil {
+		return err
+	}
+	omad.OutputCycleDate = s
+	return nil
+}
+
+// OutputTimestamp returns the OutputDate and OutputTime in America/New_York. They have no year, which is taken
+// from the OutputCycleDate.
+func (omad *OutputMessageAccountabilityData) OutputTimestamp() (time.Time, error) {
+	cycleDate, err := omad.CycleDate()
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := parseMonthDayTime(omad.OutputDate, omad.OutputTime, cycleDate)
+	if err != nil {
+		return time.Time{}, fieldError("OutputDate", err, omad.OutputDate+omad.OutputTime)
+	}
+	return t, nil
+}
+
+// SetOutputTimestamp sets the OutputDate and OutputTime to t in America/New_York
+func (omad *OutputMessageAccountabilityData) SetOutputTimestamp(t time.Time) error {
+	date, hourMinute, err := formatMonthDayTime(t)
+	if err != nil {
+		return err
+	}
+	omad.OutputDate = date
+	omad.OutputTime = hourMinute
+	return nil
+}
@@ -3,6 +3,7 @@ package wire
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -62,3 +63,41 @@ func TestOutputMessageAccountabilityDataTagError(t *testing.T) {
 
 	require.EqualError(t, omad.Validate(), fieldError("tag", ErrValidTagForType, omad.tag).Error())
 }
+
+// TestOutputMessageAccountabilityDataTimestamp validates OutputCycleDate, OutputDate and OutputTime convert to and
+// from a time.Time
+func TestOutputMessageAccountabilityDataTimestamp(t *testing.T) {
+	loc, err := FedwireLocation()
+	require.NoError(t, err)
+	omad := mockOutputMessageAccountabilityData()
+
+	cycleDate, err := omad.CycleDate()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.May, 2, 0, 0, 0, 0, loc), cycleDate)
+
+	ts, err := omad.OutputTimestamp()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.May, 2, 12, 30, 0, 0, loc), ts)
+
+	// output on the evening of December 31 for the January 2 cycle date
+	omad.OutputCycleDate = "20200102"
+	omad.OutputDate = "1231"
+	omad.OutputTime = "2115"
+	ts, err = omad.OutputTimestamp()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.December, 31, 21, 15, 0, 0, loc), ts)
+
+	require.NoError(t, omad.SetCycleDate(time.Date(2021, time.March, 15, 0, 0, 0, 0, loc)))
+	require.NoError(t, omad.SetOutputTimestamp(time.Date(2021, time.March, 14, 13, 5, 0, 0, time.UTC)))
+	require.Equal(t, "20210315", omad.OutputCycleDate)
+	require.Equal(t, "0314", omad.OutputDate)
+	require.Equal(t, "0905", omad.OutputTime)
+
+	omad.OutputTime = "2561"
+	_, err = omad.OutputTimestamp()
+	require.EqualError(t, err, fieldError("OutputDate", ErrValidDate, "03142561").Error())
+
+	omad.OutputCycleDate = ""
+	_, err = omad.OutputTimestamp()
+	require.EqualError(t, err, fieldError("OutputCycleDate", ErrValidDate, "").Error())
+}
@@ -0,0 +1,200 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"strings"
+	"time"
+)
+
+// The TransactionStatus of a pacs.002 status report
+const (
+	// isoStatusSettled is the status of a message accepted by the Fedwire Funds Service
+	isoStatusSettled = "ACSC"
+	// isoStatusPending is the status of a message in process or intercepted
+	isoStatusPending = "PDNG"
+)
+
+// Pacs002Document is a Fedwire Funds Service FIToFIPaymentStatusReport, pacs.002.001.10, the status of a message
+// sent to the Fedwire Funds Service
+type Pacs002Document struct {
+	XMLName      xml.Name       `xml:"urn:iso:std:iso:20022:tech:xsd:pacs.002.001.10 Document"`
+	StatusReport Pacs002Message `xml:"FIToFIPmtStsRpt"`
+}
+
+// Pacs002Message is a FIToFIPaymentStatusReportV10 of the single transaction of a FEDWireMessage
+type Pacs002Message struct {
+	GroupHeader       ISOGroupHeader              `xml:"GrpHdr"`
+	OriginalGroupInfo ISOOriginalGroupInformation `xml:"OrgnlGrpInfAndSts"`
+	TransactionStatus Pacs002TransactionStatus    `xml:"TxInfAndSts"`
+}
+
+// Pacs002TransactionStatus is a PaymentTransaction110, the status of the transaction of a FEDWireMessage
+type Pacs002TransactionStatus struct {
+	OriginalInstructionID            string                 `xml:"OrgnlInstrId,omitempty"`
+	OriginalEndToEndID               string                 `xml:"OrgnlEndToEndId,omitempty"`
+	Status                           string                 `xml:"TxSts"`
+	StatusReason                     []ISOReasonInformation `xml:"StsRsnInf,omitempty"`
+	AcceptanceDateTime               string                 `xml:"AccptncDtTm,omitempty"`
+	EffectiveInterbankSettlementDate *ISODateChoice         `xml:"FctvIntrBkSttlmDt,omitempty"`
+	InstructingAgent                 *ISOAgent              `xml:"InstgAgt,omitempty"`
+	InstructedAgent                  *ISOAgent              `xml:"InstdAgt,omitempty"`
+}
+
+// Bytes returns the XML of doc
+func (doc *Pacs002Document) Bytes() ([]byte, error) {
+	return marshalISODocument(doc)
+}
+
+// ToPacs002 returns the pacs.002 status report of fwm, a message received from the Fedwire Funds Service in
+// response to a message sent to it, describing its ErrorWire by NewErrorCatalog
+func (fwm *FEDWireMessage) ToPacs002(opts ISOOpts) (*Pacs002Document, error) {
+	return NewErrorCatalog().Pacs002(fwm, opts)
+}
+
+// Pacs002 returns the pacs.002 status report of fwm, a message received from the Fedwire Funds Service in response
+// to a message sent to it, describing its ErrorWire by c. The TransactionStatus is ACSC for an accepted message,
+// RJCT for a rejected one and PDNG for one in process or intercepted, as its Acknowledgment.
+//
+// The OMAD of fwm, which must have its OutputCycleDate, OutputDestinationID and OutputSequenceNumber, is the
+// MessageIdentification of the report and its IMAD the OriginalMessageIdentification. The ErrorCategory and
+// ErrorCode of an ErrorWire are the proprietary StatusReason, with its ErrorDescription as the additional
+// information. An accepted message has the ReceiptTimeStamp of fwm, or else its OutputDate and
+// OutputTime, as its AcceptanceDateTime, and the OutputCycleDate as its EffectiveInterbankSettlementDate when it
+// was processed with accounting.
+func (c *ErrorCatalog) Pacs002(fwm *FEDWireMessage, opts ISOOpts) (*Pacs002Document, error) {
+	if fwm.InputMessageAccountabilityData == nil {
+		return nil, fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+	omad := fwm.OutputMessageAccountabilityData
+	if omad == nil {
+		return nil, fieldError("OutputMessageAccountabilityData", ErrFieldRequired)
+	}
+	if strings.TrimSpace(omad.OutputCycleDate) == "" {
+		return nil, fieldError("OutputMessageAccountabilityData.OutputCycleDate", ErrFieldRequired)
+	}
+	if strings.TrimSpace(omad.OutputDestinationID) == "" {
+		return nil, fieldError("OutputMessageAccountabilityData.OutputDestinationID", ErrFieldRequired)
+	}
+	if strings.TrimSpace(omad.OutputSequenceNumber) == "" {
+		return nil, fieldError("OutputMessageAccountabilityData.OutputSequenceNumber", ErrFieldRequired)
+	}
+	ack, err := c.Acknowledgment(fwm)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := newISOGroupHeader(omad.OMAD(), opts)
+	if err != nil {
+		return nil, err
+	}
+	header.NumberOfTxs = ""
+	header.SettlementInfo = nil
+
+	tx := Pacs002TransactionStatus{}
+	switch ack.Status {
+	case AcknowledgmentAccepted:
+		tx.Status = isoStatusSettled
+		if err := fwm.setISOAcceptance(&tx); err != nil {
+			return nil, err
+		}
+	case AcknowledgmentRejected:
+		tx.Status = isoStatusRejected
+	default:
+		tx.Status = isoStatusPending
+	}
+	if fwm.ErrorWire != nil {
+		tx.StatusReason = []ISOReasonInformation{isoErrorWireReason(fwm.ErrorWire, ack.Error)}
+	}
+	if fwm.SenderReference != nil {
+		tx.OriginalInstructionID = strings.TrimSpace(fwm.SenderReference.SenderReference)
+	}
+	switch {
+	case fwm.PaymentNotification != nil && strings.TrimSpace(fwm.PaymentNotification.EndToEndIdentification) != "":
+		tx.OriginalEndToEndID = strings.TrimSpace(fwm.PaymentNotification.EndToEndIdentification)
+	case fwm.BeneficiaryReference != nil && strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference) != "":
+		tx.OriginalEndToEndID = strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference)
+	}
+	if fwm.SenderDepositoryInstitution != nil {
+		tx.InstructingAgent = isoABAAgent(fwm.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderShortName)
+	}
+	if fwm.ReceiverDepositoryInstitution != nil {
+		tx.InstructedAgent = isoABAAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+	}
+
+	return &Pacs002Document{
+		StatusReport: Pacs002Message{
+			GroupHeader: header,
+			OriginalGroupInfo: ISOOriginalGroupInformation{
+				OriginalMessageID:     fwm.InputMessageAccountabilityData.IMAD(),
+				OriginalMessageNameID: fwm.isoMessageNameID(),
+			},
+			TransactionStatus: tx,
+		},
+	}, nil
+}
+
+// setISOAcceptance sets the AcceptanceDateTime and EffectiveInterbankSettlementDate of tx, the status of fwm
+// accepted by the Fedwire Funds Service
+func (fwm *FEDWireMessage) setISOAcceptance(tx *Pacs002TransactionStatus) error {
+	omad := fwm.OutputMessageAccountabilityData
+	cycleDate, err := omad.CycleDate()
+	if err != nil {
+		return fieldError("OutputMessageAccountabilityData.OutputCycleDate", err, omad.OutputCycleDate)
+	}
+	var accepted time.Time
+	if fwm.ReceiptTimeStamp != nil {
+		accepted, err = fwm.ReceiptTimeStamp.Timestamp(cycleDate)
+	} else {
+		accepted, err = omad.OutputTimestamp()
+	}
+	if err != nil {
+		return err
+	}
+	tx.AcceptanceDateTime = accepted.Format(isoDateTimeLayout)
+	if fwm.MessageDisposition != nil && fwm.MessageDisposition.MessageStatusIndicator == MessageStatusSuccessfulValue {
+		tx.EffectiveInterbankSettlementDate = &ISODateChoice{Date: cycleDate.Format(isoDateLayout)}
+	}
+	return nil
+}
+
+// isoErrorWireReason returns the StatusReason of ew, described by code: its ErrorCategory and ErrorCode as a
+// proprietary reason, and its ErrorDescription, or else the description of code, as the additional information
+func isoErrorWireReason(ew *ErrorWire, code *ErrorWireCode) ISOReasonInformation {
+	info := ISOReasonInformation{
+		Reason: &ISOCodeOrProprietary{Proprietary: strings.TrimSpace(ew.ErrorCategory + ew.ErrorCode)},
+	}
+	description := strings.TrimSpace(ew.ErrorDescription)
+	if description == "" && code != nil {
+		description = code.Description
+	}
+	if description != "" {
+		info.AdditionalInfo = []string{description}
+	}
+	return info
+}
+
+// isoMessageNameID returns the MessageNameIdentification of the message fwm converts to: a pacs.004 reversal,
+// camt.056 request for reversal, pain.013 drawdown request or pain.014 drawdown response, and otherwise a pacs.009
+// for a transfer between financial institutions or a pacs.008
+func (fwm *FEDWireMessage) isoMessageNameID() string {
+	if fwm.TypeSubType != nil {
+		switch fwm.TypeSubType.SubTypeCode {
+		case ReversalTransfer, ReversalPriorDayTransfer:
+			return pacs004MessageNameID
+		case RequestReversal, RequestReversalPriorDayTransfer:
+			return camt056MessageNameID
+		case RequestCredit:
+			return pain013MessageNameID
+		case FundsTransferRequestCredit, RefusalRequestCredit:
+			return pain014MessageNameID
+		}
+	}
+	if fwm.BusinessFunctionCode != nil && fwm.isPacs009() {
+		return pacs009MessageNameID
+	}
+	return pacs008MessageNameID
+}
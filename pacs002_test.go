@@ -0,0 +1,168 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockPacs002Response creates the response of the Fedwire Funds Service to a customer transfer
+func mockPacs002Response() FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20190502"
+	fwm.MessageDisposition = mockMessageDisposition()
+	fwm.ReceiptTimeStamp = mockReceiptTimeStamp()
+	fwm.OutputMessageAccountabilityData = mockOutputMessageAccountabilityData()
+	fwm.SenderReference = mockSenderReference()
+	fwm.BeneficiaryReference = mockBeneficiaryReference()
+	return fwm
+}
+
+func TestToPacs002(t *testing.T) {
+	fwm := mockPacs002Response()
+	created := time.Date(2019, time.May, 2, 16, 31, 0, 0, time.UTC)
+	doc, err := fwm.ToPacs002(ISOOpts{CreationTime: created})
+	require.NoError(t, err)
+
+	msg := doc.StatusReport
+	require.Equal(t, "20190502Source08000001", msg.GroupHeader.MessageID)
+	require.Equal(t, "2019-05-02T12:31:00-04:00", msg.GroupHeader.CreationDateTime)
+	require.Empty(t, msg.GroupHeader.NumberOfTxs)
+	require.Nil(t, msg.GroupHeader.SettlementInfo)
+	require.Equal(t, fwm.InputMessageAccountabilityData.IMAD(), msg.OriginalGroupInfo.OriginalMessageID)
+	require.Equal(t, pacs008MessageNameID, msg.OriginalGroupInfo.OriginalMessageNameID)
+
+	tx := msg.TransactionStatus
+	require.Equal(t, isoStatusSettled, tx.Status)
+	require.Empty(t, tx.StatusReason)
+	require.Equal(t, strings.TrimSpace(fwm.SenderReference.SenderReference), tx.OriginalInstructionID)
+	require.Equal(t, strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference), tx.OriginalEndToEndID)
+	require.Equal(t, "2019-05-02T12:30:00-04:00", tx.AcceptanceDateTime)
+	require.Equal(t, "2019-05-02", tx.EffectiveInterbankSettlementDate.Date)
+	require.Equal(t, fwm.SenderDepositoryInstitution.SenderABANumber, tx.InstructingAgent.ABA())
+	require.Equal(t, fwm.ReceiverDepositoryInstitution.ReceiverABANumber, tx.InstructedAgent.ABA())
+
+	bs, err := doc.Bytes()
+	require.NoError(t, err)
+	require.Contains(t, string(bs), `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.002.001.10">`)
+	require.Contains(t, string(bs), "<TxSts>ACSC</TxSts>")
+}
+
+func TestToPacs002_nonValue(t *testing.T) {
+	fwm := mockPacs002Response()
+	fwm.MessageDisposition.MessageStatusIndicator = MessageStatusSuccessfulNonValue
+	fwm.ReceiptTimeStamp = nil
+	fwm.OutputMessageAccountabilityData.OutputTime = "1245"
+	doc, err := fwm.ToPacs002(ISOOpts{})
+	require.NoError(t, err)
+
+	tx := doc.StatusReport.TransactionStatus
+	require.Equal(t, isoStatusSettled, tx.Status)
+	require.Equal(t, "2019-05-02T12:45:00-04:00", tx.AcceptanceDateTime)
+	require.Nil(t, tx.EffectiveInterbankSettlementDate)
+}
+
+func TestToPacs002_rejected(t *testing.T) {
+	fwm := mockPacs002Response()
+	fwm.MessageDisposition.MessageStatusIndicator = MessageStatusRejected
+	fwm.ErrorWire = mockErrorWire()
+	fwm.ErrorWire.ErrorCategory = ErrorCategoryAccountability
+	fwm.ErrorWire.ErrorCode = "024"
+	fwm.ErrorWire.ErrorDescription = "INVLD CYCLE DT/MISSING/INVLD"
+	doc, err := fwm.ToPacs002(ISOOpts{})
+	require.NoError(t, err)
+
+	tx := doc.StatusReport.TransactionStatus
+	require.Equal(t, isoStatusRejected, tx.Status)
+	require.Empty(t, tx.AcceptanceDateTime)
+	require.Nil(t, tx.EffectiveInterbankSettlementDate)
+	require.Len(t, tx.StatusReason, 1)
+	require.Equal(t, "H024", tx.StatusReason[0].Reason.Proprietary)
+	require.Equal(t, []string{"INVLD CYCLE DT/MISSING/INVLD"}, tx.StatusReason[0].AdditionalInfo)
+
+	// without an ErrorDescription the reason is described by the ErrorCatalog
+	fwm.ErrorWire.ErrorDescription = ""
+	doc, err = fwm.ToPacs002(ISOOpts{})
+	require.NoError(t, err)
+	reason := doc.StatusReport.TransactionStatus.StatusReason[0]
+	require.Equal(t, "H024", reason.Reason.Proprietary)
+	require.Len(t, reason.AdditionalInfo, 1)
+	require.NotEmpty(t, reason.AdditionalInfo[0])
+
+	// a rejection without an ErrorWire has no reason
+	fwm.ErrorWire = nil
+	doc, err = fwm.ToPacs002(ISOOpts{})
+	require.NoError(t, err)
+	require.Equal(t, isoStatusRejected, doc.StatusReport.TransactionStatus.Status)
+	require.Empty(t, doc.StatusReport.TransactionStatus.StatusReason)
+}
+
+func TestToPacs002_pending(t *testing.T) {
+	fwm := mockPacs002Response()
+	fwm.MessageDisposition.MessageStatusIndicator = MessageStatusInProcess
+	doc, err := fwm.ToPacs002(ISOOpts{})
+	require.NoError(t, err)
+	require.Equal(t, isoStatusPending, doc.StatusReport.TransactionStatus.Status)
+
+	fwm.ErrorWire = mockErrorWire()
+	fwm.ErrorWire.ErrorCategory = ErrorCategoryIntercepted
+	fwm.ErrorWire.ErrorCode = "ABC"
+	fwm.ErrorWire.ErrorDescription = "INTERCEPTED"
+	doc, err = fwm.ToPacs002(ISOOpts{})
+	require.NoError(t, err)
+	tx := doc.StatusReport.TransactionStatus
+	require.Equal(t, isoStatusPending, tx.Status)
+	require.Equal(t, "IABC", tx.StatusReason[0].Reason.Proprietary)
+	require.Equal(t, []string{"INTERCEPTED"}, tx.StatusReason[0].AdditionalInfo)
+}
+
+func TestToPacs002_originalMessageNameID(t *testing.T) {
+	fwm := mockPacs002Response()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BankTransfer
+	doc, err := fwm.ToPacs002(ISOOpts{})
+	require.NoError(t, err)
+	require.Equal(t, pacs009MessageNameID, doc.StatusReport.OriginalGroupInfo.OriginalMessageNameID)
+
+	fwm.TypeSubType.SubTypeCode = ReversalTransfer
+	doc, err = fwm.ToPacs002(ISOOpts{})
+	require.NoError(t, err)
+	require.Equal(t, pacs004MessageNameID, doc.StatusReport.OriginalGroupInfo.OriginalMessageNameID)
+
+	fwm.TypeSubType.SubTypeCode = RequestReversal
+	doc, err = fwm.ToPacs002(ISOOpts{})
+	require.NoError(t, err)
+	require.Equal(t, camt056MessageNameID, doc.StatusReport.OriginalGroupInfo.OriginalMessageNameID)
+}
+
+func TestToPacs002_required(t *testing.T) {
+	fwm := mockPacs002Response()
+	fwm.OutputMessageAccountabilityData = nil
+	_, err := fwm.ToPacs002(ISOOpts{})
+	require.EqualError(t, err, fieldError("OutputMessageAccountabilityData", ErrFieldRequired).Error())
+
+	fwm = mockPacs002Response()
+	fwm.OutputMessageAccountabilityData.OutputDestinationID = ""
+	_, err = fwm.ToPacs002(ISOOpts{})
+	require.EqualError(t, err, fieldError("OutputMessageAccountabilityData.OutputDestinationID", ErrFieldRequired).Error())
+
+	fwm = mockPacs002Response()
+	fwm.OutputMessageAccountabilityData.OutputSequenceNumber = " "
+	_, err = fwm.ToPacs002(ISOOpts{})
+	require.EqualError(t, err, fieldError("OutputMessageAccountabilityData.OutputSequenceNumber", ErrFieldRequired).Error())
+
+	fwm = mockPacs002Response()
+	fwm.InputMessageAccountabilityData = nil
+	_, err = fwm.ToPacs002(ISOOpts{})
+	require.EqualError(t, err, fieldError("InputMessageAccountabilityData", ErrFieldRequired).Error())
+
+	fwm = mockPacs002Response()
+	fwm.MessageDisposition = nil
+	_, err = fwm.ToPacs002(ISOOpts{})
+	require.EqualError(t, err, fieldError("MessageDisposition", ErrFieldRequired).Error())
+}
@@ -0,0 +1,167 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// pacs004Format names the pacs.004 message in ConversionWarnings
+const pacs004Format = "pacs.004"
+
+// pacs004Tags are the tags of a FEDWireMessage which ToPacs004 translates
+var pacs004Tags = []string{
+	TagSenderSupplied, TagTypeSubType, TagInputMessageAccountabilityData, TagAmount, TagSenderDepositoryInstitution,
+	TagReceiverDepositoryInstitution, TagBusinessFunctionCode, TagSenderReference, TagPreviousMessageIdentifier,
+	TagLocalInstrument, TagBeneficiaryFI, TagBeneficiary, TagBeneficiaryReference, TagOriginator, TagOriginatorOptionF,
+	TagOriginatorFI, TagServiceMessage,
+}
+
+// Pacs004Document is a Fedwire Funds Service PaymentReturn, pacs.004.001.10, the reversal of a transfer
+type Pacs004Document struct {
+	XMLName       xml.Name       `xml:"urn:iso:std:iso:20022:tech:xsd:pacs.004.001.10 Document"`
+	PaymentReturn Pacs004Message `xml:"PmtRtr"`
+}
+
+// Pacs004Message is a PaymentReturnV10 of the single transaction of a FEDWireMessage
+type Pacs004Message struct {
+	GroupHeader     ISOGroupHeader     `xml:"GrpHdr"`
+	TransactionInfo Pacs004Transaction `xml:"TxInf"`
+}
+
+// Pacs004Transaction is a PaymentTransaction118. Its ReturnChain holds the parties of the return, whose debtor
+// was the creditor of the original transaction.
+type Pacs004Transaction struct {
+	ReturnID                          string                           `xml:"RtrId,omitempty"`
+	OriginalGroupInfo                 ISOOriginalGroupInformation      `xml:"OrgnlGrpInf"`
+	OriginalEndToEndID                string                           `xml:"OrgnlEndToEndId,omitempty"`
+	OriginalInterbankSettlementDate   string                           `xml:"OrgnlIntrBkSttlmDt,omitempty"`
+	ReturnedInterbankSettlementAmount ISOAmount                        `xml:"RtrdIntrBkSttlmAmt"`
+	InterbankSettlementDate           string                           `xml:"IntrBkSttlmDt,omitempty"`
+	InstructingAgent                  *ISOAgent                        `xml:"InstgAgt,omitempty"`
+	InstructedAgent                   *ISOAgent                        `xml:"InstdAgt,omitempty"`
+	ReturnChain                       ISOTransactionParties            `xml:"RtrChain"`
+	ReturnReason                      []ISOReasonInformation           `xml:"RtrRsnInf,omitempty"`
+	OriginalTransactionReference      *ISOOriginalTransactionReference `xml:"OrgnlTxRef,omitempty"`
+}
+
+// Bytes returns the XML of doc
+func (doc *Pacs004Document) Bytes() ([]byte, error) {
+	return marshalISODocument(doc)
+}
+
+// ToPacs004 converts fwm, a reversal with SubTypeCode ReversalTransfer or ReversalPriorDayTransfer, to a pacs.004
+// message. The PreviousMessageIdentifier is the MessageIdentification of the original message, the
+// BusinessFunctionCode is the CategoryPurpose of the original transaction, and the ServiceMessage is the reason for
+// the return, with a reason code when one is recognised in its text.
+//
+// Tags without a corresponding element, and values truncated to fit one, are returned as warnings.
+func (fwm *FEDWireMessage) ToPacs004(opts ISOOpts) (*Pacs004Document, []ConversionWarning, error) {
+	if fwm.TypeSubType != nil {
+		switch code := fwm.TypeSubType.SubTypeCode; code {
+		case ReversalTransfer, ReversalPriorDayTransfer:
+		default:
+			return nil, nil, fieldError("TypeSubType.SubTypeCode", ErrISOMessageType, code)
+		}
+	}
+	if err := fwm.mandatoryFields(); err != nil {
+		return nil, nil, err
+	}
+	original, originalDate, err := fwm.isoOriginalGroupInformation()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ws conversionWarnings
+	imad := fwm.InputMessageAccountabilityData
+	header, err := newISOGroupHeader(imad.IMAD(), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	tx := Pacs004Transaction{
+		OriginalGroupInfo:               original,
+		OriginalEndToEndID:              isoNotProvided,
+		OriginalInterbankSettlementDate: originalDate,
+		InstructingAgent:                isoABAAgent(fwm.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderShortName),
+		InstructedAgent:                 isoABAAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName),
+		ReturnReason:                    fwm.isoServiceMessageReason(isoReturnReasons),
+		OriginalTransactionReference:    &ISOOriginalTransactionReference{PaymentType: fwm.isoPaymentType()},
+	}
+	if fwm.SenderReference != nil {
+		tx.ReturnID = strings.TrimSpace(fwm.SenderReference.SenderReference)
+	}
+	if fwm.BeneficiaryReference != nil && strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference) != "" {
+		tx.OriginalEndToEndID = strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference)
+	}
+	if code := fwm.TypeSubType.TypeCode; code != isoTypeCode(fwm.BusinessFunctionCode.BusinessFunctionCode) {
+		ws.add(TagTypeSubType, "TypeSubType.TypeCode", "%s is not translated to %s", code, pacs004Format)
+	}
+	if tx.InterbankSettlementDate, err = isoDate(imad.InputCycleDate); err != nil {
+		return nil, nil, fieldError("InputMessageAccountabilityData.InputCycleDate", err, imad.InputCycleDate)
+	}
+	amt, err := fwm.Amount.Money()
+	if err != nil {
+		return nil, nil, fieldError("Amount.Amount", err, fwm.Amount.Amount)
+	}
+	if tx.ReturnedInterbankSettlementAmount, err = newISOAmount(amt); err != nil {
+		return nil, nil, fieldError("Amount.Amount", err, fwm.Amount.Amount)
+	}
+	tx.ReturnChain = fwm.isoTransactionParties(tx.InstructingAgent, &ws)
+
+	ws.untranslated(fwm, pacs004Tags, pacs004Format)
+	fwm.isoSenderSuppliedWarnings(&ws)
+
+	doc := &Pacs004Document{PaymentReturn: Pacs004Message{
+		GroupHeader:     header,
+		TransactionInfo: tx,
+	}}
+	return doc, ws, nil
+}
+
+// FEDWireMessage converts doc to a reversal of the original message it returns, with SubTypeCode
+// ReversalPriorDayTransfer when the original was sent on an earlier cycle date and otherwise ReversalTransfer. The
+// BusinessFunctionCode is the CategoryPurpose of the original transaction when it permits a reversal, and
+// otherwise a BankTransfer for a pacs.009 and a CustomerTransfer for other original messages. The reasons for the
+// return are its ServiceMessage.
+//
+// Elements without a corresponding tag, values truncated to fit one, and tags removed as not permitted with the
+// BusinessFunctionCode of the message are returned as warnings.
+func (doc *Pacs004Document) FEDWireMessage(opts ISOOpts) (FEDWireMessage, []ConversionWarning, error) {
+	var ws conversionWarnings
+	msg := doc.PaymentReturn
+	tx := msg.TransactionInfo
+	fwm := FEDWireMessage{}
+	if err := fwm.setISOMessageID("GrpHdr.MsgId", msg.GroupHeader.MessageID, opts); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	if err := fwm.setISOAmount("TxInf.RtrdIntrBkSttlmAmt", tx.ReturnedInterbankSettlementAmount); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	if err := fwm.setISODepositoryInstitutions("TxInf.InstgAgt", tx.InstructingAgent, "TxInf.InstdAgt",
+		tx.InstructedAgent, &ws); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	fwm.setISOReferences("TxInf.RtrId", tx.ReturnID, "TxInf.OrgnlEndToEndId", tx.OriginalEndToEndID, &ws)
+
+	var purpose *ISOCodeOrProprietary
+	if ref := tx.OriginalTransactionReference; ref != nil {
+		if ref.PaymentType != nil {
+			purpose = ref.PaymentType.CategoryPurpose
+			fwm.LocalInstrument = fedwireLocalInstrument(ref.PaymentType.LocalInstrument, &ws)
+		}
+		if ref.Debtor != nil || ref.Creditor != nil || ref.DebtorAgent != nil || ref.CreditorAgent != nil {
+			ws.add("", "TxInf.OrgnlTxRef", "parties of the original transaction are not translated")
+		}
+	}
+	if err := fwm.setISOReversal("TxInf.OrgnlGrpInf", tx.OriginalGroupInfo, purpose, ReversalTransfer,
+		ReversalPriorDayTransfer, ""); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	fwm.setISOTransactionParties("TxInf.RtrChain", tx.ReturnChain, tx.InstructingAgent, tx.InstructedAgent, &ws)
+	fwm.ServiceMessage = fedwireServiceMessage("TxInf.RtrRsnInf", tx.ReturnReason, isoReturnReasons, &ws)
+	fwm.removeISOUnpermittedTags(&ws)
+	return fwm, ws, nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockPacs004Reversal creates a reversal of a CustomerTransfer sent on an earlier cycle date
+func mockPacs004Reversal(t *testing.T) (FEDWireMessage, FEDWireMessage) {
+	original := mockPacs008CustomerTransfer()
+	opts := ReversalOpts{InputMessageAccountabilityData: mockInputMessageAccountabilityData(), SenderReference: "Reversal"}
+	opts.InputMessageAccountabilityData.InputCycleDate = "20201006"
+	opts.InputMessageAccountabilityData.InputSequenceNumber = "000002"
+	fwm, err := NewReversalOf(original, opts)
+	require.NoError(t, err)
+	return original, fwm
+}
+
+func TestToPacs004(t *testing.T) {
+	original, fwm := mockPacs004Reversal(t)
+	created := time.Date(2020, time.October, 6, 14, 30, 0, 0, time.UTC)
+	doc, warnings, err := fwm.ToPacs004(ISOOpts{CreationTime: created})
+	require.NoError(t, err)
+
+	hdr := doc.PaymentReturn.GroupHeader
+	require.Equal(t, fwm.InputMessageAccountabilityData.IMAD(), hdr.MessageID)
+	require.Equal(t, "2020-10-06T10:30:00-04:00", hdr.CreationDateTime)
+
+	tx := doc.PaymentReturn.TransactionInfo
+	require.Equal(t, "Reversal", tx.ReturnID)
+	require.Equal(t, original.InputMessageAccountabilityData.IMAD(), tx.OriginalGroupInfo.OriginalMessageID)
+	require.Equal(t, pacs008MessageNameID, tx.OriginalGroupInfo.OriginalMessageNameID)
+	require.Equal(t, isoNotProvided, tx.OriginalEndToEndID)
+	require.Equal(t, "2020-10-05", tx.OriginalInterbankSettlementDate)
+	require.Equal(t, "2020-10-06", tx.InterbankSettlementDate)
+	require.Equal(t, ISOAmount{Currency: "USD", Value: "12345.67"}, tx.ReturnedInterbankSettlementAmount)
+	require.Equal(t, "231380104", tx.InstructingAgent.ABA())
+	require.Equal(t, "121042882", tx.InstructedAgent.ABA())
+	require.Equal(t, CustomerTransfer, tx.OriginalTransactionReference.PaymentType.CategoryPurpose.Proprietary)
+
+	chain := tx.ReturnChain
+	require.Equal(t, "987654321", chain.DebtorAccount.Number())
+	require.Nil(t, chain.Debtor.Agent)
+	require.Equal(t, "231380104", chain.DebtorAgent.ABA())
+	require.Equal(t, "CITIUS33", chain.CreditorAgent.FinancialInstitution.BICFI)
+	require.Equal(t, "Name", chain.Creditor.Party.Name)
+	require.Nil(t, tx.ReturnReason)
+	require.Equal(t, []ConversionWarning{{
+		Tag:     TagSenderSupplied,
+		Field:   "SenderSupplied.UserRequestCorrelation",
+		Message: "is not translated to ISO 20022",
+	}}, warnings)
+}
+
+func TestToPacs004_reason(t *testing.T) {
+	_, fwm := mockPacs004Reversal(t)
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	fwm.ServiceMessage = NewServiceMessage()
+	fwm.ServiceMessage.LineOne = "Beneficiary account closed"
+	require.NoError(t, fwm.verify())
+	doc, _, err := fwm.ToPacs004(ISOOpts{})
+	require.NoError(t, err)
+
+	require.Equal(t, []ISOReasonInformation{{
+		Reason:         &ISOCodeOrProprietary{Code: "AC04"},
+		AdditionalInfo: []string{"Beneficiary account closed"},
+	}}, doc.PaymentReturn.TransactionInfo.ReturnReason)
+}
+
+func TestToPacs004_invalid(t *testing.T) {
+	fwm := mockPacs008CustomerTransfer()
+	_, _, err := fwm.ToPacs004(ISOOpts{})
+	require.EqualError(t, err, fieldError("TypeSubType.SubTypeCode", ErrISOMessageType, BasicFundsTransfer).Error())
+
+	_, fwm = mockPacs004Reversal(t)
+	fwm.PreviousMessageIdentifier = nil
+	_, _, err = fwm.ToPacs004(ISOOpts{})
+	require.EqualError(t, err, fieldError("PreviousMessageIdentifier", ErrFieldRequired).Error())
+}
+
+func TestPacs004Document_FEDWireMessage(t *testing.T) {
+	_, fwm := mockPacs004Reversal(t)
+	doc, _, err := fwm.ToPacs004(ISOOpts{})
+	require.NoError(t, err)
+	read, warnings := readISO20022(t, doc)
+
+	require.Equal(t, fwm.InputMessageAccountabilityData, read.InputMessageAccountabilityData)
+	require.Equal(t, fwm.TypeSubType, read.TypeSubType)
+	require.Equal(t, ReversalPriorDayTransfer, read.TypeSubType.SubTypeCode)
+	require.Equal(t, CustomerTransfer, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, fwm.PreviousMessageIdentifier, read.PreviousMessageIdentifier)
+	require.Equal(t, fwm.Amount, read.Amount)
+	require.Equal(t, fwm.SenderDepositoryInstitution, read.SenderDepositoryInstitution)
+	require.Equal(t, fwm.ReceiverDepositoryInstitution, read.ReceiverDepositoryInstitution)
+	require.Equal(t, fwm.SenderReference, read.SenderReference)
+	require.Nil(t, read.BeneficiaryReference)
+	require.Equal(t, fwm.Originator, read.Originator)
+	require.Equal(t, fwm.OriginatorFI, read.OriginatorFI)
+	require.Equal(t, fwm.BeneficiaryFI, read.BeneficiaryFI)
+	require.Equal(t, fwm.Beneficiary, read.Beneficiary)
+	require.Nil(t, read.ServiceMessage)
+	require.Empty(t, warnings)
+}
+
+func TestPacs004Document_FEDWireMessageBankTransfer(t *testing.T) {
+	original := mockPacs009BankTransfer()
+	fwm, err := NewReversalOf(original, ReversalOpts{InputMessageAccountabilityData: original.InputMessageAccountabilityData})
+	require.NoError(t, err)
+	fwm.InputMessageAccountabilityData.InputSequenceNumber = "000002"
+	doc, _, err := fwm.ToPacs004(ISOOpts{})
+	require.NoError(t, err)
+	require.Equal(t, pacs009MessageNameID, doc.PaymentReturn.TransactionInfo.OriginalGroupInfo.OriginalMessageNameID)
+	require.NotNil(t, doc.PaymentReturn.TransactionInfo.ReturnChain.Debtor.Agent)
+	read, warnings := readISO20022(t, doc)
+
+	require.Equal(t, BankTransfer, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, ReversalTransfer, read.TypeSubType.SubTypeCode)
+	require.Equal(t, fwm.Originator, read.Originator)
+	require.Equal(t, fwm.Beneficiary, read.Beneficiary)
+	require.Empty(t, warnings)
+}
+
+func TestPacs004Document_FEDWireMessageReason(t *testing.T) {
+	_, fwm := mockPacs004Reversal(t)
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	doc, _, err := fwm.ToPacs004(ISOOpts{})
+	require.NoError(t, err)
+	tx := &doc.PaymentReturn.TransactionInfo
+	tx.ReturnReason = []ISOReasonInformation{{Reason: &ISOCodeOrProprietary{Code: "AC04"}}}
+	read, _ := readISO20022(t, doc)
+	require.Equal(t, CustomerTransferPlus, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "AC04 CLOSED ACCOUNT NUMBER", read.ServiceMessage.LineOne)
+
+	// a CustomerTransfer does not permit a ServiceMessage
+	tx.OriginalTransactionReference = nil
+	read, warnings := readISO20022(t, doc)
+	require.Equal(t, CustomerTransfer, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Nil(t, read.ServiceMessage)
+	require.Equal(t, []ConversionWarning{{
+		Tag:     TagServiceMessage,
+		Field:   "ServiceMessage",
+		Message: "is not permitted with BusinessFunctionCode CTR",
+	}}, warnings)
+}
+
+func TestPacs004Document_FEDWireMessageInvalid(t *testing.T) {
+	_, fwm := mockPacs004Reversal(t)
+	doc, _, err := fwm.ToPacs004(ISOOpts{})
+	require.NoError(t, err)
+	doc.PaymentReturn.TransactionInfo.OriginalGroupInfo.OriginalMessageID = "20201005"
+	_, _, err = doc.FEDWireMessage(ISOOpts{})
+	require.EqualError(t, err, fieldError("TxInf.OrgnlGrpInf.OrgnlMsgId", ErrInvalidProperty, "20201005").Error())
+}
@@ -0,0 +1,1051 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"strings"
+	"time"
+)
+
+// pacs008Format names the pacs.008 message in ConversionWarnings
+const pacs008Format = "pacs.008"
+
+// pacs008Tags are the tags of a FEDWireMessage which ToPacs008 translates
+var pacs008Tags = []string{
+	TagSenderSupplied, TagTypeSubType, TagInputMessageAccountabilityData, TagAmount, TagSenderDepositoryInstitution,
+	TagReceiverDepositoryInstitution, TagBusinessFunctionCode, TagSenderReference, TagLocalInstrument,
+	TagPaymentNotification, TagCharges, TagInstructedAmount, TagExchangeRate, TagBeneficiaryIntermediaryFI,
+	TagBeneficiaryFI, TagBeneficiary, TagBeneficiaryReference, TagOriginator, TagOriginatorOptionF, TagOriginatorFI,
+	TagInstructingFI, TagOriginatorToBeneficiary, TagRelatedRemittance, TagRemittanceOriginator,
+	TagRemittanceBeneficiary, TagPrimaryRemittanceDocument, TagActualAmountPaid, TagGrossAmountRemittanceDocument,
+	TagAmountNegotiatedDiscount, TagAdjustment, TagDateRemittanceDocument, TagSecondaryRemittanceDocument,
+	TagRemittanceFreeText,
+}
+
+// Pacs008Document is a Fedwire Funds Service FIToFICustomerCreditTransfer, pacs.008.001.08
+type Pacs008Document struct {
+	XMLName                      xml.Name       `xml:"urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08 Document"`
+	FIToFICustomerCreditTransfer Pacs008Message `xml:"FIToFICstmrCdtTrf"`
+}
+
+// Pacs008Message is a FIToFICustomerCreditTransferV08 of the single transaction of a FEDWireMessage
+type Pacs008Message struct {
+	GroupHeader               ISOGroupHeader     `xml:"GrpHdr"`
+	CreditTransferTransaction Pacs008Transaction `xml:"CdtTrfTxInf"`
+}
+
+// Pacs008Transaction is a CreditTransferTransaction39
+type Pacs008Transaction struct {
+	PaymentID                 ISOPaymentIdentification   `xml:"PmtId"`
+	PaymentType               *ISOPaymentTypeInformation `xml:"PmtTpInf,omitempty"`
+	InterbankSettlementAmount ISOAmount                  `xml:"IntrBkSttlmAmt"`
+	InterbankSettlementDate   string                     `xml:"IntrBkSttlmDt,omitempty"`
+	InstructedAmount          *ISOAmount                 `xml:"InstdAmt,omitempty"`
+	ExchangeRate              string                     `xml:"XchgRate,omitempty"`
+	ChargeBearer              string                     `xml:"ChrgBr"`
+	Charges                   []ISOCharges               `xml:"ChrgsInf,omitempty"`
+	PreviousInstructingAgent  *ISOAgent                  `xml:"PrvsInstgAgt1,omitempty"`
+	InstructingAgent          *ISOAgent                  `xml:"InstgAgt,omitempty"`
+	InstructedAgent           *ISOAgent                  `xml:"InstdAgt,omitempty"`
+	IntermediaryAgent         *ISOAgent                  `xml:"IntrmyAgt1,omitempty"`
+	Debtor                    ISOParty                   `xml:"Dbtr"`
+	DebtorAccount             *ISOAccount                `xml:"DbtrAcct,omitempty"`
+	DebtorAgent               ISOAgent                   `xml:"DbtrAgt"`
+	CreditorAgent             ISOAgent                   `xml:"CdtrAgt"`
+	Creditor                  ISOParty                   `xml:"Cdtr"`
+	CreditorAccount           *ISOAccount                `xml:"CdtrAcct,omitempty"`
+	RelatedRemittance         *ISORelatedRemittance      `xml:"RltdRmtInf,omitempty"`
+	Remittance                *ISORemittanceInformation  `xml:"RmtInf,omitempty"`
+}
+
+// ISOCharges is a Charges7
+type ISOCharges struct {
+	Amount ISOAmount `xml:"Amt"`
+	Agent  ISOAgent  `xml:"Agt"`
+}
+
+// ISORelatedRemittance is a RemittanceLocation7
+type ISORelatedRemittance struct {
+	RemittanceID string                  `xml:"RmtId,omitempty"`
+	Location     []ISORemittanceLocation `xml:"RmtLctnDtls,omitempty"`
+}
+
+// ISORemittanceLocation is a RemittanceLocationData1
+type ISORemittanceLocation struct {
+	Method            string             `xml:"Mtd"`
+	ElectronicAddress string             `xml:"ElctrncAdr,omitempty"`
+	PostalAddress     *ISONameAndAddress `xml:"PstlAdr,omitempty"`
+}
+
+// ISONameAndAddress is a NameAndAddress16
+type ISONameAndAddress struct {
+	Name    string           `xml:"Nm"`
+	Address ISOPostalAddress `xml:"Adr"`
+}
+
+// ISORemittanceInformation is a RemittanceInformation16
+type ISORemittanceInformation struct {
+	Unstructured []string                  `xml:"Ustrd,omitempty"`
+	Structured   []ISOStructuredRemittance `xml:"Strd,omitempty"`
+}
+
+// ISOStructuredRemittance is a StructuredRemittanceInformation16
+type ISOStructuredRemittance struct {
+	ReferredDocument       []ISOReferredDocument `xml:"RfrdDocInf,omitempty"`
+	ReferredDocumentAmount *ISORemittanceAmount  `xml:"RfrdDocAmt,omitempty"`
+	CreditorReference      *ISOCreditorReference `xml:"CdtrRefInf,omitempty"`
+	Invoicer               *ISOParty             `xml:"Invcr,omitempty"`
+	Invoicee               *ISOParty             `xml:"Invcee,omitempty"`
+	AdditionalInfo         []string              `xml:"AddtlRmtInf,omitempty"`
+}
+
+// ISODocumentType is the type and issuer of a referred document or creditor reference
+type ISODocumentType struct {
+	CodeOrProprietary ISOCodeOrProprietary `xml:"CdOrPrtry"`
+	Issuer            string               `xml:"Issr,omitempty"`
+}
+
+// ISOReferredDocument is a ReferredDocumentInformation7
+type ISOReferredDocument struct {
+	Type        *ISODocumentType `xml:"Tp,omitempty"`
+	Number      string           `xml:"Nb,omitempty"`
+	RelatedDate string           `xml:"RltdDt,omitempty"`
+}
+
+// ISORemittanceAmount is a RemittanceAmount2
+type ISORemittanceAmount struct {
+	DuePayable []ISOAmount             `xml:"DuePyblAmt,omitempty"`
+	Discount   []ISODiscountAmount     `xml:"DscntApldAmt,omitempty"`
+	Adjustment []ISODocumentAdjustment `xml:"AdjstmntAmtAndRsn,omitempty"`
+	Remitted   []ISOAmount             `xml:"RmtdAmt,omitempty"`
+}
+
+// ISODiscountAmount is a DiscountAmountAndType1
+type ISODiscountAmount struct {
+	Amount ISOAmount `xml:"Amt"`
+}
+
+// ISODocumentAdjustment is a DocumentAdjustment1
+type ISODocumentAdjustment struct {
+	Amount               ISOAmount `xml:"Amt"`
+	CreditDebitIndicator string    `xml:"CdtDbtInd,omitempty"`
+	Reason               string    `xml:"Rsn,omitempty"`
+	AdditionalInfo       string    `xml:"AddtlInf,omitempty"`
+}
+
+// ISOCreditorReference is a CreditorReferenceInformation2
+type ISOCreditorReference struct {
+	Type      *ISODocumentType `xml:"Tp,omitempty"`
+	Reference string           `xml:"Ref,omitempty"`
+}
+
+// Bytes returns the XML of doc
+func (doc *Pacs008Document) Bytes() ([]byte, error) {
+	return marshalISODocument(doc)
+}
+
+// ToPacs008 converts fwm, a CustomerTransfer or CustomerTransferPlus with SubTypeCode BasicFundsTransfer, to a
+// pacs.008 message. The IMAD of fwm is the MessageIdentification of the message and the sender and receiver
+// depository institutions are its instructing and instructed agents. A cover payment is converted by ToPacs009.
+//
+// Tags without a corresponding element, and values truncated to fit one, are returned as warnings.
+func (fwm *FEDWireMessage) ToPacs008(opts ISOOpts) (*Pacs008Document, []ConversionWarning, error) {
+	if fwm.BusinessFunctionCode != nil {
+		switch code := fwm.BusinessFunctionCode.BusinessFunctionCode; code {
+		case CustomerTransfer, CustomerTransferPlus:
+			if fwm.isCoverPayment() {
+				return nil, nil, fieldError("LocalInstrument.LocalInstrumentCode", ErrISOMessageType,
+					SequenceBCoverPaymentStructured)
+			}
+		default:
+			return nil, nil, fieldError("BusinessFunctionCode.BusinessFunctionCode", ErrISOMessageType, code)
+		}
+	}
+	if fwm.TypeSubType != nil && fwm.TypeSubType.SubTypeCode != BasicFundsTransfer {
+		return nil, nil, fieldError("TypeSubType.SubTypeCode", ErrISOMessageType, fwm.TypeSubType.SubTypeCode)
+	}
+	if err := fwm.mandatoryFields(); err != nil {
+		return nil, nil, err
+	}
+
+	var ws conversionWarnings
+	imad := fwm.InputMessageAccountabilityData
+	header, err := newISOGroupHeader(imad.IMAD(), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	tx := Pacs008Transaction{
+		PaymentID:        fwm.isoPaymentID(opts, pacs008Format, &ws),
+		PaymentType:      fwm.isoPaymentType(),
+		ChargeBearer:     "SHAR",
+		InstructingAgent: isoABAAgent(fwm.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderShortName),
+		InstructedAgent:  isoABAAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName),
+	}
+	if fwm.TypeSubType.TypeCode != isoTypeCode(fwm.BusinessFunctionCode.BusinessFunctionCode) {
+		ws.add(TagTypeSubType, "TypeSubType.TypeCode", "%s is not translated to %s", fwm.TypeSubType.TypeCode, pacs008Format)
+	}
+	if tx.InterbankSettlementDate, err = isoDate(imad.InputCycleDate); err != nil {
+		return nil, nil, fieldError("InputMessageAccountabilityData.InputCycleDate", err, imad.InputCycleDate)
+	}
+	amt, err := fwm.Amount.Money()
+	if err != nil {
+		return nil, nil, fieldError("Amount.Amount", err, fwm.Amount.Amount)
+	}
+	if tx.InterbankSettlementAmount, err = newISOAmount(amt); err != nil {
+		return nil, nil, fieldError("Amount.Amount", err, fwm.Amount.Amount)
+	}
+	if err := fwm.isoInstructedAmount(&tx); err != nil {
+		return nil, nil, err
+	}
+	if err := fwm.isoCharges(&tx); err != nil {
+		return nil, nil, err
+	}
+	if fwm.InstructingFI != nil {
+		tx.PreviousInstructingAgent = isoFinancialInstitutionAgent(fwm.InstructingFI.FinancialInstitution)
+	}
+	if fwm.BeneficiaryIntermediaryFI != nil {
+		tx.IntermediaryAgent = isoFinancialInstitutionAgent(fwm.BeneficiaryIntermediaryFI.FinancialInstitution)
+	}
+
+	switch {
+	case fwm.Originator != nil:
+		tx.Debtor, tx.DebtorAccount = isoPersonalParty(fwm.Originator.Personal)
+	case fwm.OriginatorOptionF != nil:
+		tx.Debtor, tx.DebtorAccount = isoOptionFParty(TagOriginatorOptionF, "OriginatorOptionF", fwm.OriginatorOptionF, &ws)
+	}
+	tx.DebtorAgent = *tx.InstructingAgent
+	if fwm.OriginatorFI != nil {
+		tx.DebtorAgent = *isoFinancialInstitutionAgent(fwm.OriginatorFI.FinancialInstitution)
+	}
+	tx.CreditorAgent = *tx.InstructedAgent
+	if fwm.BeneficiaryFI != nil {
+		tx.CreditorAgent = *isoFinancialInstitutionAgent(fwm.BeneficiaryFI.FinancialInstitution)
+	}
+	if fwm.Beneficiary != nil {
+		tx.Creditor, tx.CreditorAccount = isoPersonalParty(fwm.Beneficiary.Personal)
+	}
+
+	if rr := fwm.RelatedRemittance; rr != nil {
+		tx.RelatedRemittance = isoRelatedRemittance(rr)
+	}
+	if tx.Remittance, err = fwm.isoRemittance(&ws); err != nil {
+		return nil, nil, err
+	}
+
+	ws.untranslated(fwm, pacs008Tags, pacs008Format)
+	fwm.isoSenderSuppliedWarnings(&ws)
+
+	doc := &Pacs008Document{FIToFICustomerCreditTransfer: Pacs008Message{
+		GroupHeader:               header,
+		CreditTransferTransaction: tx,
+	}}
+	return doc, ws, nil
+}
+
+// isoPaymentID returns the PaymentIdentification of fwm. The EndToEndIdentification is that of the
+// PaymentNotification, or otherwise the BeneficiaryReference. Warnings name the message format.
+func (fwm *FEDWireMessage) isoPaymentID(opts ISOOpts, format string, ws *conversionWarnings) ISOPaymentIdentification {
+	id := ISOPaymentIdentification{EndToEndID: isoNotProvided, UETR: opts.UETR}
+	if fwm.SenderReference != nil {
+		id.InstructionID = strings.TrimSpace(fwm.SenderReference.SenderReference)
+	}
+	var e2e, ref string
+	if pn := fwm.PaymentNotification; pn != nil {
+		e2e = strings.TrimSpace(pn.EndToEndIdentification)
+		contact := pn.PaymentNotificationIndicator + pn.ContactNotificationElectronicAddress + pn.ContactName +
+			pn.ContactPhoneNumber + pn.ContactMobileNumber + pn.ContactFaxNumber
+		if strings.TrimSpace(contact) != "" {
+			ws.add(TagPaymentNotification, "PaymentNotification", "notification and contact details are not translated to %s", format)
+		}
+	}
+	if fwm.BeneficiaryReference != nil {
+		ref = strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference)
+	}
+	switch {
+	case e2e != "":
+		id.EndToEndID = ws.truncate(TagPaymentNotification, "PaymentNotification.EndToEndIdentification", e2e, 35)
+		if ref != "" {
+			ws.add(TagBeneficiaryReference, "BeneficiaryReference", "is not translated to %s with an EndToEndIdentification", format)
+		}
+	case ref != "":
+		id.EndToEndID = ref
+	}
+	return id
+}
+
+// isoPaymentType returns the PaymentTypeInformation of fwm, its LocalInstrument and BusinessFunctionCode
+func (fwm *FEDWireMessage) isoPaymentType() *ISOPaymentTypeInformation {
+	pt := &ISOPaymentTypeInformation{
+		CategoryPurpose: &ISOCodeOrProprietary{Proprietary: fwm.BusinessFunctionCode.BusinessFunctionCode},
+	}
+	if li := fwm.LocalInstrument; li != nil && li.LocalInstrumentCode != "" {
+		pt.LocalInstrument = &ISOCodeOrProprietary{Proprietary: li.LocalInstrumentCode}
+		if li.LocalInstrumentCode == ProprietaryLocalInstrumentCode {
+			pt.LocalInstrument.Proprietary = strings.TrimSpace(li.ProprietaryCode)
+		}
+	}
+	return pt
+}
+
+// isoInstructedAmount sets the InstructedAmount and ExchangeRate of tx
+func (fwm *FEDWireMessage) isoInstructedAmount(tx *Pacs008Transaction) error {
+	if fwm.InstructedAmount != nil {
+		m, err := fwm.InstructedAmount.Money()
+		if err != nil {
+			return fieldError("InstructedAmount.Amount", err, fwm.InstructedAmount.Amount)
+		}
+		amt, err := newISOAmount(m)
+		if err != nil {
+			return fieldError("InstructedAmount.Amount", err, fwm.InstructedAmount.Amount)
+		}
+		tx.InstructedAmount = &amt
+	}
+	if fwm.ExchangeRate != nil && strings.TrimSpace(fwm.ExchangeRate.ExchangeRate) != "" {
+		tx.ExchangeRate = isoDecimal(fwm.ExchangeRate.ExchangeRate)
+	}
+	return nil
+}
+
+// isoCharges sets the ChargeBearer and the senders charges of tx, which are taken by the instructing agent
+func (fwm *FEDWireMessage) isoCharges(tx *Pacs008Transaction) error {
+	if fwm.Charges == nil {
+		return nil
+	}
+	if fwm.Charges.ChargeDetails == CDBeneficiary {
+		tx.ChargeBearer = "CRED"
+	}
+	charges, err := fwm.Charges.SendersCharges()
+	if err != nil {
+		return fieldError("Charges", err)
+	}
+	for _, m := range charges {
+		amt, err := newISOAmount(m)
+		if err != nil {
+			return fieldError("Charges", err, m.String())
+		}
+		tx.Charges = append(tx.Charges, ISOCharges{Amount: amt, Agent: *tx.InstructingAgent})
+	}
+	return nil
+}
+
+// isoSenderSuppliedWarnings warns for SenderSupplied values without a corresponding element
+func (fwm *FEDWireMessage) isoSenderSuppliedWarnings(ws *conversionWarnings) {
+	ss := fwm.SenderSupplied
+	if ss == nil {
+		return
+	}
+	if strings.TrimSpace(ss.UserRequestCorrelation) != "" {
+		ws.add(TagSenderSupplied, "SenderSupplied.UserRequestCorrelation", "is not translated to ISO 20022")
+	}
+	if ss.MessageDuplicationCode == MessageDuplicationResend {
+		ws.add(TagSenderSupplied, "SenderSupplied.MessageDuplicationCode", "resend is not translated to ISO 20022")
+	}
+	if ss.TestProductionCode == EnvironmentTest {
+		ws.add(TagSenderSupplied, "SenderSupplied.TestProductionCode", "test is not translated to ISO 20022")
+	}
+}
+
+// isoRelatedRemittance returns the RemittanceLocation of rr
+func isoRelatedRemittance(rr *RelatedRemittance) *ISORelatedRemittance {
+	related := &ISORelatedRemittance{RemittanceID: strings.TrimSpace(rr.RemittanceIdentification)}
+	if rr.RemittanceLocationMethod == "" {
+		return related
+	}
+	location := ISORemittanceLocation{
+		Method:            rr.RemittanceLocationMethod,
+		ElectronicAddress: strings.TrimSpace(rr.RemittanceLocationElectronicAddress),
+	}
+	if adr := isoRemittanceAddress(rr.RemittanceData); adr != nil || rr.RemittanceData.Name != "" {
+		location.PostalAddress = &ISONameAndAddress{Name: strings.TrimSpace(rr.RemittanceData.Name)}
+		if adr != nil {
+			location.PostalAddress.Address = *adr
+		}
+	}
+	related.Location = append(related.Location, location)
+	return related
+}
+
+// isoRemittance returns the RemittanceInformation of the OriginatorToBeneficiary and structured remittance tags of
+// fwm, or nil without any
+func (fwm *FEDWireMessage) isoRemittance(ws *conversionWarnings) (*ISORemittanceInformation, error) {
+	var rmt ISORemittanceInformation
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		if ustrd := isoUnstructuredRemittance(ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour); ustrd != nil {
+			rmt = *ustrd
+		}
+	}
+	strd, err := fwm.isoStructuredRemittance(ws)
+	if err != nil {
+		return nil, err
+	}
+	if strd != nil {
+		rmt.Structured = append(rmt.Structured, *strd)
+	}
+	if rmt.Unstructured == nil && rmt.Structured == nil {
+		return nil, nil
+	}
+	return &rmt, nil
+}
+
+// isoStructuredRemittance returns the StructuredRemittanceInformation of the {8300} to {8750} tags of fwm, or nil
+// without any
+func (fwm *FEDWireMessage) isoStructuredRemittance(ws *conversionWarnings) (*ISOStructuredRemittance, error) {
+	var strd ISOStructuredRemittance
+	empty := true
+	if prd := fwm.PrimaryRemittanceDocument; prd != nil {
+		doc := ISOReferredDocument{
+			Type: &ISODocumentType{
+				CodeOrProprietary: ISOCodeOrProprietary{Code: prd.DocumentTypeCode},
+				Issuer:            strings.TrimSpace(prd.Issuer),
+			},
+			Number: strings.TrimSpace(prd.DocumentIdentificationNumber),
+		}
+		if prd.DocumentTypeCode == ProprietaryDocumentType {
+			doc.Type.CodeOrProprietary = ISOCodeOrProprietary{Proprietary: strings.TrimSpace(prd.ProprietaryDocumentTypeCode)}
+		}
+		if drd := fwm.DateRemittanceDocument; drd != nil {
+			date, err := isoDate(drd.DateRemittanceDocument)
+			if err != nil {
+				return nil, fieldError("DateRemittanceDocument.DateRemittanceDocument", err, drd.DateRemittanceDocument)
+			}
+			doc.RelatedDate = date
+		}
+		strd.ReferredDocument = append(strd.ReferredDocument, doc)
+		empty = false
+	}
+
+	amounts, err := fwm.isoRemittanceAmount()
+	if err != nil {
+		return nil, err
+	}
+	if amounts != nil {
+		strd.ReferredDocumentAmount = amounts
+		empty = false
+	}
+
+	if srd := fwm.SecondaryRemittanceDocument; srd != nil {
+		code := ISOCodeOrProprietary{Proprietary: srd.DocumentTypeCode}
+		if srd.DocumentTypeCode == ProprietaryDocumentType {
+			code.Proprietary = strings.TrimSpace(srd.ProprietaryDocumentTypeCode)
+		}
+		strd.CreditorReference = &ISOCreditorReference{
+			Type:      &ISODocumentType{CodeOrProprietary: code, Issuer: strings.TrimSpace(srd.Issuer)},
+			Reference: strings.TrimSpace(srd.DocumentIdentificationNumber),
+		}
+		empty = false
+	}
+	if rb := fwm.RemittanceBeneficiary; rb != nil {
+		party := isoRemittanceParty(TagRemittanceBeneficiary, "RemittanceBeneficiary", rb.IdentificationType,
+			rb.IdentificationCode, rb.IdentificationNumber, rb.IdentificationNumberIssuer, rb.RemittanceData, ws)
+		strd.Invoicer = &party
+		empty = false
+	}
+	if ro := fwm.RemittanceOriginator; ro != nil {
+		party := isoRemittanceParty(TagRemittanceOriginator, "RemittanceOriginator", ro.IdentificationType,
+			ro.IdentificationCode, ro.IdentificationNumber, ro.IdentificationNumberIssuer, ro.RemittanceData, ws)
+		contact := ISOContact{
+			Name:         strings.TrimSpace(ro.ContactName),
+			PhoneNumber:  strings.TrimSpace(ro.ContactPhoneNumber),
+			MobileNumber: strings.TrimSpace(ro.ContactMobileNumber),
+			FaxNumber:    strings.TrimSpace(ro.ContactFaxNumber),
+			EmailAddress: strings.TrimSpace(ro.ContactElectronicAddress),
+		}
+		if other := strings.TrimSpace(ro.ContactOther); other != "" {
+			contact.Other = append(contact.Other, ISOOtherContact{ChannelType: "OTHR", ID: other})
+		}
+		if contact.Name != "" || contact.PhoneNumber != "" || contact.MobileNumber != "" || contact.FaxNumber != "" ||
+			contact.EmailAddress != "" || contact.Other != nil {
+			party.ContactDetails = &contact
+		}
+		strd.Invoicee = &party
+		empty = false
+	}
+	if rft := fwm.RemittanceFreeText; rft != nil {
+		for _, line := range []string{rft.LineOne, rft.LineTwo, rft.LineThree} {
+			if line = strings.TrimSpace(line); line != "" {
+				strd.AdditionalInfo = append(strd.AdditionalInfo, line)
+			}
+		}
+		empty = empty && strd.AdditionalInfo == nil
+	}
+	if empty {
+		return nil, nil
+	}
+	return &strd, nil
+}
+
+// isoRemittanceAmount returns the RemittanceAmount of the {8450} to {8600} tags of fwm, or nil without any
+func (fwm *FEDWireMessage) isoRemittanceAmount() (*ISORemittanceAmount, error) {
+	var amounts ISORemittanceAmount
+	empty := true
+	amount := func(field string, ra *RemittanceAmount) (ISOAmount, error) {
+		d, err := ra.DecimalAmount()
+		if err != nil {
+			return ISOAmount{}, fieldError(field+".RemittanceAmount.Amount", err, ra.Amount)
+		}
+		empty = false
+		return newISODecimalAmount(d), nil
+	}
+	if gross := fwm.GrossAmountRemittanceDocument; gross != nil {
+		amt, err := amount("GrossAmountRemittanceDocument", &gross.RemittanceAmount)
+		if err != nil {
+			return nil, err
+		}
+		amounts.DuePayable = append(amounts.DuePayable, amt)
+	}
+	if discount := fwm.AmountNegotiatedDiscount; discount != nil {
+		amt, err := amount("AmountNegotiatedDiscount", &discount.RemittanceAmount)
+		if err != nil {
+			return nil, err
+		}
+		amounts.Discount = append(amounts.Discount, ISODiscountAmount{Amount: amt})
+	}
+	if adj := fwm.Adjustment; adj != nil {
+		amt, err := amount("Adjustment", &adj.RemittanceAmount)
+		if err != nil {
+			return nil, err
+		}
+		amounts.Adjustment = append(amounts.Adjustment, ISODocumentAdjustment{
+			Amount:               amt,
+			CreditDebitIndicator: adj.CreditDebitIndicator,
+			Reason:               adj.AdjustmentReasonCode,
+			AdditionalInfo:       strings.TrimSpace(adj.AdditionalInfo),
+		})
+	}
+	if paid := fwm.ActualAmountPaid; paid != nil {
+		amt, err := amount("ActualAmountPaid", &paid.RemittanceAmount)
+		if err != nil {
+			return nil, err
+		}
+		amounts.Remitted = append(amounts.Remitted, amt)
+	}
+	if empty {
+		return nil, nil
+	}
+	return &amounts, nil
+}
+
+// isoRemittanceParty returns the ISOParty of a RemittanceOriginator or RemittanceBeneficiary
+func isoRemittanceParty(tag, field, idType, idCode, idNumber, issuer string, data RemittanceData, ws *conversionWarnings) ISOParty {
+	party := ISOParty{
+		Name:               strings.TrimSpace(data.Name),
+		PostalAddress:      isoRemittanceAddress(data),
+		CountryOfResidence: strings.TrimSpace(data.CountryOfResidence),
+	}
+	id := ISOGenericIdentification{
+		ID:         strings.TrimSpace(idNumber),
+		SchemeName: &ISOCodeOrProprietary{Code: idCode},
+		Issuer:     strings.TrimSpace(issuer),
+	}
+	if idCode == OICProprietaryIdentificationNumber {
+		id.SchemeName = &ISOCodeOrProprietary{Proprietary: idCode}
+	}
+	switch idType {
+	case OrganizationID:
+		org := &ISOOrganisationIdentification{}
+		if idCode == OICSWIFTBICORBEI {
+			org.AnyBIC = id.ID
+		} else {
+			org.Other = append(org.Other, id)
+		}
+		party.Identification = &ISOPartyIdentification{Organisation: org}
+	case PrivateID:
+		private := &ISOPersonIdentification{}
+		if idCode == PICDateBirthPlace {
+			birth, err := isoDateAndPlaceOfBirth(data)
+			if err != nil {
+				ws.add(tag, field+".RemittanceData.DateBirthPlace", "%v", err)
+				return party
+			}
+			private.DateAndPlaceOfBirth = birth
+		} else {
+			private.Other = append(private.Other, id)
+		}
+		party.Identification = &ISOPartyIdentification{Private: private}
+	}
+	return party
+}
+
+// isoDateAndPlaceOfBirth returns the DateAndPlaceOfBirth of a CCYYMMDD date followed by the place of birth, in the
+// Country of data
+func isoDateAndPlaceOfBirth(data RemittanceData) (*ISODateAndPlaceOfBirth, error) {
+	s := strings.TrimSpace(data.DateBirthPlace)
+	if len(s) < 8 {
+		return nil, ErrValidDate
+	}
+	date, err := isoDate(s[:8])
+	if err != nil {
+		return nil, err
+	}
+	birth := &ISODateAndPlaceOfBirth{
+		BirthDate:      date,
+		CityOfBirth:    strings.TrimSpace(s[8:]),
+		CountryOfBirth: strings.TrimSpace(data.Country),
+	}
+	if birth.CityOfBirth == "" || birth.CountryOfBirth == "" {
+		return nil, ErrFieldRequired
+	}
+	return birth, nil
+}
+
+// isoRemittanceAddress returns the ISOPostalAddress of data, or nil without one
+func isoRemittanceAddress(data RemittanceData) *ISOPostalAddress {
+	adr := isoAddressLines(data.AddressLineOne, data.AddressLineTwo, data.AddressLineThree, data.AddressLineFour,
+		data.AddressLineFive, data.AddressLineSix, data.AddressLineSeven)
+	if adr == nil {
+		adr = &ISOPostalAddress{}
+	}
+	adr.Department = strings.TrimSpace(data.Department)
+	adr.SubDepartment = strings.TrimSpace(data.SubDepartment)
+	adr.StreetName = strings.TrimSpace(data.StreetName)
+	adr.BuildingNumber = strings.TrimSpace(data.BuildingNumber)
+	adr.PostCode = strings.TrimSpace(data.PostCode)
+	adr.TownName = strings.TrimSpace(data.TownName)
+	adr.CountrySubDivision = strings.TrimSpace(data.CountrySubDivisionState)
+	adr.Country = strings.TrimSpace(data.Country)
+	if data.AddressType != "" {
+		adr.AddressType = &ISOCodeOrProprietary{Code: data.AddressType}
+	}
+	if adr.AddressType == nil && adr.AddressLines == nil && adr.Department == "" && adr.SubDepartment == "" &&
+		adr.StreetName == "" && adr.BuildingNumber == "" && adr.PostCode == "" && adr.TownName == "" &&
+		adr.CountrySubDivision == "" && adr.Country == "" {
+		return nil
+	}
+	return adr
+}
+
+// FEDWireMessage converts doc to a CustomerTransfer, or a CustomerTransferPlus when it has a local instrument, a
+// CategoryPurpose of CustomerTransferPlus or structured remittance information. The IMAD is that of its
+// MessageIdentification and its instructing and instructed agents are the sender and receiver depository
+// institutions, which must be identified by ABA routing numbers.
+//
+// Elements without a corresponding tag, values truncated to fit one, and tags removed as not permitted with the
+// BusinessFunctionCode of the message are returned as warnings.
+func (doc *Pacs008Document) FEDWireMessage(opts ISOOpts) (FEDWireMessage, []ConversionWarning, error) {
+	var ws conversionWarnings
+	msg := doc.FIToFICustomerCreditTransfer
+	tx := msg.CreditTransferTransaction
+	fwm, err := newISOFEDWireMessage(msg.GroupHeader, tx.PaymentID, tx.InterbankSettlementAmount, tx.InstructingAgent,
+		tx.InstructedAgent, opts, &ws)
+	if err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	if err := fwm.fedwireCharges(tx, &ws); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	if err := fwm.fedwireInstructedAmount(tx.InstructedAmount, tx.ExchangeRate, &ws); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+
+	fwm.Originator = NewOriginator()
+	fwm.Originator.Personal = fedwirePersonal(TagOriginator, "Dbtr", tx.Debtor, tx.DebtorAccount, &ws)
+	if tx.PreviousInstructingAgent != nil || !sameISOAgent(&tx.DebtorAgent, tx.InstructingAgent) {
+		fwm.OriginatorFI = NewOriginatorFI()
+		fwm.OriginatorFI.FinancialInstitution = fedwireFinancialInstitution(TagOriginatorFI, "DbtrAgt", &tx.DebtorAgent,
+			nil, true, &ws)
+	}
+	if agent := tx.PreviousInstructingAgent; agent != nil {
+		fwm.InstructingFI = NewInstructingFI()
+		fwm.InstructingFI.FinancialInstitution = fedwireFinancialInstitution(TagInstructingFI, "PrvsInstgAgt1", agent,
+			nil, true, &ws)
+	}
+	if agent := tx.IntermediaryAgent; agent != nil {
+		fwm.BeneficiaryIntermediaryFI = NewBeneficiaryIntermediaryFI()
+		fwm.BeneficiaryIntermediaryFI.FinancialInstitution = fedwireFinancialInstitution(TagBeneficiaryIntermediaryFI,
+			"IntrmyAgt1", agent, nil, true, &ws)
+	}
+	if tx.IntermediaryAgent != nil || !sameISOAgent(&tx.CreditorAgent, tx.InstructedAgent) {
+		fwm.BeneficiaryFI = NewBeneficiaryFI()
+		fwm.BeneficiaryFI.FinancialInstitution = fedwireFinancialInstitution(TagBeneficiaryFI, "CdtrAgt",
+			&tx.CreditorAgent, nil, true, &ws)
+	}
+	fwm.Beneficiary = NewBeneficiary()
+	fwm.Beneficiary.Personal = fedwirePersonal(TagBeneficiary, "Cdtr", tx.Creditor, tx.CreditorAccount, &ws)
+
+	fwm.OriginatorToBeneficiary = fedwireUnstructuredRemittance(tx.Remittance, &ws)
+	if err := fwm.fedwireStructuredRemittance(tx.Remittance, &ws); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	fwm.RelatedRemittance = fedwireRelatedRemittance(tx.RelatedRemittance, &ws)
+
+	var li, purpose *ISOCodeOrProprietary
+	if pt := tx.PaymentType; pt != nil {
+		li, purpose = pt.LocalInstrument, pt.CategoryPurpose
+	}
+	fwm.LocalInstrument = fedwireLocalInstrument(li, &ws)
+	if fwm.LocalInstrument == nil {
+		switch {
+		case fwm.hasAnyTag(structuredRemittanceTags):
+			fwm.LocalInstrument = NewLocalInstrument()
+			fwm.LocalInstrument.LocalInstrumentCode = RemittanceInformationStructured
+		case fwm.RelatedRemittance != nil:
+			fwm.LocalInstrument = NewLocalInstrument()
+			fwm.LocalInstrument.LocalInstrumentCode = RelatedRemittanceInformation
+		}
+	}
+	bfc := CustomerTransfer
+	if fwm.LocalInstrument != nil || (purpose != nil && purpose.Proprietary == CustomerTransferPlus) {
+		bfc = CustomerTransferPlus
+	}
+	fwm.setISOBusinessFunctionCode(bfc)
+	fwm.removeISOUnpermittedTags(&ws)
+	return fwm, ws, nil
+}
+
+// fedwireCharges sets the Charges of fwm to the ChargeBearer and charges of tx
+func (fwm *FEDWireMessage) fedwireCharges(tx Pacs008Transaction, ws *conversionWarnings) error {
+	var charges []Money
+	for i, c := range tx.Charges {
+		if i == len(sendersChargesFieldNames) {
+			ws.add(TagCharges, "ChrgsInf", "is truncated to %d charges", len(sendersChargesFieldNames))
+			break
+		}
+		m, err := c.Amount.Money()
+		if err != nil {
+			return fieldError("ChrgsInf.Amt", err, c.Amount.Value)
+		}
+		charges = append(charges, m)
+	}
+	switch tx.ChargeBearer {
+	case "CRED":
+		fwm.Charges = NewCharges()
+		fwm.Charges.ChargeDetails = CDBeneficiary
+	case "SHAR":
+		if charges == nil {
+			return nil
+		}
+		fwm.Charges = NewCharges()
+		fwm.Charges.ChargeDetails = CDShared
+	default:
+		ws.add(TagCharges, "ChrgBr", "%s is not translated", tx.ChargeBearer)
+		return nil
+	}
+	if err := fwm.Charges.SetSendersCharges(charges...); err != nil {
+		return fieldError("ChrgsInf", err)
+	}
+	return nil
+}
+
+// fedwireInstructedAmount sets the InstructedAmount and ExchangeRate of fwm
+func (fwm *FEDWireMessage) fedwireInstructedAmount(amount *ISOAmount, rate string, ws *conversionWarnings) error {
+	if amount != nil {
+		m, err := amount.Money()
+		if err != nil {
+			return fieldError("InstdAmt", err, amount.Value)
+		}
+		fwm.InstructedAmount = NewInstructedAmount()
+		if err := fwm.InstructedAmount.SetMoney(m); err != nil {
+			return fieldError("InstdAmt", err, amount.Value)
+		}
+	}
+	if rate = strings.TrimSpace(rate); rate != "" {
+		fwm.ExchangeRate = NewExchangeRate()
+		fwm.ExchangeRate.ExchangeRate = ws.truncate(TagExchangeRate, "XchgRate", strings.Replace(rate, ".", ",", 1), 12)
+	}
+	return nil
+}
+
+// fedwireRelatedRemittance returns the RelatedRemittance of related, or nil without one
+func fedwireRelatedRemittance(related *ISORelatedRemittance, ws *conversionWarnings) *RelatedRemittance {
+	if related == nil {
+		return nil
+	}
+	rr := NewRelatedRemittance()
+	rr.RemittanceIdentification = ws.truncate(TagRelatedRemittance, "RltdRmtInf.RmtId", related.RemittanceID, 35)
+	if len(related.Location) == 0 {
+		return rr
+	}
+	location := related.Location[0]
+	if len(related.Location) > 1 {
+		ws.add(TagRelatedRemittance, "RltdRmtInf.RmtLctnDtls", "is truncated to 1 location")
+	}
+	if (&validator{}).isRemittanceLocationMethod(location.Method) == nil {
+		rr.RemittanceLocationMethod = location.Method
+	} else {
+		ws.add(TagRelatedRemittance, "RltdRmtInf.RmtLctnDtls.Mtd", "%s is not translated", location.Method)
+	}
+	rr.RemittanceLocationElectronicAddress = ws.truncate(TagRelatedRemittance, "RltdRmtInf.RmtLctnDtls.ElctrncAdr",
+		location.ElectronicAddress, 2048)
+	if adr := location.PostalAddress; adr != nil {
+		rr.RemittanceData = fedwireRemittanceData(TagRelatedRemittance, "RltdRmtInf.RmtLctnDtls.PstlAdr", adr.Name,
+			&adr.Address, ws)
+	}
+	return rr
+}
+
+// fedwireStructuredRemittance sets the {8300} to {8750} tags of fwm to the first structured remittance of rmt
+func (fwm *FEDWireMessage) fedwireStructuredRemittance(rmt *ISORemittanceInformation, ws *conversionWarnings) error {
+	if rmt == nil || len(rmt.Structured) == 0 {
+		return nil
+	}
+	if len(rmt.Structured) > 1 {
+		ws.add("", "RmtInf.Strd", "is truncated to 1 structured remittance")
+	}
+	strd := rmt.Structured[0]
+
+	if len(strd.ReferredDocument) > 0 {
+		doc := strd.ReferredDocument[0]
+		if len(strd.ReferredDocument) > 1 {
+			ws.add(TagPrimaryRemittanceDocument, "RmtInf.Strd.RfrdDocInf", "is truncated to 1 referred document")
+		}
+		prd := NewPrimaryRemittanceDocument()
+		prd.DocumentTypeCode, prd.ProprietaryDocumentTypeCode, prd.Issuer = fedwireDocumentType(TagPrimaryRemittanceDocument,
+			"RmtInf.Strd.RfrdDocInf.Tp", doc.Type, ws)
+		prd.DocumentIdentificationNumber = ws.truncate(TagPrimaryRemittanceDocument, "RmtInf.Strd.RfrdDocInf.Nb",
+			doc.Number, 35)
+		if prd.DocumentIdentificationNumber != "" {
+			fwm.PrimaryRemittanceDocument = prd
+		} else {
+			ws.add(TagPrimaryRemittanceDocument, "RmtInf.Strd.RfrdDocInf", "is not translated without a Nb")
+		}
+		if date := strings.TrimSpace(doc.RelatedDate); date != "" {
+			if t, err := time.Parse(isoDateLayout, date); err == nil {
+				fwm.DateRemittanceDocument = NewDateRemittanceDocument()
+				fwm.DateRemittanceDocument.DateRemittanceDocument = t.Format(cycleDateLayout)
+			} else {
+				ws.add(TagDateRemittanceDocument, "RmtInf.Strd.RfrdDocInf.RltdDt", "is not an ISODate")
+			}
+		}
+	}
+
+	if amounts := strd.ReferredDocumentAmount; amounts != nil {
+		if err := fwm.fedwireRemittanceAmount(amounts, ws); err != nil {
+			return err
+		}
+	}
+
+	if ref := strd.CreditorReference; ref != nil {
+		srd := NewSecondaryRemittanceDocument()
+		srd.DocumentTypeCode, srd.ProprietaryDocumentTypeCode, srd.Issuer = fedwireDocumentType(TagSecondaryRemittanceDocument,
+			"RmtInf.Strd.CdtrRefInf.Tp", ref.Type, ws)
+		srd.DocumentIdentificationNumber = ws.truncate(TagSecondaryRemittanceDocument, "RmtInf.Strd.CdtrRefInf.Ref",
+			ref.Reference, 35)
+		if srd.DocumentIdentificationNumber != "" {
+			fwm.SecondaryRemittanceDocument = srd
+		} else {
+			ws.add(TagSecondaryRemittanceDocument, "RmtInf.Strd.CdtrRefInf", "is not translated without a Ref")
+		}
+	}
+
+	if party := strd.Invoicer; party != nil {
+		rb := NewRemittanceBeneficiary()
+		var ok bool
+		rb.IdentificationType, rb.IdentificationCode, rb.IdentificationNumber, rb.IdentificationNumberIssuer,
+			rb.RemittanceData, ok = fedwireRemittanceParty(TagRemittanceBeneficiary, "RmtInf.Strd.Invcr", party, ws)
+		if ok {
+			fwm.RemittanceBeneficiary = rb
+		}
+	}
+	if party := strd.Invoicee; party != nil {
+		ro := NewRemittanceOriginator()
+		var ok bool
+		ro.IdentificationType, ro.IdentificationCode, ro.IdentificationNumber, ro.IdentificationNumberIssuer,
+			ro.RemittanceData, ok = fedwireRemittanceParty(TagRemittanceOriginator, "RmtInf.Strd.Invcee", party, ws)
+		if contact := party.ContactDetails; contact != nil {
+			field := "RmtInf.Strd.Invcee.CtctDtls"
+			ro.ContactName = ws.truncate(TagRemittanceOriginator, field+".Nm", contact.Name, 140)
+			ro.ContactPhoneNumber = ws.truncate(TagRemittanceOriginator, field+".PhneNb", contact.PhoneNumber, 35)
+			ro.ContactMobileNumber = ws.truncate(TagRemittanceOriginator, field+".MobNb", contact.MobileNumber, 35)
+			ro.ContactFaxNumber = ws.truncate(TagRemittanceOriginator, field+".FaxNb", contact.FaxNumber, 35)
+			ro.ContactElectronicAddress = ws.truncate(TagRemittanceOriginator, field+".EmailAdr", contact.EmailAddress, 2048)
+			for i, other := range contact.Other {
+				if i > 0 {
+					ws.add(TagRemittanceOriginator, field+".Othr", "is truncated to 1 other contact")
+					break
+				}
+				ro.ContactOther = ws.truncate(TagRemittanceOriginator, field+".Othr.Id", other.ID, 35)
+			}
+		}
+		if ok {
+			fwm.RemittanceOriginator = ro
+		}
+	}
+
+	if strd.AdditionalInfo != nil {
+		lines := fedwireTextLines(TagRemittanceFreeText, "RmtInf.Strd.AddtlRmtInf", strd.AdditionalInfo,
+			[]int{140, 140, 140}, ws)
+		if lines != nil {
+			fwm.RemittanceFreeText = NewRemittanceFreeText()
+			setLines(lines, &fwm.RemittanceFreeText.LineOne, &fwm.RemittanceFreeText.LineTwo,
+				&fwm.RemittanceFreeText.LineThree)
+		}
+	}
+	return nil
+}
+
+// fedwireRemittanceAmount sets the {8450} to {8600} tags of fwm to the first of each of amounts
+func (fwm *FEDWireMessage) fedwireRemittanceAmount(amounts *ISORemittanceAmount, ws *conversionWarnings) error {
+	field := "RmtInf.Strd.RfrdDocAmt"
+	set := func(name string, ra *RemittanceAmount, amount ISOAmount) error {
+		d, err := amount.DecimalAmount()
+		if err != nil {
+			return fieldError(field+"."+name, err, amount.Value)
+		}
+		if err := ra.SetDecimalAmount(d); err != nil {
+			return fieldError(field+"."+name, err, amount.Value)
+		}
+		return nil
+	}
+	truncated := func(tag, name string, n int) {
+		if n > 1 {
+			ws.add(tag, field+"."+name, "is truncated to 1 amount")
+		}
+	}
+	if len(amounts.DuePayable) > 0 {
+		truncated(TagGrossAmountRemittanceDocument, "DuePyblAmt", len(amounts.DuePayable))
+		gross := NewGrossAmountRemittanceDocument()
+		if err := set("DuePyblAmt", &gross.RemittanceAmount, amounts.DuePayable[0]); err != nil {
+			return err
+		}
+		fwm.GrossAmountRemittanceDocument = gross
+	}
+	if len(amounts.Discount) > 0 {
+		truncated(TagAmountNegotiatedDiscount, "DscntApldAmt", len(amounts.Discount))
+		discount := NewAmountNegotiatedDiscount()
+		if err := set("DscntApldAmt.Amt", &discount.RemittanceAmount, amounts.Discount[0].Amount); err != nil {
+			return err
+		}
+		fwm.AmountNegotiatedDiscount = discount
+	}
+	if len(amounts.Adjustment) > 0 {
+		truncated(TagAdjustment, "AdjstmntAmtAndRsn", len(amounts.Adjustment))
+		adjustment := amounts.Adjustment[0]
+		adj := NewAdjustment()
+		adj.AdjustmentReasonCode = adjustment.Reason
+		adj.CreditDebitIndicator = adjustment.CreditDebitIndicator
+		adj.AdditionalInfo = ws.truncate(TagAdjustment, field+".AdjstmntAmtAndRsn.AddtlInf", adjustment.AdditionalInfo, 140)
+		if err := set("AdjstmntAmtAndRsn.Amt", &adj.RemittanceAmount, adjustment.Amount); err != nil {
+			return err
+		}
+		if (&validator{}).isAdjustmentReasonCode(adj.AdjustmentReasonCode) != nil ||
+			(&validator{}).isCreditDebitIndicator(adj.CreditDebitIndicator) != nil {
+			ws.add(TagAdjustment, field+".AdjstmntAmtAndRsn", "is not translated without a valid Rsn and CdtDbtInd")
+		} else {
+			fwm.Adjustment = adj
+		}
+	}
+	if len(amounts.Remitted) > 0 {
+		truncated(TagActualAmountPaid, "RmtdAmt", len(amounts.Remitted))
+		paid := NewActualAmountPaid()
+		if err := set("RmtdAmt", &paid.RemittanceAmount, amounts.Remitted[0]); err != nil {
+			return err
+		}
+		fwm.ActualAmountPaid = paid
+	}
+	return nil
+}
+
+// fedwireDocumentType returns the DocumentTypeCode, ProprietaryDocumentTypeCode and Issuer of a referred document
+// type or creditor reference type. A type without a DocumentTypeCode is proprietary.
+func fedwireDocumentType(tag, field string, docType *ISODocumentType, ws *conversionWarnings) (string, string, string) {
+	if docType == nil {
+		ws.add(tag, field, "is not provided")
+		return ProprietaryDocumentType, isoNotProvided, ""
+	}
+	issuer := ws.truncate(tag, field+".Issr", docType.Issuer, 35)
+	for _, code := range []string{docType.CodeOrProprietary.Code, docType.CodeOrProprietary.Proprietary} {
+		if code != "" && code != ProprietaryDocumentType && (&validator{}).isDocumentTypeCode(code) == nil {
+			return code, "", issuer
+		}
+	}
+	code := strings.TrimSpace(docType.CodeOrProprietary.Proprietary)
+	if code == "" {
+		code = strings.TrimSpace(docType.CodeOrProprietary.Code)
+	}
+	if code == "" {
+		ws.add(tag, field, "is not provided")
+		code = isoNotProvided
+	}
+	return ProprietaryDocumentType, ws.truncate(tag, field+".CdOrPrtry", code, 35), issuer
+}
+
+// fedwireRemittanceParty returns the IdentificationType, IdentificationCode, IdentificationNumber,
+// IdentificationNumberIssuer and RemittanceData of an invoicer or invoicee, and false when it cannot be a
+// RemittanceBeneficiary or RemittanceOriginator, which require a name and identification
+func fedwireRemittanceParty(tag, field string, party *ISOParty, ws *conversionWarnings) (string, string, string, string, RemittanceData, bool) {
+	data := fedwireRemittanceData(tag, field+".PstlAdr", party.Name, party.PostalAddress, ws)
+	data.CountryOfResidence = ws.truncate(tag, field+".CtryOfRes", party.CountryOfResidence, 2)
+	var idType, code, number, issuer string
+	if id := party.Identification; id != nil {
+		switch {
+		case id.Organisation != nil && id.Organisation.AnyBIC != "":
+			idType, code, number = OrganizationID, OICSWIFTBICORBEI, id.Organisation.AnyBIC
+		case id.Organisation != nil && len(id.Organisation.Other) > 0:
+			other := id.Organisation.Other[0]
+			idType, code, number, issuer = OrganizationID, OICProprietaryIdentificationNumber, other.ID, other.Issuer
+			if s := other.SchemeName; s != nil && (&validator{}).isOrganizationIdentificationCode(s.Code) == nil {
+				code = s.Code
+			}
+		case id.Private != nil && id.Private.DateAndPlaceOfBirth != nil:
+			birth := id.Private.DateAndPlaceOfBirth
+			t, err := time.Parse(isoDateLayout, birth.BirthDate)
+			if err != nil {
+				ws.add(tag, field+".Id.PrvtId.DtAndPlcOfBirth.BirthDt", "is not an ISODate")
+				break
+			}
+			idType, code = PrivateID, PICDateBirthPlace
+			data.DateBirthPlace = ws.truncate(tag, field+".Id.PrvtId.DtAndPlcOfBirth",
+				t.Format(cycleDateLayout)+strings.TrimSpace(birth.CityOfBirth), 82)
+			if data.Country == "" {
+				data.Country = ws.truncate(tag, field+".Id.PrvtId.DtAndPlcOfBirth.CtryOfBirth", birth.CountryOfBirth, 2)
+			}
+		case id.Private != nil && len(id.Private.Other) > 0:
+			other := id.Private.Other[0]
+			idType, code, number, issuer = PrivateID, PICProprietaryIdentificationNumber, other.ID, other.Issuer
+			if s := other.SchemeName; s != nil && s.Code != PICDateBirthPlace &&
+				(&validator{}).isPrivateIdentificationCode(s.Code) == nil {
+				code = s.Code
+			}
+		}
+	}
+	number = ws.truncate(tag, field+".Id", number, 35)
+	if number == "" || code == OICSWIFTBICORBEI {
+		issuer = ""
+	}
+	issuer = ws.truncate(tag, field+".Id.Issr", issuer, 35)
+	if party.ContactDetails != nil && tag != TagRemittanceOriginator {
+		ws.add(tag, field+".CtctDtls", "is not translated")
+	}
+	if data.Name == "" || idType == "" || (number == "" && code != PICDateBirthPlace) {
+		ws.add(tag, field, "is not translated without a name and identification")
+		return "", "", "", "", RemittanceData{}, false
+	}
+	return idType, code, number, issuer, data, true
+}
+
+// fedwireRemittanceData returns the RemittanceData of a name and structured postal address
+func fedwireRemittanceData(tag, field, name string, adr *ISOPostalAddress, ws *conversionWarnings) RemittanceData {
+	data := RemittanceData{Name: ws.truncate(tag, field+".Nm", name, 140)}
+	if adr == nil {
+		return data
+	}
+	if adr.AddressType != nil {
+		if (&validator{}).isAddressType(adr.AddressType.Code) == nil {
+			data.AddressType = adr.AddressType.Code
+		} else {
+			ws.add(tag, field+".AdrTp", "is not translated")
+		}
+	}
+	data.Department = ws.truncate(tag, field+".Dept", adr.Department, 70)
+	data.SubDepartment = ws.truncate(tag, field+".SubDept", adr.SubDepartment, 70)
+	data.StreetName = ws.truncate(tag, field+".StrtNm", adr.StreetName, 70)
+	data.BuildingNumber = ws.truncate(tag, field+".BldgNb", adr.BuildingNumber, 16)
+	data.PostCode = ws.truncate(tag, field+".PstCd", adr.PostCode, 16)
+	data.TownName = ws.truncate(tag, field+".TwnNm", adr.TownName, 35)
+	data.CountrySubDivisionState = ws.truncate(tag, field+".CtrySubDvsn", adr.CountrySubDivision, 35)
+	data.Country = ws.truncate(tag, field+".Ctry", adr.Country, 2)
+	var lines [7]string
+	for i, line := range adr.AddressLines {
+		if i == len(lines) {
+			ws.add(tag, field+".AdrLine", "is truncated to %d lines", len(lines))
+			break
+		}
+		lines[i] = ws.truncate(tag, field+".AdrLine", line, 70)
+	}
+	data.AddressLineOne, data.AddressLineTwo, data.AddressLineThree, data.AddressLineFour = lines[0], lines[1], lines[2], lines[3]
+	data.AddressLineFive, data.AddressLineSix, data.AddressLineSeven = lines[4], lines[5], lines[6]
+	return data
+}
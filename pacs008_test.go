@@ -0,0 +1,428 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockPacs008CustomerTransfer creates a CustomerTransfer with a complete FI chain
+func mockPacs008CustomerTransfer() FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20201005"
+	fwm.SenderReference = mockSenderReference()
+	fwm.Charges = mockCharges()
+	fwm.InstructedAmount = mockInstructedAmount()
+	fwm.ExchangeRate = mockExchangeRate()
+	fwm.Originator = mockOriginator()
+	fwm.OriginatorFI = mockOriginatorFI()
+	fwm.OriginatorFI.FinancialInstitution.IdentificationCode = SWIFTBankIdentifierCode
+	fwm.OriginatorFI.FinancialInstitution.Identifier = "CITIUS33"
+	fwm.InstructingFI = mockInstructingFI()
+	fwm.InstructingFI.FinancialInstitution.IdentificationCode = FEDRoutingNumber
+	fwm.InstructingFI.FinancialInstitution.Identifier = "021000021"
+	fwm.BeneficiaryIntermediaryFI = mockBeneficiaryIntermediaryFI()
+	fwm.BeneficiaryIntermediaryFI.FinancialInstitution.IdentificationCode = CHIPSParticipant
+	fwm.BeneficiaryIntermediaryFI.FinancialInstitution.Identifier = "0123"
+	fwm.BeneficiaryFI = mockBeneficiaryFI()
+	fwm.BeneficiaryFI.FinancialInstitution.IdentificationCode = FEDRoutingNumber
+	fwm.BeneficiaryFI.FinancialInstitution.Identifier = "231380104"
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.IdentificationCode = DemandDepositAccountNumber
+	fwm.Beneficiary.Personal.Identifier = "987654321"
+	fwm.BeneficiaryReference = mockBeneficiaryReference()
+	fwm.OriginatorToBeneficiary = mockOriginatorToBeneficiary()
+	return fwm
+}
+
+func TestToPacs008(t *testing.T) {
+	fwm := mockPacs008CustomerTransfer()
+	created := time.Date(2020, time.October, 5, 14, 30, 0, 0, time.UTC)
+	doc, warnings, err := fwm.ToPacs008(ISOOpts{CreationTime: created, UETR: "8a562c67-ca16-48ba-b074-65581be6f011"})
+	require.NoError(t, err)
+
+	hdr := doc.FIToFICustomerCreditTransfer.GroupHeader
+	require.Equal(t, fwm.InputMessageAccountabilityData.IMAD(), hdr.MessageID)
+	require.Equal(t, "2020-10-05T10:30:00-04:00", hdr.CreationDateTime)
+	require.Equal(t, isoClearingSystemFedwire, hdr.SettlementInfo.ClearingSystem.Code)
+
+	tx := doc.FIToFICustomerCreditTransfer.CreditTransferTransaction
+	require.Equal(t, fwm.SenderReference.SenderReference, tx.PaymentID.InstructionID)
+	require.Equal(t, fwm.BeneficiaryReference.BeneficiaryReference, tx.PaymentID.EndToEndID)
+	require.Equal(t, "8a562c67-ca16-48ba-b074-65581be6f011", tx.PaymentID.UETR)
+	require.Equal(t, CustomerTransfer, tx.PaymentType.CategoryPurpose.Proprietary)
+	require.Equal(t, ISOAmount{Currency: "USD", Value: "12345.67"}, tx.InterbankSettlementAmount)
+	require.Equal(t, "2020-10-05", tx.InterbankSettlementDate)
+	require.Equal(t, &ISOAmount{Currency: "USD", Value: "4567.89"}, tx.InstructedAmount)
+	require.Equal(t, "1.2345", tx.ExchangeRate)
+	require.Equal(t, "CRED", tx.ChargeBearer)
+	require.Len(t, tx.Charges, 4)
+	require.Equal(t, ISOAmount{Currency: "USD", Value: "0.99"}, tx.Charges[0].Amount)
+	require.Equal(t, "121042882", tx.Charges[0].Agent.ABA())
+
+	require.Equal(t, "021000021", tx.PreviousInstructingAgent.ABA())
+	require.Equal(t, "121042882", tx.InstructingAgent.ABA())
+	require.Equal(t, "231380104", tx.InstructedAgent.ABA())
+	require.Equal(t, isoMemberIDCHIPS, tx.IntermediaryAgent.FinancialInstitution.ClearingSystemMember.ClearingSystemID.Code)
+	require.Equal(t, "CITIUS33", tx.DebtorAgent.FinancialInstitution.BICFI)
+	require.Equal(t, "231380104", tx.CreditorAgent.ABA())
+
+	require.Equal(t, "Name", tx.Debtor.Name)
+	require.Equal(t, []string{"Address One", "Address Two", "Address Three"}, tx.Debtor.PostalAddress.AddressLines)
+	require.Equal(t, PICPassportNumber, tx.Debtor.Identification.Private.Other[0].SchemeName.Code)
+	require.Nil(t, tx.DebtorAccount)
+	require.Equal(t, "987654321", tx.CreditorAccount.Number())
+	require.Equal(t, []string{"LineOne", "LineTwo", "LineThree", "LineFour"}, tx.Remittance.Unstructured)
+
+	require.Contains(t, warnings, ConversionWarning{
+		Tag:     TagSenderSupplied,
+		Field:   "SenderSupplied.UserRequestCorrelation",
+		Message: "is not translated to ISO 20022",
+	})
+
+	bs, err := doc.Bytes()
+	require.NoError(t, err)
+	require.Contains(t, string(bs), `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08">`)
+	require.Contains(t, string(bs), `<IntrBkSttlmAmt Ccy="USD">12345.67</IntrBkSttlmAmt>`)
+
+	var read Pacs008Document
+	require.NoError(t, xml.Unmarshal(bs, &read))
+	require.Equal(t, tx, read.FIToFICustomerCreditTransfer.CreditTransferTransaction)
+}
+
+func TestToPacs008_optionFAndRemittance(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	fwm.LocalInstrument = mockLocalInstrument()
+	fwm.LocalInstrument.LocalInstrumentCode = RemittanceInformationStructured
+	fwm.PaymentNotification = mockPaymentNotification()
+	fwm.OriginatorOptionF = mockOriginatorOptionF()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.RemittanceOriginator = mockRemittanceOriginator()
+	fwm.RemittanceBeneficiary = mockRemittanceBeneficiary()
+	fwm.PrimaryRemittanceDocument = mockPrimaryRemittanceDocument()
+	fwm.ActualAmountPaid = mockActualAmountPaid()
+	fwm.GrossAmountRemittanceDocument = mockGrossAmountRemittanceDocument()
+	fwm.AmountNegotiatedDiscount = mockAmountNegotiatedDiscount()
+	fwm.Adjustment = mockAdjustment()
+	fwm.DateRemittanceDocument = mockDateRemittanceDocument()
+	fwm.SecondaryRemittanceDocument = mockSecondaryRemittanceDocument()
+	fwm.RemittanceFreeText = mockRemittanceFreeText()
+	fwm.FIReceiverFI = mockFIReceiverFI()
+
+	doc, warnings, err := fwm.ToPacs008(ISOOpts{})
+	require.NoError(t, err)
+	tx := doc.FIToFICustomerCreditTransfer.CreditTransferTransaction
+
+	require.Equal(t, RemittanceInformationStructured, tx.PaymentType.LocalInstrument.Proprietary)
+	require.Equal(t, "End To End Identification", tx.PaymentID.EndToEndID)
+	require.Equal(t, "SHAR", tx.ChargeBearer)
+
+	// OriginatorOptionF
+	require.Equal(t, "Name 1234", tx.Debtor.Name)
+	require.Equal(t, []string{"1000 Colonial Farm Rd"}, tx.Debtor.PostalAddress.AddressLines)
+	require.Equal(t, "TXID", tx.Debtor.Identification.Private.Other[0].SchemeName.Code)
+	require.Equal(t, "123-45-6789", tx.Debtor.Identification.Private.Other[0].ID)
+	require.Equal(t, tx.InstructingAgent, &tx.DebtorAgent)
+
+	strd := tx.Remittance.Structured[0]
+	require.Equal(t, AccountsReceivableOpenItem, strd.ReferredDocument[0].Type.CodeOrProprietary.Code)
+	require.Equal(t, "111111", strd.ReferredDocument[0].Number)
+	require.NotEmpty(t, strd.ReferredDocument[0].RelatedDate)
+	require.Len(t, strd.ReferredDocumentAmount.DuePayable, 1)
+	require.Len(t, strd.ReferredDocumentAmount.Discount, 1)
+	require.Equal(t, CreditIndicator, strd.ReferredDocumentAmount.Adjustment[0].CreditDebitIndicator)
+	require.Equal(t, ISOAmount{Currency: "USD", Value: "1234.56"}, strd.ReferredDocumentAmount.Adjustment[0].Amount)
+	require.Len(t, strd.ReferredDocumentAmount.Remitted, 1)
+	require.NotNil(t, strd.CreditorReference)
+	require.Equal(t, "Name", strd.Invoicer.Name)
+	require.Equal(t, OICCustomerNumber, strd.Invoicee.Identification.Organisation.Other[0].SchemeName.Code)
+	require.Equal(t, "Bank", strd.Invoicee.Identification.Organisation.Other[0].Issuer)
+	require.Equal(t, CompletePostalAddress, strd.Invoicee.PostalAddress.AddressType.Code)
+	require.Len(t, strd.Invoicee.PostalAddress.AddressLines, 7)
+	require.Equal(t, "Contact Name", strd.Invoicee.ContactDetails.Name)
+	require.Equal(t, "US", strd.Invoicee.CountryOfResidence)
+	require.NotEmpty(t, strd.AdditionalInfo)
+
+	require.Contains(t, warnings, ConversionWarning{
+		Tag:     TagFIReceiverFI,
+		Field:   "FIReceiverFI",
+		Message: "is not translated to pacs.008",
+	})
+	require.Contains(t, warnings, ConversionWarning{
+		Tag:     TagPaymentNotification,
+		Field:   "PaymentNotification",
+		Message: "notification and contact details are not translated to pacs.008",
+	})
+
+	_, err = doc.Bytes()
+	require.NoError(t, err)
+}
+
+func TestToPacs008_invalid(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BankTransfer
+	_, _, err := fwm.ToPacs008(ISOOpts{})
+	require.EqualError(t, err, fieldError("BusinessFunctionCode.BusinessFunctionCode", ErrISOMessageType, BankTransfer).Error())
+
+	fwm = mockCustomerTransferData()
+	fwm.TypeSubType.SubTypeCode = ReversalTransfer
+	_, _, err = fwm.ToPacs008(ISOOpts{})
+	require.EqualError(t, err, fieldError("TypeSubType.SubTypeCode", ErrISOMessageType, ReversalTransfer).Error())
+
+	fwm = mockCustomerTransferData()
+	fwm.Amount = nil
+	_, _, err = fwm.ToPacs008(ISOOpts{})
+	require.Error(t, err)
+}
+
+func TestConversionWarnings_truncate(t *testing.T) {
+	var ws conversionWarnings
+	require.Equal(t, "abc", ws.truncate(TagSenderReference, "SenderReference", " abc ", 3))
+	require.Empty(t, ws)
+	require.Equal(t, "ab", ws.truncate(TagSenderReference, "SenderReference", "abc", 2))
+	require.Equal(t, "{3320} SenderReference is truncated to 2 characters", ws[0].String())
+
+	ws = nil
+	require.Equal(t, "ab", ws.truncate(TagSenderReference, "SenderReference", "ab cd", 3))
+	require.Equal(t, "X.4200.D666.EVIL.", ws.truncate(TagOriginator, "Dbtr.Nm", "X{4200}D666*EVIL*", 35))
+	require.Equal(t, "Zo. M.ller", ws.truncate(TagOriginator, "Dbtr.Nm", "Zoë Müller", 35))
+	require.Equal(t, conversionWarnings{
+		{Tag: TagSenderReference, Field: "SenderReference", Message: "is truncated to 3 characters"},
+		{Tag: TagOriginator, Field: "Dbtr.Nm", Message: `character "{" is replaced by a full stop`},
+		{Tag: TagOriginator, Field: "Dbtr.Nm", Message: `character "}" is replaced by a full stop`},
+		{Tag: TagOriginator, Field: "Dbtr.Nm", Message: `character "*" is replaced by a full stop`},
+		{Tag: TagOriginator, Field: "Dbtr.Nm", Message: `character "ë" is replaced by a full stop`},
+		{Tag: TagOriginator, Field: "Dbtr.Nm", Message: `character "ü" is replaced by a full stop`},
+	}, ws)
+}
+
+// readISO20022 converts doc to XML and reads it back as a FEDWireMessage
+func readISO20022(t *testing.T, doc interface{ Bytes() ([]byte, error) }) (FEDWireMessage, []ConversionWarning) {
+	t.Helper()
+	bs, err := doc.Bytes()
+	require.NoError(t, err)
+	fwm, warnings, err := ReadISO20022(bytes.NewReader(bs), ISOOpts{})
+	require.NoError(t, err)
+	require.Equal(t, fwm, writeReadFEDWireMessage(t, fwm))
+	return fwm, warnings
+}
+
+// writeReadFEDWireMessage writes fwm, which must validate, as a file and reads it back
+func writeReadFEDWireMessage(t *testing.T, fwm FEDWireMessage) FEDWireMessage {
+	t.Helper()
+	file := NewFile()
+	file.AddFEDWireMessage(fwm)
+	require.NoError(t, file.Validate())
+	require.Empty(t, fwm.validateAll())
+
+	var buf bytes.Buffer
+	require.NoError(t, NewWriter(&buf).Write(file))
+	read, err := NewReader(&buf).Read()
+	require.NoError(t, err)
+	require.Len(t, read.FEDWireMessages, 1)
+
+	// the {7033} Amount is read back with the leading zeros it is written with
+	if cia := read.FEDWireMessages[0].CurrencyInstructedAmount; cia != nil && fwm.CurrencyInstructedAmount != nil {
+		want, err := fwm.CurrencyInstructedAmount.Money()
+		require.NoError(t, err)
+		got, err := cia.Money()
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+		cia.Amount = fwm.CurrencyInstructedAmount.Amount
+	}
+	return read.FEDWireMessages[0]
+}
+
+func TestPacs008Document_FEDWireMessage(t *testing.T) {
+	fwm := mockPacs008CustomerTransfer()
+	doc, _, err := fwm.ToPacs008(ISOOpts{UETR: "8a562c67-ca16-48ba-b074-65581be6f011"})
+	require.NoError(t, err)
+	read, warnings := readISO20022(t, doc)
+
+	require.Equal(t, fwm.InputMessageAccountabilityData, read.InputMessageAccountabilityData)
+	require.Equal(t, fwm.InputMessageAccountabilityData.InputSequenceNumber, read.SenderSupplied.UserRequestCorrelation)
+	require.Equal(t, fwm.TypeSubType, read.TypeSubType)
+	require.Equal(t, fwm.Amount, read.Amount)
+	require.Equal(t, fwm.SenderDepositoryInstitution, read.SenderDepositoryInstitution)
+	require.Equal(t, fwm.ReceiverDepositoryInstitution, read.ReceiverDepositoryInstitution)
+	require.Equal(t, CustomerTransfer, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Nil(t, read.LocalInstrument)
+	require.Equal(t, fwm.SenderReference, read.SenderReference)
+	require.Equal(t, fwm.BeneficiaryReference, read.BeneficiaryReference)
+	require.Equal(t, fwm.Charges, read.Charges)
+	require.Equal(t, fwm.InstructedAmount, read.InstructedAmount)
+	require.Equal(t, fwm.ExchangeRate, read.ExchangeRate)
+
+	require.Equal(t, fwm.Originator, read.Originator)
+	require.Equal(t, fwm.OriginatorFI, read.OriginatorFI)
+	require.Equal(t, fwm.InstructingFI, read.InstructingFI)
+	require.Equal(t, fwm.BeneficiaryIntermediaryFI, read.BeneficiaryIntermediaryFI)
+	require.Equal(t, fwm.BeneficiaryFI, read.BeneficiaryFI)
+	require.Equal(t, fwm.Beneficiary, read.Beneficiary)
+	require.Equal(t, fwm.OriginatorToBeneficiary, read.OriginatorToBeneficiary)
+	require.Equal(t, []ConversionWarning{{Field: "PmtId.UETR", Message: "has no corresponding tag"}}, warnings)
+}
+
+// TestPacs008Document_TestProductionCode ensures the environment of a test message is warned of on export and
+// set from the ISOOpts on import
+func TestPacs008Document_TestProductionCode(t *testing.T) {
+	fwm := mockPacs008CustomerTransfer()
+	fwm.SenderSupplied.TestProductionCode = EnvironmentTest
+	doc, warnings, err := fwm.ToPacs008(ISOOpts{})
+	require.NoError(t, err)
+	require.Contains(t, warnings, ConversionWarning{
+		Tag:     TagSenderSupplied,
+		Field:   "SenderSupplied.TestProductionCode",
+		Message: "test is not translated to ISO 20022",
+	})
+
+	read, _, err := doc.FEDWireMessage(ISOOpts{})
+	require.NoError(t, err)
+	require.Equal(t, EnvironmentProduction, read.SenderSupplied.TestProductionCode)
+
+	bs, err := doc.Bytes()
+	require.NoError(t, err)
+	read, _, err = ReadISO20022(bytes.NewReader(bs), ISOOpts{TestProductionCode: EnvironmentTest})
+	require.NoError(t, err)
+	require.Equal(t, EnvironmentTest, read.SenderSupplied.TestProductionCode)
+}
+
+func TestPacs008Document_FEDWireMessageRemittance(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20201005"
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	fwm.LocalInstrument = mockLocalInstrument()
+	fwm.LocalInstrument.LocalInstrumentCode = RemittanceInformationStructured
+	fwm.Originator = mockOriginator()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.RemittanceOriginator = mockRemittanceOriginator()
+	fwm.RemittanceBeneficiary = mockRemittanceBeneficiary()
+	fwm.PrimaryRemittanceDocument = mockPrimaryRemittanceDocument()
+	fwm.ActualAmountPaid = mockActualAmountPaid()
+	fwm.GrossAmountRemittanceDocument = mockGrossAmountRemittanceDocument()
+	fwm.AmountNegotiatedDiscount = mockAmountNegotiatedDiscount()
+	fwm.Adjustment = mockAdjustment()
+	fwm.DateRemittanceDocument = mockDateRemittanceDocument()
+	fwm.SecondaryRemittanceDocument = mockSecondaryRemittanceDocument()
+	fwm.RemittanceFreeText = mockRemittanceFreeText()
+	fwm.Adjustment.AdditionalInfo = "Adjustment Additional Information"
+	// remittance amounts may have more decimal places than the currency
+	fwm.ActualAmountPaid.RemittanceAmount.Amount = "1234.56789"
+	require.NoError(t, fwm.verify())
+
+	doc, _, err := fwm.ToPacs008(ISOOpts{})
+	require.NoError(t, err)
+	read, warnings := readISO20022(t, doc)
+
+	require.Equal(t, CustomerTransferPlus, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, fwm.LocalInstrument, read.LocalInstrument)
+	require.Equal(t, fwm.RemittanceOriginator, read.RemittanceOriginator)
+	require.Equal(t, fwm.RemittanceBeneficiary, read.RemittanceBeneficiary)
+	require.Equal(t, fwm.PrimaryRemittanceDocument, read.PrimaryRemittanceDocument)
+	require.Equal(t, fwm.ActualAmountPaid, read.ActualAmountPaid)
+	require.Equal(t, fwm.GrossAmountRemittanceDocument, read.GrossAmountRemittanceDocument)
+	require.Equal(t, fwm.AmountNegotiatedDiscount, read.AmountNegotiatedDiscount)
+	require.Equal(t, fwm.Adjustment, read.Adjustment)
+	require.Equal(t, fwm.DateRemittanceDocument, read.DateRemittanceDocument)
+	require.Equal(t, fwm.SecondaryRemittanceDocument, read.SecondaryRemittanceDocument)
+	require.Equal(t, fwm.RemittanceFreeText, read.RemittanceFreeText)
+	require.Empty(t, warnings)
+}
+
+func TestPacs008Document_FEDWireMessageStructuredAddress(t *testing.T) {
+	fwm := mockPacs008CustomerTransfer()
+	doc, _, err := fwm.ToPacs008(ISOOpts{})
+	require.NoError(t, err)
+	tx := &doc.FIToFICustomerCreditTransfer.CreditTransferTransaction
+	tx.PaymentID.EndToEndID = isoNotProvided
+	tx.PaymentType.LocalInstrument = &ISOCodeOrProprietary{Proprietary: "ACME PAYROLL"}
+	tx.Debtor.Name = "A Name Which Is Longer Than Thirty Five Characters"
+	tx.Debtor.PostalAddress = &ISOPostalAddress{
+		AddressType:        &ISOCodeOrProprietary{Code: BusinessAddress},
+		Department:         "Treasury",
+		StreetName:         "Colonial Farm Rd",
+		BuildingNumber:     "1000",
+		PostCode:           "23112",
+		TownName:           "Midlothian",
+		CountrySubDivision: "VA",
+		Country:            "US",
+	}
+	read, warnings := readISO20022(t, doc)
+
+	require.Nil(t, read.BeneficiaryReference)
+	require.Equal(t, CustomerTransferPlus, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, ProprietaryLocalInstrumentCode, read.LocalInstrument.LocalInstrumentCode)
+	require.Equal(t, "ACME PAYROLL", read.LocalInstrument.ProprietaryCode)
+	require.Equal(t, strings.TrimSpace(tx.Debtor.Name[:35]), read.Originator.Personal.Name)
+	require.Equal(t, Address{
+		AddressLineOne:   "Treasury, 1000 Colonial Farm Rd",
+		AddressLineTwo:   "Midlothian, VA 23112, US",
+		AddressLineThree: "",
+	}, read.Originator.Personal.Address)
+	require.Contains(t, warnings, ConversionWarning{Tag: TagOriginator, Field: "Dbtr.Nm", Message: "is truncated to 35 characters"})
+	require.Contains(t, warnings, ConversionWarning{Tag: TagOriginator, Field: "Dbtr.PstlAdr.AdrTp", Message: "is not translated"})
+}
+
+// TestPacs008Document_FEDWireMessageCharacters ensures text a tag cannot hold is replaced, so it can neither forge a
+// tag nor make the written file unreadable
+func TestPacs008Document_FEDWireMessageCharacters(t *testing.T) {
+	fwm := mockPacs008CustomerTransfer()
+	doc, _, err := fwm.ToPacs008(ISOOpts{})
+	require.NoError(t, err)
+	tx := &doc.FIToFICustomerCreditTransfer.CreditTransferTransaction
+	tx.Debtor.Name = "X{4200}D666*EVIL*"
+	tx.Creditor.Name = "Zoë Müller"
+	read, warnings := readISO20022(t, doc)
+
+	require.Equal(t, "X.4200.D666.EVIL.", read.Originator.Personal.Name)
+	require.Equal(t, fwm.Beneficiary.Personal.IdentificationCode, read.Beneficiary.Personal.IdentificationCode)
+	require.Equal(t, fwm.Beneficiary.Personal.Identifier, read.Beneficiary.Personal.Identifier)
+	require.Equal(t, "Zo. M.ller", read.Beneficiary.Personal.Name)
+	require.Contains(t, warnings, ConversionWarning{Tag: TagOriginator, Field: "Dbtr.Nm", Message: `character "*" is replaced by a full stop`})
+	require.Contains(t, warnings, ConversionWarning{Tag: TagBeneficiary, Field: "Cdtr.Nm", Message: `character "ë" is replaced by a full stop`})
+}
+
+func TestPacs008Document_FEDWireMessageInvalid(t *testing.T) {
+	fwm := mockPacs008CustomerTransfer()
+	doc, _, err := fwm.ToPacs008(ISOOpts{})
+	require.NoError(t, err)
+
+	msg := &doc.FIToFICustomerCreditTransfer
+	msg.GroupHeader.MessageID = "MSG-1"
+	_, _, err = doc.FEDWireMessage(ISOOpts{})
+	require.EqualError(t, err, fieldError("GrpHdr.MsgId", ErrInvalidProperty, "MSG-1").Error())
+
+	msg.GroupHeader.MessageID = fwm.InputMessageAccountabilityData.IMAD()
+	msg.CreditTransferTransaction.InterbankSettlementAmount.Currency = "EUR"
+	_, _, err = doc.FEDWireMessage(ISOOpts{})
+	require.EqualError(t, err, fieldError("IntrBkSttlmAmt", ErrNonCurrencyCode, "EUR").Error())
+
+	msg.CreditTransferTransaction.InterbankSettlementAmount.Currency = "USD"
+	msg.CreditTransferTransaction.InstructedAgent = nil
+	_, _, err = doc.FEDWireMessage(ISOOpts{})
+	require.EqualError(t, err, fieldError("InstdAgt", ErrFieldRequired).Error())
+
+	_, _, err = ReadISO20022(strings.NewReader(`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08"/>`), ISOOpts{})
+	require.EqualError(t, err, fieldError("Document", ErrISODocument, "urn:iso:std:iso:20022:tech:xsd:camt.053.001.08").Error())
+}
+
+func TestFedwireTextLines(t *testing.T) {
+	var ws conversionWarnings
+	lines := fedwireTextLines(TagFIReceiverFI, "InstrInf", []string{"Line One Line Two", "Three"}, []int{10, 10}, &ws)
+	require.Equal(t, []string{"Line One", "Line Two"}, lines)
+	require.Equal(t, "{6100} InstrInf is truncated to 2 lines", ws[0].String())
+
+	ws = nil
+	lines = fedwireTextLines(TagFIReceiverFI, "InstrInf", []string{"*{6000}*"}, []int{10}, &ws)
+	require.Equal(t, []string{"..6000.."}, lines)
+	require.Len(t, ws, 3)
+}
@@ -0,0 +1,887 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// pacs009Format names the pacs.009 message in ConversionWarnings
+const pacs009Format = "pacs.009"
+
+// The InstructionInformation of each {6xxx} tag begins with a code between slashes, such as /ACC/ for
+// FIBeneficiaryFI, followed for an advice tag by its AdviceCode and a slash, so the tag can be told apart.
+const (
+	isoInstructionReceiverFI           = "REC"
+	isoInstructionIntermediaryFI       = "INT"
+	isoInstructionIntermediaryFIAdvice = "INTA"
+	isoInstructionBeneficiaryFI        = "ACC"
+	isoInstructionBeneficiaryFIAdvice  = "ACCA"
+	isoInstructionBeneficiary          = "BNF"
+	isoInstructionBeneficiaryAdvice    = "BNFA"
+	isoInstructionAdditionalFIToFI     = "FIFI"
+	// isoInstructionPaymentMethodByCheque is the InstructionForCreditorAgent code of FIPaymentMethodToBeneficiary
+	isoInstructionPaymentMethodByCheque = "CHQB"
+	// isoInstructionInformationMaxLength is the maximum length of InstructionInformation
+	isoInstructionInformationMaxLength = 140
+)
+
+// The party identifier line of a SWIFT institution field identifies the institution by its Fedwire routing number
+// or CHIPS participant when it begins with one of these prefixes.
+const (
+	swiftPartyIdentifierFedwire = "//FW"
+	swiftPartyIdentifierCHIPS   = "//CH"
+)
+
+// isoInstructionTags are the {6xxx} tags of each instruction code
+var isoInstructionTags = map[string]string{
+	isoInstructionReceiverFI:           TagFIReceiverFI,
+	isoInstructionIntermediaryFI:       TagFIIntermediaryFI,
+	isoInstructionIntermediaryFIAdvice: TagFIIntermediaryFIAdvice,
+	isoInstructionBeneficiaryFI:        TagFIBeneficiaryFI,
+	isoInstructionBeneficiaryFIAdvice:  TagFIBeneficiaryFIAdvice,
+	isoInstructionBeneficiary:          TagFIBeneficiary,
+	isoInstructionBeneficiaryAdvice:    TagFIBeneficiaryAdvice,
+	isoInstructionAdditionalFIToFI:     TagFIAdditionalFIToFI,
+}
+
+// pacs009Tags are the tags of a FEDWireMessage which ToPacs009 translates
+var pacs009Tags = []string{
+	TagSenderSupplied, TagTypeSubType, TagInputMessageAccountabilityData, TagAmount, TagSenderDepositoryInstitution,
+	TagReceiverDepositoryInstitution, TagBusinessFunctionCode, TagSenderReference, TagLocalInstrument,
+	TagPaymentNotification, TagBeneficiaryIntermediaryFI, TagBeneficiaryFI, TagBeneficiary, TagBeneficiaryReference,
+	TagOriginator, TagOriginatorFI, TagInstructingFI, TagOriginatorToBeneficiary, TagFIReceiverFI,
+	TagFIIntermediaryFI, TagFIIntermediaryFIAdvice, TagFIBeneficiaryFI, TagFIBeneficiaryFIAdvice, TagFIBeneficiary,
+	TagFIBeneficiaryAdvice, TagFIPaymentMethodToBeneficiary, TagFIAdditionalFIToFI, TagCurrencyInstructedAmount,
+	TagOrderingCustomer, TagOrderingInstitution, TagIntermediaryInstitution, TagInstitutionAccount,
+	TagBeneficiaryCustomer, TagRemittance, TagSenderToReceiver,
+}
+
+// Pacs009Document is a Fedwire Funds Service FinancialInstitutionCreditTransfer, pacs.009.001.08, which is the
+// COV variant when it has an UnderlyingCustomerCreditTransfer
+type Pacs009Document struct {
+	XMLName          xml.Name       `xml:"urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08 Document"`
+	FICreditTransfer Pacs009Message `xml:"FICdtTrf"`
+}
+
+// Pacs009Message is a FinancialInstitutionCreditTransferV08 of the single transaction of a FEDWireMessage
+type Pacs009Message struct {
+	GroupHeader               ISOGroupHeader     `xml:"GrpHdr"`
+	CreditTransferTransaction Pacs009Transaction `xml:"CdtTrfTxInf"`
+}
+
+// Pacs009Transaction is a CreditTransferTransaction36
+type Pacs009Transaction struct {
+	PaymentID                        ISOPaymentIdentification             `xml:"PmtId"`
+	PaymentType                      *ISOPaymentTypeInformation           `xml:"PmtTpInf,omitempty"`
+	InterbankSettlementAmount        ISOAmount                            `xml:"IntrBkSttlmAmt"`
+	InterbankSettlementDate          string                               `xml:"IntrBkSttlmDt,omitempty"`
+	PreviousInstructingAgent         *ISOAgent                            `xml:"PrvsInstgAgt1,omitempty"`
+	InstructingAgent                 *ISOAgent                            `xml:"InstgAgt,omitempty"`
+	InstructedAgent                  *ISOAgent                            `xml:"InstdAgt,omitempty"`
+	IntermediaryAgent                *ISOAgent                            `xml:"IntrmyAgt1,omitempty"`
+	Debtor                           ISOAgent                             `xml:"Dbtr"`
+	DebtorAccount                    *ISOAccount                          `xml:"DbtrAcct,omitempty"`
+	DebtorAgent                      *ISOAgent                            `xml:"DbtrAgt,omitempty"`
+	CreditorAgent                    *ISOAgent                            `xml:"CdtrAgt,omitempty"`
+	Creditor                         ISOAgent                             `xml:"Cdtr"`
+	CreditorAccount                  *ISOAccount                          `xml:"CdtrAcct,omitempty"`
+	InstructionsForCreditorAgent     []ISOInstruction                     `xml:"InstrForCdtrAgt,omitempty"`
+	InstructionsForNextAgent         []ISOInstruction                     `xml:"InstrForNxtAgt,omitempty"`
+	Remittance                       *ISORemittanceInformation            `xml:"RmtInf,omitempty"`
+	UnderlyingCustomerCreditTransfer *ISOUnderlyingCustomerCreditTransfer `xml:"UndrlygCstmrCdtTrf,omitempty"`
+}
+
+// ISOInstruction is an instruction for the creditor agent or next agent
+type ISOInstruction struct {
+	Code        string `xml:"Cd,omitempty"`
+	Information string `xml:"InstrInf,omitempty"`
+}
+
+// ISOUnderlyingCustomerCreditTransfer is a CreditTransferTransaction37, the customer credit transfer covered by a
+// pacs.009 COV
+type ISOUnderlyingCustomerCreditTransfer struct {
+	Debtor                   ISOParty                  `xml:"Dbtr"`
+	DebtorAccount            *ISOAccount               `xml:"DbtrAcct,omitempty"`
+	DebtorAgent              ISOAgent                  `xml:"DbtrAgt"`
+	DebtorAgentAccount       *ISOAccount               `xml:"DbtrAgtAcct,omitempty"`
+	IntermediaryAgent        *ISOAgent                 `xml:"IntrmyAgt1,omitempty"`
+	IntermediaryAgentAccount *ISOAccount               `xml:"IntrmyAgt1Acct,omitempty"`
+	CreditorAgent            ISOAgent                  `xml:"CdtrAgt"`
+	CreditorAgentAccount     *ISOAccount               `xml:"CdtrAgtAcct,omitempty"`
+	Creditor                 ISOParty                  `xml:"Cdtr"`
+	CreditorAccount          *ISOAccount               `xml:"CdtrAcct,omitempty"`
+	InstructionsForNextAgent []ISOInstruction          `xml:"InstrForNxtAgt,omitempty"`
+	Remittance               *ISORemittanceInformation `xml:"RmtInf,omitempty"`
+	InstructedAmount         *ISOAmount                `xml:"InstdAmt,omitempty"`
+}
+
+// Bytes returns the XML of doc
+func (doc *Pacs009Document) Bytes() ([]byte, error) {
+	return marshalISODocument(doc)
+}
+
+// isPacs009 returns true if fwm is a transfer between financial institutions, a BankTransfer, FED funds transfer or
+// CustomerTransferPlus cover payment
+func (fwm *FEDWireMessage) isPacs009() bool {
+	switch fwm.BusinessFunctionCode.BusinessFunctionCode {
+	case BankTransfer, CheckSameDaySettlement, DepositSendersAccount, FEDFundsReturned, FEDFundsSold:
+		return true
+	case CustomerTransferPlus:
+		return fwm.isCoverPayment()
+	}
+	return false
+}
+
+// isCoverPayment returns true if the LocalInstrument of fwm is SequenceBCoverPaymentStructured
+func (fwm *FEDWireMessage) isCoverPayment() bool {
+	return fwm.LocalInstrument != nil && fwm.LocalInstrument.LocalInstrumentCode == SequenceBCoverPaymentStructured
+}
+
+// ToPacs009 converts fwm, a BankTransfer, CheckSameDaySettlement, DepositSendersAccount, FEDFundsReturned,
+// FEDFundsSold or CustomerTransferPlus cover payment with SubTypeCode BasicFundsTransfer, to a pacs.009 message. The
+// Originator and Beneficiary of fwm are its debtor and creditor, and the {6xxx} tags are instructions for the
+// creditor agent or next agent. The {7xxx} tags of a cover payment are its UnderlyingCustomerCreditTransfer.
+//
+// Tags without a corresponding element, and values truncated to fit one, are returned as warnings.
+func (fwm *FEDWireMessage) ToPacs009(opts ISOOpts) (*Pacs009Document, []ConversionWarning, error) {
+	if fwm.BusinessFunctionCode != nil && !fwm.isPacs009() {
+		return nil, nil, fieldError("BusinessFunctionCode.BusinessFunctionCode", ErrISOMessageType,
+			fwm.BusinessFunctionCode.BusinessFunctionCode)
+	}
+	if fwm.TypeSubType != nil && fwm.TypeSubType.SubTypeCode != BasicFundsTransfer {
+		return nil, nil, fieldError("TypeSubType.SubTypeCode", ErrISOMessageType, fwm.TypeSubType.SubTypeCode)
+	}
+	if err := fwm.mandatoryFields(); err != nil {
+		return nil, nil, err
+	}
+
+	var ws conversionWarnings
+	imad := fwm.InputMessageAccountabilityData
+	header, err := newISOGroupHeader(imad.IMAD(), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	tx := Pacs009Transaction{
+		PaymentID:        fwm.isoPaymentID(opts, pacs009Format, &ws),
+		PaymentType:      fwm.isoPaymentType(),
+		InstructingAgent: isoABAAgent(fwm.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderShortName),
+		InstructedAgent:  isoABAAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName),
+	}
+	if code := fwm.TypeSubType.TypeCode; code != isoTypeCode(fwm.BusinessFunctionCode.BusinessFunctionCode) {
+		ws.add(TagTypeSubType, "TypeSubType.TypeCode", "%s is not translated to %s", code, pacs009Format)
+	}
+	if tx.InterbankSettlementDate, err = isoDate(imad.InputCycleDate); err != nil {
+		return nil, nil, fieldError("InputMessageAccountabilityData.InputCycleDate", err, imad.InputCycleDate)
+	}
+	amt, err := fwm.Amount.Money()
+	if err != nil {
+		return nil, nil, fieldError("Amount.Amount", err, fwm.Amount.Amount)
+	}
+	if tx.InterbankSettlementAmount, err = newISOAmount(amt); err != nil {
+		return nil, nil, fieldError("Amount.Amount", err, fwm.Amount.Amount)
+	}
+	if fwm.InstructingFI != nil {
+		tx.PreviousInstructingAgent = isoFinancialInstitutionAgent(fwm.InstructingFI.FinancialInstitution)
+	}
+	if fwm.BeneficiaryIntermediaryFI != nil {
+		tx.IntermediaryAgent = isoFinancialInstitutionAgent(fwm.BeneficiaryIntermediaryFI.FinancialInstitution)
+	}
+
+	tx.Debtor = *tx.InstructingAgent
+	if fwm.Originator != nil {
+		tx.Debtor, tx.DebtorAccount = isoPersonalAgent(fwm.Originator.Personal)
+	}
+	if fwm.OriginatorFI != nil {
+		tx.DebtorAgent = isoFinancialInstitutionAgent(fwm.OriginatorFI.FinancialInstitution)
+	}
+	if fwm.BeneficiaryFI != nil {
+		tx.CreditorAgent = isoFinancialInstitutionAgent(fwm.BeneficiaryFI.FinancialInstitution)
+	}
+	tx.Creditor = *tx.InstructedAgent
+	if fwm.Beneficiary != nil {
+		tx.Creditor, tx.CreditorAccount = isoPersonalAgent(fwm.Beneficiary.Personal)
+	}
+
+	tx.InstructionsForCreditorAgent, tx.InstructionsForNextAgent = fwm.isoFIToFIInstructions(&ws)
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		tx.Remittance = isoUnstructuredRemittance(ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour)
+	}
+	if fwm.isCoverPayment() {
+		if tx.UnderlyingCustomerCreditTransfer, err = fwm.isoUnderlyingCustomerCreditTransfer(tx, &ws); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	ws.untranslated(fwm, pacs009Tags, pacs009Format)
+	fwm.isoSenderSuppliedWarnings(&ws)
+
+	doc := &Pacs009Document{FICreditTransfer: Pacs009Message{
+		GroupHeader:               header,
+		CreditTransferTransaction: tx,
+	}}
+	return doc, ws, nil
+}
+
+// isoPersonalAgent returns the ISOAgent and account of p, a financial institution identified as an Originator or
+// Beneficiary
+func isoPersonalAgent(p Personal) (ISOAgent, *ISOAccount) {
+	fi := FinancialInstitution{IdentificationCode: p.IdentificationCode, Name: p.Name, Address: p.Address}
+	var account *ISOAccount
+	id := strings.TrimSpace(p.Identifier)
+	switch p.IdentificationCode {
+	case DemandDepositAccountNumber:
+		account = isoAccount(id)
+	case SWIFTBICORBEIANDAccountNumber:
+		// BIC followed by a slash and the account number
+		if i := strings.Index(id, "/"); i > 0 {
+			fi.IdentificationCode = SWIFTBankIdentifierCode
+			fi.Identifier = id[:i]
+			account = isoAccount(id[i+1:])
+		} else {
+			account = isoAccount(id)
+		}
+	default:
+		fi.Identifier = id
+	}
+	if id == "" {
+		account = nil
+	}
+	return *isoFinancialInstitutionAgent(fi), account
+}
+
+// isoFIToFIInstructions returns the {6xxx} tags of fwm as instructions for the creditor agent and for the next
+// agent
+func (fwm *FEDWireMessage) isoFIToFIInstructions(ws *conversionWarnings) ([]ISOInstruction, []ISOInstruction) {
+	var creditorAgent, nextAgent []ISOInstruction
+	instruction := func(tag, field, code string, lines ...string) ISOInstruction {
+		text := "/" + code + "/" + isoJoinLines(lines...)
+		return ISOInstruction{Information: ws.truncate(tag, field, text, isoInstructionInformationMaxLength)}
+	}
+	fiToFI := func(f FIToFI) []string {
+		return []string{f.LineOne, f.LineTwo, f.LineThree, f.LineFour, f.LineFive, f.LineSix}
+	}
+	advice := func(a Advice) []string {
+		return []string{a.LineOne, a.LineTwo, a.LineThree, a.LineFour, a.LineFive, a.LineSix}
+	}
+
+	if t := fwm.FIReceiverFI; t != nil {
+		nextAgent = append(nextAgent, instruction(TagFIReceiverFI, "FIReceiverFI", isoInstructionReceiverFI,
+			fiToFI(t.FIToFI)...))
+	}
+	if t := fwm.FIIntermediaryFI; t != nil {
+		nextAgent = append(nextAgent, instruction(TagFIIntermediaryFI, "FIIntermediaryFI",
+			isoInstructionIntermediaryFI, fiToFI(t.FIToFI)...))
+	}
+	if t := fwm.FIIntermediaryFIAdvice; t != nil {
+		nextAgent = append(nextAgent, instruction(TagFIIntermediaryFIAdvice, "FIIntermediaryFIAdvice",
+			isoInstructionIntermediaryFIAdvice+"/"+t.Advice.AdviceCode, advice(t.Advice)...))
+	}
+	if t := fwm.FIBeneficiaryFI; t != nil {
+		creditorAgent = append(creditorAgent, instruction(TagFIBeneficiaryFI, "FIBeneficiaryFI",
+			isoInstructionBeneficiaryFI, fiToFI(t.FIToFI)...))
+	}
+	if t := fwm.FIBeneficiaryFIAdvice; t != nil {
+		creditorAgent = append(creditorAgent, instruction(TagFIBeneficiaryFIAdvice, "FIBeneficiaryFIAdvice",
+			isoInstructionBeneficiaryFIAdvice+"/"+t.Advice.AdviceCode, advice(t.Advice)...))
+	}
+	if t := fwm.FIBeneficiary; t != nil {
+		creditorAgent = append(creditorAgent, instruction(TagFIBeneficiary, "FIBeneficiary",
+			isoInstructionBeneficiary, fiToFI(t.FIToFI)...))
+	}
+	if t := fwm.FIBeneficiaryAdvice; t != nil {
+		creditorAgent = append(creditorAgent, instruction(TagFIBeneficiaryAdvice, "FIBeneficiaryAdvice",
+			isoInstructionBeneficiaryAdvice+"/"+t.Advice.AdviceCode, advice(t.Advice)...))
+	}
+	if t := fwm.FIPaymentMethodToBeneficiary; t != nil {
+		creditorAgent = append(creditorAgent, ISOInstruction{
+			Code: isoInstructionPaymentMethodByCheque,
+			Information: ws.truncate(TagFIPaymentMethodToBeneficiary, "FIPaymentMethodToBeneficiary.AdditionalInformation",
+				t.AdditionalInformation, isoInstructionInformationMaxLength),
+		})
+	}
+	if t := fwm.FIAdditionalFIToFI; t != nil {
+		a := t.AdditionalFIToFI
+		nextAgent = append(nextAgent, instruction(TagFIAdditionalFIToFI, "FIAdditionalFIToFI",
+			isoInstructionAdditionalFIToFI, a.LineOne, a.LineTwo, a.LineThree, a.LineFour, a.LineFive, a.LineSix))
+	}
+	return creditorAgent, nextAgent
+}
+
+// isoUnderlyingCustomerCreditTransfer returns the {7xxx} cover payment tags of fwm as the customer credit transfer
+// covered by tx. Without an OrderingInstitution or InstitutionAccount, the debtor and creditor of tx are the debtor
+// agent and creditor agent of the customer credit transfer.
+func (fwm *FEDWireMessage) isoUnderlyingCustomerCreditTransfer(tx Pacs009Transaction, ws *conversionWarnings) (*ISOUnderlyingCustomerCreditTransfer, error) {
+	cct := &ISOUnderlyingCustomerCreditTransfer{
+		DebtorAgent:   tx.Debtor,
+		CreditorAgent: tx.Creditor,
+	}
+	if t := fwm.OrderingCustomer; t != nil {
+		cct.Debtor, cct.DebtorAccount = isoSwiftParty(TagOrderingCustomer, "OrderingCustomer.CoverPayment", t.CoverPayment, ws)
+	}
+	if t := fwm.OrderingInstitution; t != nil {
+		cct.DebtorAgent, cct.DebtorAgentAccount = isoSwiftAgent(TagOrderingInstitution, "OrderingInstitution.CoverPayment",
+			t.CoverPayment, ws)
+	}
+	if t := fwm.IntermediaryInstitution; t != nil {
+		agent, account := isoSwiftAgent(TagIntermediaryInstitution, "IntermediaryInstitution.CoverPayment",
+			t.CoverPayment, ws)
+		cct.IntermediaryAgent, cct.IntermediaryAgentAccount = &agent, account
+	}
+	if t := fwm.InstitutionAccount; t != nil {
+		cct.CreditorAgent, cct.CreditorAgentAccount = isoSwiftAgent(TagInstitutionAccount, "InstitutionAccount.CoverPayment",
+			t.CoverPayment, ws)
+	}
+	if t := fwm.BeneficiaryCustomer; t != nil {
+		cct.Creditor, cct.CreditorAccount = isoSwiftParty(TagBeneficiaryCustomer, "BeneficiaryCustomer.CoverPayment",
+			t.CoverPayment, ws)
+	}
+	if t := fwm.SenderToReceiver; t != nil {
+		for _, line := range swiftLines(t.CoverPayment) {
+			cct.InstructionsForNextAgent = append(cct.InstructionsForNextAgent, ISOInstruction{Information: line})
+		}
+	}
+	if t := fwm.Remittance; t != nil {
+		cp := t.CoverPayment
+		cct.Remittance = isoUnstructuredRemittance(cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree, cp.SwiftLineFour)
+	}
+	if t := fwm.CurrencyInstructedAmount; t != nil && t.CurrencyCode != "" {
+		m, err := t.Money()
+		if err != nil {
+			return nil, fieldError("CurrencyInstructedAmount.Amount", err, t.Amount)
+		}
+		amt, err := newISOAmount(m)
+		if err != nil {
+			return nil, fieldError("CurrencyInstructedAmount.Amount", err, t.Amount)
+		}
+		cct.InstructedAmount = &amt
+	}
+	return cct, nil
+}
+
+// swiftLines returns the non-empty lines of cp
+func swiftLines(cp CoverPayment) []string {
+	var lines []string
+	for _, line := range []string{cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree, cp.SwiftLineFour,
+		cp.SwiftLineFive, cp.SwiftLineSix} {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// swiftFieldOption returns the letter option of a SWIFT field tag, such as A for 52A
+func swiftFieldOption(tag string) byte {
+	tag = strings.ToUpper(strings.TrimSpace(tag))
+	if tag == "" {
+		return 0
+	}
+	if c := tag[len(tag)-1]; c >= 'A' && c <= 'Z' {
+		return c
+	}
+	return 0
+}
+
+// isoSwiftParty returns the ISOParty and account of a SWIFT customer field, such as 50K or 59, which is an optional
+// /account line followed by a BIC for option A, the coded lines of option F, or a name and address
+func isoSwiftParty(tag, field string, cp CoverPayment, ws *conversionWarnings) (ISOParty, *ISOAccount) {
+	lines := swiftLines(cp)
+	option := swiftFieldOption(cp.SwiftFieldTag)
+	if option == 'F' && len(lines) > 0 {
+		oof := &OriginatorOptionF{PartyIdentifier: lines[0]}
+		coded := []*string{&oof.Name, &oof.LineOne, &oof.LineTwo, &oof.LineThree}
+		for i, line := range lines[1:] {
+			if i >= len(coded) {
+				ws.add(tag, field, "line %q is not translated", line)
+				continue
+			}
+			*coded[i] = line
+		}
+		return isoOptionFParty(tag, field, oof, ws)
+	}
+
+	var party ISOParty
+	var account *ISOAccount
+	if len(lines) > 0 && strings.HasPrefix(lines[0], "/") {
+		account = isoAccount(strings.TrimPrefix(lines[0], "/"))
+		lines = lines[1:]
+	}
+	if option == 'A' && len(lines) > 0 {
+		party.Identification = &ISOPartyIdentification{Organisation: &ISOOrganisationIdentification{AnyBIC: lines[0]}}
+		for _, line := range lines[1:] {
+			ws.add(tag, field, "line %q is not translated", line)
+		}
+		return party, account
+	}
+	if len(lines) > 0 {
+		party.Name = lines[0]
+		party.PostalAddress = isoAddressLines(lines[1:]...)
+	}
+	return party, account
+}
+
+// isoSwiftAgent returns the ISOAgent and account of a SWIFT institution field, such as 52A or 57D, which is an
+// optional party identifier line followed by a BIC for option A or otherwise a name and address. A party identifier
+// of //FW or //CH followed by a routing number or CHIPS participant identifies the agent, and otherwise is an account.
+func isoSwiftAgent(tag, field string, cp CoverPayment, ws *conversionWarnings) (ISOAgent, *ISOAccount) {
+	lines := swiftLines(cp)
+	var agent ISOAgent
+	var account *ISOAccount
+	if len(lines) > 0 && strings.HasPrefix(lines[0], "/") {
+		id := lines[0]
+		switch {
+		case strings.HasPrefix(id, swiftPartyIdentifierFedwire):
+			agent.FinancialInstitution.ClearingSystemMember = &ISOClearingSystemMemberIdentification{
+				ClearingSystemID: ISOCodeOrProprietary{Code: isoMemberIDABA},
+				MemberID:         strings.TrimPrefix(id, swiftPartyIdentifierFedwire),
+			}
+		case strings.HasPrefix(id, swiftPartyIdentifierCHIPS):
+			agent.FinancialInstitution.ClearingSystemMember = &ISOClearingSystemMemberIdentification{
+				ClearingSystemID: ISOCodeOrProprietary{Code: isoMemberIDCHIPS},
+				MemberID:         strings.TrimPrefix(id, swiftPartyIdentifierCHIPS),
+			}
+		default:
+			account = isoAccount(strings.TrimPrefix(id, "/"))
+		}
+		lines = lines[1:]
+	}
+	if swiftFieldOption(cp.SwiftFieldTag) == 'A' && len(lines) > 0 {
+		agent.FinancialInstitution.BICFI = lines[0]
+		for _, line := range lines[1:] {
+			ws.add(tag, field, "line %q is not translated", line)
+		}
+		return agent, account
+	}
+	if len(lines) > 0 {
+		agent.FinancialInstitution.Name = lines[0]
+		agent.FinancialInstitution.PostalAddress = isoAddressLines(lines[1:]...)
+	}
+	return agent, account
+}
+
+// FEDWireMessage converts doc to a BankTransfer, or the CheckSameDaySettlement, DepositSendersAccount,
+// FEDFundsReturned or FEDFundsSold of its CategoryPurpose, and a pacs.009 COV to a CustomerTransferPlus cover
+// payment. The IMAD is that of its MessageIdentification and its instructing and instructed agents are the sender
+// and receiver depository institutions, which must be identified by ABA routing numbers. The debtor and creditor
+// are the Originator and Beneficiary unless they are the instructing and instructed agents.
+//
+// Elements without a corresponding tag, values truncated to fit one, and tags removed as not permitted with the
+// BusinessFunctionCode of the message are returned as warnings.
+func (doc *Pacs009Document) FEDWireMessage(opts ISOOpts) (FEDWireMessage, []ConversionWarning, error) {
+	var ws conversionWarnings
+	msg := doc.FICreditTransfer
+	tx := msg.CreditTransferTransaction
+	fwm, err := newISOFEDWireMessage(msg.GroupHeader, tx.PaymentID, tx.InterbankSettlementAmount, tx.InstructingAgent,
+		tx.InstructedAgent, opts, &ws)
+	if err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+
+	cover := tx.UnderlyingCustomerCreditTransfer != nil
+	var li, purpose *ISOCodeOrProprietary
+	if pt := tx.PaymentType; pt != nil {
+		li, purpose = pt.LocalInstrument, pt.CategoryPurpose
+	}
+	bfc := BankTransfer
+	switch {
+	case cover:
+		bfc = CustomerTransferPlus
+	case purpose != nil:
+		switch purpose.Proprietary {
+		case CheckSameDaySettlement, DepositSendersAccount, FEDFundsReturned, FEDFundsSold:
+			bfc = purpose.Proprietary
+		}
+	}
+	fwm.setISOBusinessFunctionCode(bfc)
+	fwm.LocalInstrument = fedwireLocalInstrument(li, &ws)
+	if cover {
+		fwm.LocalInstrument = NewLocalInstrument()
+		fwm.LocalInstrument.LocalInstrumentCode = SequenceBCoverPaymentStructured
+	}
+
+	// only a CustomerTransferPlus permits an Originator or Beneficiary identified by a BIC and account number
+	if cover || tx.DebtorAccount != nil || tx.DebtorAgent != nil || tx.PreviousInstructingAgent != nil ||
+		!sameISOAgent(&tx.Debtor, tx.InstructingAgent) {
+		fwm.Originator = NewOriginator()
+		fwm.Originator.Personal = Personal(fedwireFinancialInstitution(TagOriginator, "Dbtr", &tx.Debtor, tx.DebtorAccount,
+			cover, &ws))
+	}
+	if agent := tx.DebtorAgent; agent != nil {
+		fwm.OriginatorFI = NewOriginatorFI()
+		fwm.OriginatorFI.FinancialInstitution = fedwireFinancialInstitution(TagOriginatorFI, "DbtrAgt", agent, nil,
+			true, &ws)
+	}
+	if agent := tx.PreviousInstructingAgent; agent != nil {
+		fwm.InstructingFI = NewInstructingFI()
+		fwm.InstructingFI.FinancialInstitution = fedwireFinancialInstitution(TagInstructingFI, "PrvsInstgAgt1", agent,
+			nil, true, &ws)
+	}
+	if agent := tx.IntermediaryAgent; agent != nil {
+		fwm.BeneficiaryIntermediaryFI = NewBeneficiaryIntermediaryFI()
+		fwm.BeneficiaryIntermediaryFI.FinancialInstitution = fedwireFinancialInstitution(TagBeneficiaryIntermediaryFI,
+			"IntrmyAgt1", agent, nil, true, &ws)
+	}
+	if agent := tx.CreditorAgent; agent != nil {
+		fwm.BeneficiaryFI = NewBeneficiaryFI()
+		fwm.BeneficiaryFI.FinancialInstitution = fedwireFinancialInstitution(TagBeneficiaryFI, "CdtrAgt", agent, nil,
+			true, &ws)
+	}
+	if cover || tx.CreditorAccount != nil || tx.CreditorAgent != nil || tx.IntermediaryAgent != nil ||
+		tx.InstructionsForCreditorAgent != nil || !sameISOAgent(&tx.Creditor, tx.InstructedAgent) {
+		fwm.Beneficiary = NewBeneficiary()
+		fwm.Beneficiary.Personal = Personal(fedwireFinancialInstitution(TagBeneficiary, "Cdtr", &tx.Creditor,
+			tx.CreditorAccount, cover, &ws))
+	}
+
+	for _, instruction := range tx.InstructionsForCreditorAgent {
+		fwm.fedwireInstruction("InstrForCdtrAgt", instruction, true, &ws)
+	}
+	for _, instruction := range tx.InstructionsForNextAgent {
+		fwm.fedwireInstruction("InstrForNxtAgt", instruction, false, &ws)
+	}
+	fwm.OriginatorToBeneficiary = fedwireUnstructuredRemittance(tx.Remittance, &ws)
+	if tx.Remittance != nil && tx.Remittance.Structured != nil {
+		ws.add("", "RmtInf.Strd", "is not translated to a %s", pacs009Format)
+	}
+	if cover {
+		if err := fwm.fedwireCoverPayment(tx, &ws); err != nil {
+			return FEDWireMessage{}, nil, err
+		}
+	}
+	fwm.removeISOUnpermittedTags(&ws)
+	return fwm, ws, nil
+}
+
+// fedwireInstruction sets the {6xxx} tag of an instruction for the creditor agent or next agent, which begins with
+// the code of the tag as described by isoFIToFIInstructions. Other instructions for the creditor agent are
+// FIBeneficiaryFI when there is a BeneficiaryFI, and otherwise FIReceiverFI like those for the next agent.
+func (fwm *FEDWireMessage) fedwireInstruction(field string, instruction ISOInstruction, creditorAgent bool, ws *conversionWarnings) {
+	info := strings.TrimSpace(instruction.Information)
+	if instruction.Code == isoInstructionPaymentMethodByCheque {
+		if fwm.FIPaymentMethodToBeneficiary != nil {
+			ws.add(TagFIPaymentMethodToBeneficiary, field, "is not translated with another %s instruction",
+				isoInstructionPaymentMethodByCheque)
+			return
+		}
+		fwm.FIPaymentMethodToBeneficiary = NewFIPaymentMethodToBeneficiary()
+		fwm.FIPaymentMethodToBeneficiary.AdditionalInformation = ws.truncate(TagFIPaymentMethodToBeneficiary,
+			field+".InstrInf", info, 30)
+		return
+	}
+	if instruction.Code != "" {
+		info = joinNonEmpty(" ", instruction.Code, info)
+	}
+
+	tag := TagFIReceiverFI
+	if creditorAgent && fwm.BeneficiaryFI != nil {
+		tag = TagFIBeneficiaryFI
+	}
+	var adviceCode string
+	if strings.HasPrefix(info, "/") {
+		if i := strings.Index(info[1:], "/"); i > 0 {
+			code, rest := info[1:i+1], info[i+2:]
+			if codeTag, ok := isoInstructionTags[code]; ok {
+				tag, info = codeTag, rest
+			}
+			switch tag {
+			case TagFIIntermediaryFIAdvice, TagFIBeneficiaryFIAdvice, TagFIBeneficiaryAdvice:
+				// the AdviceCode, such as LTR/
+				if j := strings.Index(info, "/"); j > 0 && (&validator{}).isAdviceCode(info[:j]) == nil {
+					adviceCode, info = info[:j], info[j+1:]
+				} else {
+					ws.add(tag, field, "is not translated without an AdviceCode")
+					return
+				}
+			}
+		}
+	}
+
+	name := fedWireMessageFieldName(tag)
+	if !fwm.tagValue(tag).IsNil() {
+		ws.add(tag, field, "is not translated with another %s instruction", name)
+		return
+	}
+	fiToFI := func() FIToFI {
+		var f FIToFI
+		lines := fedwireTextLines(tag, field, []string{info}, []int{30, 33, 33, 33, 33, 33}, ws)
+		setLines(lines, &f.LineOne, &f.LineTwo, &f.LineThree, &f.LineFour, &f.LineFive, &f.LineSix)
+		return f
+	}
+	advice := func() Advice {
+		a := Advice{AdviceCode: adviceCode}
+		lines := fedwireTextLines(tag, field, []string{info}, []int{26, 33, 33, 33, 33, 33}, ws)
+		setLines(lines, &a.LineOne, &a.LineTwo, &a.LineThree, &a.LineFour, &a.LineFive, &a.LineSix)
+		return a
+	}
+	switch tag {
+	case TagFIReceiverFI:
+		fwm.FIReceiverFI = NewFIReceiverFI()
+		fwm.FIReceiverFI.FIToFI = fiToFI()
+	case TagFIIntermediaryFI:
+		fwm.FIIntermediaryFI = NewFIIntermediaryFI()
+		fwm.FIIntermediaryFI.FIToFI = fiToFI()
+	case TagFIIntermediaryFIAdvice:
+		fwm.FIIntermediaryFIAdvice = NewFIIntermediaryFIAdvice()
+		fwm.FIIntermediaryFIAdvice.Advice = advice()
+	case TagFIBeneficiaryFI:
+		fwm.FIBeneficiaryFI = NewFIBeneficiaryFI()
+		fwm.FIBeneficiaryFI.FIToFI = fiToFI()
+	case TagFIBeneficiaryFIAdvice:
+		fwm.FIBeneficiaryFIAdvice = NewFIBeneficiaryFIAdvice()
+		fwm.FIBeneficiaryFIAdvice.Advice = advice()
+	case TagFIBeneficiary:
+		fwm.FIBeneficiary = NewFIBeneficiary()
+		fwm.FIBeneficiary.FIToFI = fiToFI()
+	case TagFIBeneficiaryAdvice:
+		fwm.FIBeneficiaryAdvice = NewFIBeneficiaryAdvice()
+		fwm.FIBeneficiaryAdvice.Advice = advice()
+	case TagFIAdditionalFIToFI:
+		fwm.FIAdditionalFIToFI = NewFIAdditionalFIToFI()
+		a := &fwm.FIAdditionalFIToFI.AdditionalFIToFI
+		lines := fedwireTextLines(tag, field, []string{info}, []int{35, 35, 35, 35, 35, 35}, ws)
+		setLines(lines, &a.LineOne, &a.LineTwo, &a.LineThree, &a.LineFour, &a.LineFive, &a.LineSix)
+	}
+}
+
+// fedwireCoverPayment sets the {7xxx} cover payment tags of fwm to the UnderlyingCustomerCreditTransfer of tx. Its
+// debtor agent and creditor agent are the OrderingInstitution and InstitutionAccount unless they are the debtor
+// and creditor of tx.
+func (fwm *FEDWireMessage) fedwireCoverPayment(tx Pacs009Transaction, ws *conversionWarnings) error {
+	cct := tx.UnderlyingCustomerCreditTransfer
+	const field = "UndrlygCstmrCdtTrf."
+
+	fwm.OrderingCustomer = NewOrderingCustomer()
+	fwm.OrderingCustomer.CoverPayment = fedwireSwiftParty(TagOrderingCustomer, field+"Dbtr", "50", cct.Debtor,
+		cct.DebtorAccount, ws)
+	if cct.DebtorAgentAccount != nil || !reflect.DeepEqual(cct.DebtorAgent, tx.Debtor) {
+		fwm.OrderingInstitution = NewOrderingInstitution()
+		fwm.OrderingInstitution.CoverPayment = fedwireSwiftAgent(TagOrderingInstitution, field+"DbtrAgt", "52",
+			cct.DebtorAgent, cct.DebtorAgentAccount, ws)
+	}
+	if agent := cct.IntermediaryAgent; agent != nil {
+		fwm.IntermediaryInstitution = NewIntermediaryInstitution()
+		fwm.IntermediaryInstitution.CoverPayment = fedwireSwiftAgent(TagIntermediaryInstitution, field+"IntrmyAgt1",
+			"56", *agent, cct.IntermediaryAgentAccount, ws)
+	}
+	if cct.CreditorAgentAccount != nil || !reflect.DeepEqual(cct.CreditorAgent, tx.Creditor) {
+		fwm.InstitutionAccount = NewInstitutionAccount()
+		fwm.InstitutionAccount.CoverPayment = fedwireSwiftAgent(TagInstitutionAccount, field+"CdtrAgt", "57",
+			cct.CreditorAgent, cct.CreditorAgentAccount, ws)
+	}
+	fwm.BeneficiaryCustomer = NewBeneficiaryCustomer()
+	fwm.BeneficiaryCustomer.CoverPayment = fedwireSwiftParty(TagBeneficiaryCustomer, field+"Cdtr", "59", cct.Creditor,
+		cct.CreditorAccount, ws)
+
+	var instructions []string
+	for _, instruction := range cct.InstructionsForNextAgent {
+		instructions = append(instructions, joinNonEmpty(" ", instruction.Code, instruction.Information))
+	}
+	if lines := fedwireTextLines(TagSenderToReceiver, field+"InstrForNxtAgt", instructions,
+		[]int{35, 35, 35, 35, 35, 35}, ws); lines != nil {
+		fwm.SenderToReceiver = NewSenderToReceiver()
+		cp := &fwm.SenderToReceiver.CoverPayment
+		cp.SwiftFieldTag = "72"
+		setLines(lines, &cp.SwiftLineOne, &cp.SwiftLineTwo, &cp.SwiftLineThree, &cp.SwiftLineFour, &cp.SwiftLineFive,
+			&cp.SwiftLineSix)
+	}
+	if rmt := cct.Remittance; rmt != nil {
+		if lines := fedwireTextLines(TagRemittance, field+"RmtInf.Ustrd", rmt.Unstructured, []int{35, 35, 35, 35},
+			ws); lines != nil {
+			fwm.Remittance = NewRemittance()
+			cp := &fwm.Remittance.CoverPayment
+			cp.SwiftFieldTag = "70"
+			setLines(lines, &cp.SwiftLineOne, &cp.SwiftLineTwo, &cp.SwiftLineThree, &cp.SwiftLineFour)
+		}
+		if rmt.Structured != nil {
+			ws.add(TagRemittance, field+"RmtInf.Strd", "is not translated")
+		}
+	}
+	if amount := cct.InstructedAmount; amount != nil {
+		m, err := amount.Money()
+		if err != nil {
+			return fieldError(field+"InstdAmt", err, amount.Value)
+		}
+		fwm.CurrencyInstructedAmount = NewCurrencyInstructedAmount()
+		fwm.CurrencyInstructedAmount.SwiftFieldTag = "33B"
+		if err := fwm.CurrencyInstructedAmount.SetMoney(m); err != nil {
+			return fieldError(field+"InstdAmt", err, amount.Value)
+		}
+	}
+	return nil
+}
+
+// fedwireSwiftParty returns the CoverPayment of a SWIFT customer field, such as 50F or 59, for party and its
+// account. A party with a private identification, or structured country and town, is option F and a party
+// identified only by a BIC is option A. Otherwise the field, such as 50K, has an optional /account line followed
+// by a name and address.
+func fedwireSwiftParty(tag, field, swiftTag string, party ISOParty, account *ISOAccount, ws *conversionWarnings) CoverPayment {
+	var lines []string
+	number := strings.TrimSpace(account.Number())
+	if number != "" {
+		lines = append(lines, "/"+number)
+	}
+	var bic string
+	var private *ISOPersonIdentification
+	if id := party.Identification; id != nil {
+		if id.Organisation != nil {
+			bic = strings.TrimSpace(id.Organisation.AnyBIC)
+			for _, other := range id.Organisation.Other {
+				ws.add(tag, field+".Id.OrgId.Othr", "identification %s is not translated", strings.TrimSpace(other.ID))
+			}
+		}
+		private = id.Private
+	}
+	adr := party.PostalAddress
+	identified := number != ""
+	if private != nil {
+		for _, other := range private.Other {
+			identified = identified || (other.SchemeName != nil && other.SchemeName.Code != "")
+		}
+	}
+	// option F requires a party identifier
+	optionF := identified && (private != nil || (adr != nil && adr.Country != "" && adr.TownName != ""))
+
+	option := "K"
+	switch {
+	case optionF:
+		option = "F"
+		lines = fedwireOptionFLines(tag, field, party, number, ws)
+	case bic != "" && strings.TrimSpace(party.Name) == "" && adr == nil:
+		option = "A"
+		lines = append(lines, bic)
+	default:
+		if bic != "" {
+			ws.add(tag, field+".Id.OrgId.AnyBIC", "is not translated with a Nm")
+		}
+		if private != nil {
+			ws.add(tag, field+".Id.PrvtId", "is not translated without a party identifier")
+		}
+		lines = append(lines, ws.truncate(tag, field+".Nm", party.Name, 35))
+		lines = append(lines, fedwireAddressLines(tag, field+".PstlAdr", adr, 5-len(lines), ws)...)
+	}
+	if option == "K" && swiftTag == "59" {
+		option = ""
+	}
+	if party.CountryOfResidence != "" {
+		ws.add(tag, field+".CtryOfRes", "is not translated")
+	}
+	if party.ContactDetails != nil {
+		ws.add(tag, field+".CtctDtls", "is not translated")
+	}
+	cp := CoverPayment{SwiftFieldTag: swiftTag + option}
+	setLines(lines, &cp.SwiftLineOne, &cp.SwiftLineTwo, &cp.SwiftLineThree, &cp.SwiftLineFour, &cp.SwiftLineFive)
+	return cp
+}
+
+// fedwireOptionFLines returns the lines of a SWIFT option F customer field for party and its account number. The
+// party identifier line is the account number, or otherwise the first private identification with its code,
+// followed by at most four numbered lines such as 1/ for the name.
+func fedwireOptionFLines(tag, field string, party ISOParty, number string, ws *conversionWarnings) []string {
+	var coded []string
+	line := func(code, value string) {
+		if value = strings.TrimSpace(value); value != "" {
+			coded = append(coded, ws.truncate(tag, field, code+"/"+value, 35))
+		}
+	}
+	line(OptionFName, party.Name)
+	if adr := party.PostalAddress; adr != nil {
+		for _, adrLine := range adr.AddressLines {
+			line(OptionFAddress, adrLine)
+		}
+		street := joinNonEmpty(" ", adr.BuildingNumber, adr.StreetName)
+		if street != "" && adr.AddressLines == nil {
+			line(OptionFAddress, street)
+		}
+		if adr.Country != "" && adr.TownName != "" {
+			line(OptionFCountryTown, adr.Country+"/"+joinNonEmpty(" ", adr.TownName, adr.PostCode))
+		}
+	}
+
+	partyIdentifier := ""
+	if number != "" {
+		partyIdentifier = "/" + number
+	}
+	if id := party.Identification; id != nil && id.Private != nil {
+		if birth := id.Private.DateAndPlaceOfBirth; birth != nil {
+			if t, err := time.Parse(isoDateLayout, birth.BirthDate); err == nil {
+				line(OptionFDOB, t.Format(cycleDateLayout))
+			} else {
+				ws.add(tag, field+".Id.PrvtId.DtAndPlcOfBirth.BirthDt", "is not an ISODate")
+			}
+			line(OptionFBirthPlace, joinNonEmpty("/", birth.CountryOfBirth, birth.CityOfBirth))
+		}
+		for _, other := range id.Private.Other {
+			var code string
+			if other.SchemeName != nil {
+				code = other.SchemeName.Code
+			}
+			switch {
+			case partyIdentifier == "" && code != "":
+				partyIdentifier = code + "/" + strings.TrimSpace(other.ID)
+			case code == PICCustomerNumber:
+				line(OptionFCustomerIdentificationNumber, other.ID)
+			case code == PICNationalIdentityNumber:
+				line(OptionFNationalIdentityNumber, other.ID)
+			default:
+				ws.add(tag, field+".Id.PrvtId.Othr", "identification %s is not translated", strings.TrimSpace(other.ID))
+			}
+		}
+	}
+	if len(coded) > 4 {
+		ws.add(tag, field, "is truncated to 4 lines")
+		coded = coded[:4]
+	}
+	return append([]string{ws.truncate(tag, field+".Id", partyIdentifier, 35)}, coded...)
+}
+
+// fedwireSwiftAgent returns the CoverPayment of a SWIFT institution field, such as 52A or 57D, for agent and its
+// account. The party identifier line is //FW or //CH followed by its routing number or CHIPS participant, or
+// otherwise its account. An agent identified by a BIC is option A and otherwise option D with a name and address.
+func fedwireSwiftAgent(tag, field, swiftTag string, agent ISOAgent, account *ISOAccount, ws *conversionWarnings) CoverPayment {
+	fin := agent.FinancialInstitution
+	var lines []string
+	number := strings.TrimSpace(account.Number())
+	if member := fin.ClearingSystemMember; member != nil {
+		switch member.ClearingSystemID.Code {
+		case isoMemberIDABA:
+			lines = append(lines, swiftPartyIdentifierFedwire+strings.TrimSpace(member.MemberID))
+		case isoMemberIDCHIPS:
+			lines = append(lines, swiftPartyIdentifierCHIPS+strings.TrimSpace(member.MemberID))
+		default:
+			ws.add(tag, field+".FinInstnId.ClrSysMmbId", "clearing system %s is not translated", member.ClearingSystemID.Code)
+		}
+	}
+	switch {
+	case number != "" && lines == nil:
+		lines = append(lines, "/"+number)
+	case number != "":
+		ws.add(tag, field+"Acct", "is not translated with a ClrSysMmbId")
+	}
+	if fin.Other != nil {
+		ws.add(tag, field+".FinInstnId.Othr", "is not translated")
+	}
+	if lines != nil {
+		lines[0] = ws.truncate(tag, field, lines[0], 35)
+	}
+
+	option := "D"
+	if bic := strings.TrimSpace(fin.BICFI); bic != "" {
+		option = "A"
+		lines = append(lines, bic)
+		if fin.Name != "" || fin.PostalAddress != nil {
+			ws.add(tag, field+".FinInstnId.Nm", "is not translated with a BICFI")
+		}
+	} else {
+		lines = append(lines, ws.truncate(tag, field+".FinInstnId.Nm", fin.Name, 35))
+		lines = append(lines, fedwireAddressLines(tag, field+".FinInstnId.PstlAdr", fin.PostalAddress, 5-len(lines), ws)...)
+	}
+	cp := CoverPayment{SwiftFieldTag: swiftTag + option}
+	setLines(lines, &cp.SwiftLineOne, &cp.SwiftLineTwo, &cp.SwiftLineThree, &cp.SwiftLineFour, &cp.SwiftLineFive)
+	return cp
+}
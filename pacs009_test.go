@@ -0,0 +1,313 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockPacs009BankTransfer creates a BankTransfer between two financial institutions
+func mockPacs009BankTransfer() FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20201005"
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BankTransfer
+	fwm.SenderReference = mockSenderReference()
+	fwm.Originator = mockOriginator()
+	fwm.Originator.Personal.IdentificationCode = FEDRoutingNumber
+	fwm.Originator.Personal.Identifier = "021000021"
+	fwm.Originator.Personal.Name = "Originator Bank"
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.IdentificationCode = SWIFTBankIdentifierCode
+	fwm.Beneficiary.Personal.Identifier = "CITIUS33"
+	fwm.Beneficiary.Personal.Name = "Beneficiary Bank"
+	fwm.BeneficiaryFI = mockBeneficiaryFI()
+	fwm.BeneficiaryFI.FinancialInstitution.IdentificationCode = FEDRoutingNumber
+	fwm.BeneficiaryFI.FinancialInstitution.Identifier = "231380104"
+	fwm.FIReceiverFI = mockFIReceiverFI()
+	fwm.FIIntermediaryFIAdvice = mockFIIntermediaryFIAdvice()
+	fwm.FIPaymentMethodToBeneficiary = mockFIPaymentMethodToBeneficiary()
+	fwm.FIAdditionalFIToFI = mockFIAdditionalFIToFI()
+	fwm.FIAdditionalFIToFI.AdditionalFIToFI.LineOne = "Additional information for the bank"
+	fwm.FIAdditionalFIToFI.AdditionalFIToFI.LineTwo = "Additional information for the bank"
+	fwm.FIAdditionalFIToFI.AdditionalFIToFI.LineThree = "Additional information for the bank"
+	fwm.FIAdditionalFIToFI.AdditionalFIToFI.LineFour = "Additional information for the bank"
+	fwm.OriginatorToBeneficiary = mockOriginatorToBeneficiary()
+	return fwm
+}
+
+// mockPacs009CoverPayment creates a CustomerTransferPlus cover payment
+func mockPacs009CoverPayment() FEDWireMessage {
+	fwm := mockPacs009BankTransfer()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	fwm.LocalInstrument = mockLocalInstrument()
+	fwm.LocalInstrument.LocalInstrumentCode = SequenceBCoverPaymentStructured
+	fwm.BeneficiaryReference = mockBeneficiaryReference()
+	fwm.FIReceiverFI = nil
+	fwm.FIIntermediaryFIAdvice = nil
+	fwm.FIPaymentMethodToBeneficiary = nil
+	fwm.FIAdditionalFIToFI = nil
+	fwm.OriginatorToBeneficiary = nil
+
+	fwm.OrderingCustomer = mockOrderingCustomer()
+	fwm.OrderingCustomer.CoverPayment = CoverPayment{SwiftFieldTag: "50F", SwiftLineOne: "/12345678",
+		SwiftLineTwo: "1/SMITH JOHN", SwiftLineThree: "2/299 PARK AVENUE", SwiftLineFour: "3/US/NEW YORK, NY 10017"}
+	fwm.OrderingInstitution = mockOrderingInstitution()
+	fwm.OrderingInstitution.CoverPayment = CoverPayment{SwiftFieldTag: "52A", SwiftLineOne: "/C/9876", SwiftLineTwo: "CHASUS33"}
+	fwm.IntermediaryInstitution = mockIntermediaryInstitution()
+	fwm.IntermediaryInstitution.CoverPayment = CoverPayment{SwiftFieldTag: "56D", SwiftLineOne: "//FW021000089",
+		SwiftLineTwo: "Intermediary Bank", SwiftLineThree: "New York"}
+	fwm.InstitutionAccount = mockInstitutionAccount()
+	fwm.InstitutionAccount.CoverPayment = CoverPayment{SwiftFieldTag: "57A", SwiftLineOne: "DEUTDEFF"}
+	fwm.BeneficiaryCustomer = mockBeneficiaryCustomer()
+	fwm.BeneficiaryCustomer.CoverPayment = CoverPayment{SwiftFieldTag: "59", SwiftLineOne: "/DE89370400440532013000",
+		SwiftLineTwo: "Muller GmbH", SwiftLineThree: "Frankfurt"}
+	fwm.Remittance = mockRemittance()
+	fwm.SenderToReceiver = mockSenderToReceiver()
+	fwm.CurrencyInstructedAmount = mockCurrencyInstructedAmount()
+	fwm.CurrencyInstructedAmount.SwiftFieldTag = "33B"
+	return fwm
+}
+
+func TestToPacs009(t *testing.T) {
+	fwm := mockPacs009BankTransfer()
+	doc, warnings, err := fwm.ToPacs009(ISOOpts{})
+	require.NoError(t, err)
+
+	require.Equal(t, fwm.InputMessageAccountabilityData.IMAD(), doc.FICreditTransfer.GroupHeader.MessageID)
+	tx := doc.FICreditTransfer.CreditTransferTransaction
+	require.Equal(t, BankTransfer, tx.PaymentType.CategoryPurpose.Proprietary)
+	require.Nil(t, tx.PaymentType.LocalInstrument)
+	require.Equal(t, ISOAmount{Currency: "USD", Value: "12345.67"}, tx.InterbankSettlementAmount)
+	require.Equal(t, "2020-10-05", tx.InterbankSettlementDate)
+	require.Equal(t, isoNotProvided, tx.PaymentID.EndToEndID)
+
+	require.Equal(t, "021000021", tx.Debtor.ABA())
+	require.Equal(t, "Originator Bank", tx.Debtor.FinancialInstitution.Name)
+	require.Nil(t, tx.DebtorAccount)
+	require.Nil(t, tx.DebtorAgent)
+	require.Equal(t, "CITIUS33", tx.Creditor.FinancialInstitution.BICFI)
+	require.Nil(t, tx.CreditorAccount)
+	require.Equal(t, "231380104", tx.CreditorAgent.ABA())
+
+	require.Equal(t, []ISOInstruction{{Code: isoInstructionPaymentMethodByCheque, Information: "Additional Information"}},
+		tx.InstructionsForCreditorAgent)
+	require.Len(t, tx.InstructionsForNextAgent, 3)
+	require.Equal(t, "/REC/Line Six", tx.InstructionsForNextAgent[0].Information)
+	require.Equal(t, "/INTA/LTR/Line One Line Two Line Three Line Four Line Five Line Six",
+		tx.InstructionsForNextAgent[1].Information)
+	require.Len(t, tx.InstructionsForNextAgent[2].Information, isoInstructionInformationMaxLength)
+	require.Contains(t, warnings, ConversionWarning{
+		Tag:     TagFIAdditionalFIToFI,
+		Field:   "FIAdditionalFIToFI",
+		Message: "is truncated to 140 characters",
+	})
+	require.Equal(t, []string{"LineOne", "LineTwo", "LineThree", "LineFour"}, tx.Remittance.Unstructured)
+	require.Nil(t, tx.UnderlyingCustomerCreditTransfer)
+
+	bs, err := doc.Bytes()
+	require.NoError(t, err)
+	require.Contains(t, string(bs), `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08">`)
+	var read Pacs009Document
+	require.NoError(t, xml.Unmarshal(bs, &read))
+	require.Equal(t, tx, read.FICreditTransfer.CreditTransferTransaction)
+}
+
+func TestToPacs009_coverPayment(t *testing.T) {
+	fwm := mockPacs009CoverPayment()
+	require.NoError(t, fwm.verify())
+	doc, warnings, err := fwm.ToPacs009(ISOOpts{})
+	require.NoError(t, err)
+
+	tx := doc.FICreditTransfer.CreditTransferTransaction
+	require.Equal(t, SequenceBCoverPaymentStructured, tx.PaymentType.LocalInstrument.Proprietary)
+	require.Equal(t, CustomerTransferPlus, tx.PaymentType.CategoryPurpose.Proprietary)
+	require.Equal(t, fwm.BeneficiaryReference.BeneficiaryReference, tx.PaymentID.EndToEndID)
+
+	cct := tx.UnderlyingCustomerCreditTransfer
+	require.NotNil(t, cct)
+	require.Equal(t, "SMITH JOHN", cct.Debtor.Name)
+	require.Equal(t, []string{"299 PARK AVENUE"}, cct.Debtor.PostalAddress.AddressLines)
+	require.Equal(t, "US", cct.Debtor.PostalAddress.Country)
+	require.Equal(t, "NEW YORK, NY 10017", cct.Debtor.PostalAddress.TownName)
+	require.Equal(t, "12345678", cct.DebtorAccount.Number())
+	require.Equal(t, "CHASUS33", cct.DebtorAgent.FinancialInstitution.BICFI)
+	require.Equal(t, "C/9876", cct.DebtorAgentAccount.Number())
+	require.Equal(t, "021000089", cct.IntermediaryAgent.ABA())
+	require.Equal(t, "Intermediary Bank", cct.IntermediaryAgent.FinancialInstitution.Name)
+	require.Equal(t, []string{"New York"}, cct.IntermediaryAgent.FinancialInstitution.PostalAddress.AddressLines)
+	require.Equal(t, "DEUTDEFF", cct.CreditorAgent.FinancialInstitution.BICFI)
+	require.Equal(t, "Muller GmbH", cct.Creditor.Name)
+	require.Equal(t, "DE89370400440532013000", cct.CreditorAccount.Number())
+	require.Len(t, cct.InstructionsForNextAgent, 6)
+	require.Len(t, cct.Remittance.Unstructured, 4)
+	require.Equal(t, &ISOAmount{Currency: "USD", Value: "1500.49"}, cct.InstructedAmount)
+	require.Equal(t, []ConversionWarning{{
+		Tag:     TagSenderSupplied,
+		Field:   "SenderSupplied.UserRequestCorrelation",
+		Message: "is not translated to ISO 20022",
+	}}, warnings)
+
+	_, err = doc.Bytes()
+	require.NoError(t, err)
+}
+
+func TestToPacs009_invalid(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	_, _, err := fwm.ToPacs009(ISOOpts{})
+	require.EqualError(t, err, fieldError("BusinessFunctionCode.BusinessFunctionCode", ErrISOMessageType, CustomerTransfer).Error())
+
+	fwm = mockPacs009CoverPayment()
+	_, _, err = fwm.ToPacs008(ISOOpts{})
+	require.EqualError(t, err, fieldError("LocalInstrument.LocalInstrumentCode", ErrISOMessageType, SequenceBCoverPaymentStructured).Error())
+}
+
+func TestToPacs009_paymentNotification(t *testing.T) {
+	fwm := mockPacs009CoverPayment()
+	fwm.PaymentNotification = mockPaymentNotification()
+	doc, warnings, err := fwm.ToPacs009(ISOOpts{})
+	require.NoError(t, err)
+	require.Equal(t, "End To End Identification", doc.FICreditTransfer.CreditTransferTransaction.PaymentID.EndToEndID)
+	require.Contains(t, warnings, ConversionWarning{
+		Tag:     TagPaymentNotification,
+		Field:   "PaymentNotification",
+		Message: "notification and contact details are not translated to pacs.009",
+	})
+	require.Contains(t, warnings, ConversionWarning{
+		Tag:     TagBeneficiaryReference,
+		Field:   "BeneficiaryReference",
+		Message: "is not translated to pacs.009 with an EndToEndIdentification",
+	})
+}
+
+func TestSwiftFieldOption(t *testing.T) {
+	require.Equal(t, byte('A'), swiftFieldOption("52A"))
+	require.Equal(t, byte('F'), swiftFieldOption(" 50f "))
+	require.Equal(t, byte(0), swiftFieldOption("59"))
+	require.Equal(t, byte(0), swiftFieldOption(""))
+}
+
+func TestPacs009Document_FEDWireMessage(t *testing.T) {
+	fwm := mockPacs009BankTransfer()
+	fwm.FIAdditionalFIToFI.AdditionalFIToFI = AdditionalFIToFI{LineOne: "Additional information"}
+	fwm.BeneficiaryIntermediaryFI = mockBeneficiaryIntermediaryFI()
+	fwm.BeneficiaryIntermediaryFI.FinancialInstitution.IdentificationCode = FEDRoutingNumber
+	fwm.BeneficiaryIntermediaryFI.FinancialInstitution.Identifier = "021000089"
+	fwm.FIBeneficiaryAdvice = mockFIBeneficiaryAdvice()
+	require.NoError(t, fwm.verify())
+	doc, _, err := fwm.ToPacs009(ISOOpts{})
+	require.NoError(t, err)
+	read, warnings := readISO20022(t, doc)
+
+	require.Equal(t, BankTransfer, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, fwm.TypeSubType, read.TypeSubType)
+	require.Equal(t, fwm.Amount, read.Amount)
+	require.Equal(t, fwm.SenderReference, read.SenderReference)
+	require.Nil(t, read.BeneficiaryReference)
+	require.Equal(t, fwm.Originator, read.Originator)
+	require.Equal(t, fwm.Beneficiary, read.Beneficiary)
+	require.Equal(t, fwm.BeneficiaryIntermediaryFI, read.BeneficiaryIntermediaryFI)
+	require.Equal(t, fwm.BeneficiaryFI, read.BeneficiaryFI)
+	require.Nil(t, read.OriginatorFI)
+
+	require.Equal(t, FIToFI{LineOne: "Line Six"}, read.FIReceiverFI.FIToFI)
+	require.Equal(t, Advice{
+		AdviceCode: AdviceCodeLetter,
+		LineOne:    "Line One Line Two Line",
+		LineTwo:    "Three Line Four Line Five Line",
+		LineThree:  "Six",
+	}, read.FIIntermediaryFIAdvice.Advice)
+	require.Equal(t, fwm.FIBeneficiaryAdvice.Advice.AdviceCode, read.FIBeneficiaryAdvice.Advice.AdviceCode)
+	require.Equal(t, fwm.FIPaymentMethodToBeneficiary, read.FIPaymentMethodToBeneficiary)
+	require.Equal(t, fwm.FIAdditionalFIToFI, read.FIAdditionalFIToFI)
+	require.Equal(t, fwm.OriginatorToBeneficiary, read.OriginatorToBeneficiary)
+	require.Empty(t, warnings)
+}
+
+func TestPacs009Document_FEDWireMessageSettlement(t *testing.T) {
+	fwm := mockPacs009BankTransfer()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = FEDFundsSold
+	fwm.TypeSubType.TypeCode = SettlementTransfer
+	fwm.Originator, fwm.Beneficiary, fwm.BeneficiaryFI = nil, nil, nil
+	fwm.FIReceiverFI, fwm.FIIntermediaryFIAdvice, fwm.FIPaymentMethodToBeneficiary = nil, nil, nil
+	doc, _, err := fwm.ToPacs009(ISOOpts{})
+	require.NoError(t, err)
+	tx := &doc.FICreditTransfer.CreditTransferTransaction
+	tx.InstructionsForCreditorAgent = []ISOInstruction{{Information: "Credit the account"}}
+	tx.PaymentType.LocalInstrument = &ISOCodeOrProprietary{Proprietary: "ACME"}
+	read, warnings := readISO20022(t, doc)
+
+	require.Equal(t, FEDFundsSold, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, SettlementTransfer, read.TypeSubType.TypeCode)
+	require.Nil(t, read.Originator)
+	require.Equal(t, "231380104", read.Beneficiary.Personal.Identifier)
+	require.Equal(t, FIToFI{LineOne: "Credit the account"}, read.FIReceiverFI.FIToFI)
+	require.Nil(t, read.LocalInstrument)
+	require.Equal(t, []ConversionWarning{{
+		Tag:     TagLocalInstrument,
+		Field:   "LocalInstrument",
+		Message: "is not permitted with BusinessFunctionCode FFS",
+	}}, warnings)
+}
+
+func TestPacs009Document_FEDWireMessageCoverPayment(t *testing.T) {
+	fwm := mockPacs009CoverPayment()
+	doc, _, err := fwm.ToPacs009(ISOOpts{})
+	require.NoError(t, err)
+	read, warnings := readISO20022(t, doc)
+
+	require.Equal(t, CustomerTransferPlus, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, fwm.LocalInstrument, read.LocalInstrument)
+	require.Equal(t, fwm.BeneficiaryReference, read.BeneficiaryReference)
+	require.Equal(t, fwm.Originator, read.Originator)
+	require.Equal(t, fwm.Beneficiary, read.Beneficiary)
+	require.Equal(t, fwm.BeneficiaryFI, read.BeneficiaryFI)
+	require.Equal(t, fwm.OrderingCustomer, read.OrderingCustomer)
+	require.Equal(t, fwm.OrderingInstitution, read.OrderingInstitution)
+	require.Equal(t, fwm.IntermediaryInstitution, read.IntermediaryInstitution)
+	require.Equal(t, fwm.InstitutionAccount, read.InstitutionAccount)
+	require.Equal(t, fwm.BeneficiaryCustomer, read.BeneficiaryCustomer)
+	require.Equal(t, fwm.CurrencyInstructedAmount, read.CurrencyInstructedAmount)
+	require.Equal(t, "70", read.Remittance.CoverPayment.SwiftFieldTag)
+	require.Equal(t, swiftLines(fwm.Remittance.CoverPayment), swiftLines(read.Remittance.CoverPayment))
+	require.Equal(t, "72", read.SenderToReceiver.CoverPayment.SwiftFieldTag)
+	require.Equal(t, swiftLines(fwm.SenderToReceiver.CoverPayment), swiftLines(read.SenderToReceiver.CoverPayment))
+	require.Empty(t, warnings)
+}
+
+func TestFedwireSwiftParty(t *testing.T) {
+	var ws conversionWarnings
+	party := ISOParty{
+		Name: "SMITH JOHN",
+		PostalAddress: &ISOPostalAddress{
+			StreetName: "Park Avenue", BuildingNumber: "299", TownName: "New York", PostCode: "10017", Country: "US",
+		},
+		Identification: &ISOPartyIdentification{Private: &ISOPersonIdentification{
+			DateAndPlaceOfBirth: &ISODateAndPlaceOfBirth{BirthDate: "1970-01-02", CityOfBirth: "BOSTON", CountryOfBirth: "US"},
+			Other: []ISOGenericIdentification{
+				{ID: "123456789", SchemeName: &ISOCodeOrProprietary{Code: PICPassportNumber}},
+				{ID: "987", SchemeName: &ISOCodeOrProprietary{Code: PICNationalIdentityNumber}},
+			},
+		}},
+	}
+	cp := fedwireSwiftParty(TagOrderingCustomer, "Dbtr", "50", party, nil, &ws)
+	require.Equal(t, CoverPayment{
+		SwiftFieldTag:  "50F",
+		SwiftLineOne:   "CCPT/123456789",
+		SwiftLineTwo:   "1/SMITH JOHN",
+		SwiftLineThree: "2/299 Park Avenue",
+		SwiftLineFour:  "3/US/New York 10017",
+		SwiftLineFive:  "4/19700102",
+	}, cp)
+	require.Equal(t, "{7050} Dbtr is truncated to 4 lines", ws[0].String())
+
+	ws = nil
+	party = ISOParty{Identification: &ISOPartyIdentification{Organisation: &ISOOrganisationIdentification{AnyBIC: "DEUTDEFF"}}}
+	cp = fedwireSwiftParty(TagBeneficiaryCustomer, "Cdtr", "59", party, isoAccount("123"), &ws)
+	require.Equal(t, CoverPayment{SwiftFieldTag: "59A", SwiftLineOne: "/123", SwiftLineTwo: "DEUTDEFF"}, cp)
+	require.Empty(t, ws)
+}
@@ -0,0 +1,292 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// pain013Format names the pain.013 message in ConversionWarnings
+const pain013Format = "pain.013"
+
+// pain013Tags are the tags of a FEDWireMessage which ToPain013 translates
+var pain013Tags = []string{
+	TagSenderSupplied, TagTypeSubType, TagInputMessageAccountabilityData, TagAmount, TagSenderDepositoryInstitution,
+	TagReceiverDepositoryInstitution, TagBusinessFunctionCode, TagSenderReference, TagBeneficiary,
+	TagBeneficiaryReference, TagAccountDebitedDrawdown, TagAccountCreditedDrawdown, TagOriginatorToBeneficiary,
+}
+
+// Pain013Document is a Fedwire Funds Service CreditorPaymentActivationRequest, pain.013.001.07, a drawdown
+// request
+type Pain013Document struct {
+	XMLName           xml.Name       `xml:"urn:iso:std:iso:20022:tech:xsd:pain.013.001.07 Document"`
+	ActivationRequest Pain013Message `xml:"CdtrPmtActvtnReq"`
+}
+
+// Pain013Message is a CreditorPaymentActivationRequestV07 of the single transaction of a FEDWireMessage
+type Pain013Message struct {
+	GroupHeader ISOPainGroupHeader        `xml:"GrpHdr"`
+	PaymentInfo Pain013PaymentInstruction `xml:"PmtInf"`
+}
+
+// ISOPainGroupHeader is the GroupHeader of a pain message. The agents of a pain.014 status report are those of
+// the debtor and creditor of the original request.
+type ISOPainGroupHeader struct {
+	MessageID        string    `xml:"MsgId"`
+	CreationDateTime string    `xml:"CreDtTm"`
+	NumberOfTxs      string    `xml:"NbOfTxs,omitempty"`
+	InitiatingParty  ISOParty  `xml:"InitgPty"`
+	DebtorAgent      *ISOAgent `xml:"DbtrAgt,omitempty"`
+	CreditorAgent    *ISOAgent `xml:"CdtrAgt,omitempty"`
+}
+
+// Pain013PaymentInstruction is a PaymentInstruction31, which requests a payment from the debtor's account at its
+// agent, the receiver of the drawdown request
+type Pain013PaymentInstruction struct {
+	PaymentInfoID             string                     `xml:"PmtInfId"`
+	PaymentMethod             string                     `xml:"PmtMtd"`
+	PaymentType               *ISOPaymentTypeInformation `xml:"PmtTpInf,omitempty"`
+	RequestedExecutionDate    ISODateChoice              `xml:"ReqdExctnDt"`
+	Debtor                    ISOParty                   `xml:"Dbtr"`
+	DebtorAccount             *ISOAccount                `xml:"DbtrAcct,omitempty"`
+	DebtorAgent               ISOAgent                   `xml:"DbtrAgt"`
+	CreditTransferTransaction Pain013Transaction         `xml:"CdtTrfTx"`
+}
+
+// Pain013Transaction is a CreditTransferTransaction35, whose creditor agent is the sender of the drawdown request
+type Pain013Transaction struct {
+	PaymentID       ISOPaymentIdentification  `xml:"PmtId"`
+	Amount          ISOAmountChoice           `xml:"Amt"`
+	ChargeBearer    string                    `xml:"ChrgBr"`
+	CreditorAgent   ISOAgent                  `xml:"CdtrAgt"`
+	Creditor        ISOParty                  `xml:"Cdtr"`
+	CreditorAccount *ISOAccount               `xml:"CdtrAcct,omitempty"`
+	Remittance      *ISORemittanceInformation `xml:"RmtInf,omitempty"`
+}
+
+// ISOAmountChoice is an AmountType4Choice with an instructed amount
+type ISOAmountChoice struct {
+	InstructedAmount ISOAmount `xml:"InstdAmt"`
+}
+
+// ISODateChoice is a DateAndDateTime2Choice with a date
+type ISODateChoice struct {
+	Date string `xml:"Dt"`
+}
+
+// Bytes returns the XML of doc
+func (doc *Pain013Document) Bytes() ([]byte, error) {
+	return marshalISODocument(doc)
+}
+
+// ToPain013 converts fwm, a BankDrawDownRequest or CustomerCorporateDrawdownRequest with SubTypeCode RequestCredit,
+// to a pain.013 message. The IMAD of fwm is the MessageIdentification and PaymentInformationIdentification of the
+// message, the AccountDebitedDrawdown is the debtor and its account, whose agent is the receiver depository
+// institution, and the AccountCreditedDrawdown is the account of the creditor, whose agent is the sender
+// depository institution. The creditor is the Beneficiary, or the sender without one.
+//
+// Tags without a corresponding element, and values truncated to fit one, are returned as warnings.
+func (fwm *FEDWireMessage) ToPain013(opts ISOOpts) (*Pain013Document, []ConversionWarning, error) {
+	if fwm.BusinessFunctionCode != nil {
+		switch code := fwm.BusinessFunctionCode.BusinessFunctionCode; code {
+		case BankDrawDownRequest, CustomerCorporateDrawdownRequest:
+		default:
+			return nil, nil, fieldError("BusinessFunctionCode.BusinessFunctionCode", ErrISOMessageType, code)
+		}
+	}
+	if fwm.TypeSubType != nil && fwm.TypeSubType.SubTypeCode != RequestCredit {
+		return nil, nil, fieldError("TypeSubType.SubTypeCode", ErrISOMessageType, fwm.TypeSubType.SubTypeCode)
+	}
+	if err := fwm.mandatoryFields(); err != nil {
+		return nil, nil, err
+	}
+	if fwm.AccountDebitedDrawdown == nil {
+		return nil, nil, fieldError("AccountDebitedDrawdown", ErrFieldRequired)
+	}
+	if fwm.AccountCreditedDrawdown == nil {
+		return nil, nil, fieldError("AccountCreditedDrawdown", ErrFieldRequired)
+	}
+
+	var ws conversionWarnings
+	imad := fwm.InputMessageAccountabilityData
+	header, err := newISOPainGroupHeader(imad.IMAD(), fwm.SenderDepositoryInstitution.SenderShortName, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	header.NumberOfTxs = "1"
+	pmt := Pain013PaymentInstruction{
+		PaymentInfoID: imad.IMAD(),
+		PaymentMethod: "TRF",
+		PaymentType:   fwm.isoPaymentType(),
+		DebtorAgent:   *isoABAAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName),
+	}
+	if code := fwm.TypeSubType.TypeCode; code != isoTypeCode(fwm.BusinessFunctionCode.BusinessFunctionCode) {
+		ws.add(TagTypeSubType, "TypeSubType.TypeCode", "%s is not translated to %s", code, pain013Format)
+	}
+	if pmt.RequestedExecutionDate.Date, err = isoDate(imad.InputCycleDate); err != nil {
+		return nil, nil, fieldError("InputMessageAccountabilityData.InputCycleDate", err, imad.InputCycleDate)
+	}
+	pmt.Debtor, pmt.DebtorAccount = isoDrawdownDebtor(fwm.AccountDebitedDrawdown)
+
+	tx := Pain013Transaction{
+		PaymentID:       fwm.isoPaymentID(opts, pain013Format, &ws),
+		ChargeBearer:    "SLEV",
+		CreditorAgent:   *isoABAAgent(fwm.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderShortName),
+		Creditor:        fwm.isoDrawdownCreditor(),
+		CreditorAccount: isoAccount(strings.TrimSpace(fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber)),
+	}
+	amt, err := fwm.Amount.Money()
+	if err != nil {
+		return nil, nil, fieldError("Amount.Amount", err, fwm.Amount.Amount)
+	}
+	if tx.Amount.InstructedAmount, err = newISOAmount(amt); err != nil {
+		return nil, nil, fieldError("Amount.Amount", err, fwm.Amount.Amount)
+	}
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		tx.Remittance = isoUnstructuredRemittance(ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour)
+	}
+	pmt.CreditTransferTransaction = tx
+
+	ws.untranslated(fwm, pain013Tags, pain013Format)
+	fwm.isoSenderSuppliedWarnings(&ws)
+
+	doc := &Pain013Document{ActivationRequest: Pain013Message{
+		GroupHeader: header,
+		PaymentInfo: pmt,
+	}}
+	return doc, ws, nil
+}
+
+// newISOPainGroupHeader returns the GroupHeader of a pain message initiated by the depository institution name
+func newISOPainGroupHeader(messageID, name string, opts ISOOpts) (ISOPainGroupHeader, error) {
+	created, err := opts.creationTime()
+	if err != nil {
+		return ISOPainGroupHeader{}, err
+	}
+	return ISOPainGroupHeader{
+		MessageID:        messageID,
+		CreationDateTime: created,
+		InitiatingParty:  ISOParty{Name: strings.TrimSpace(name)},
+	}, nil
+}
+
+// isoDrawdownDebtor returns the party and account of an AccountDebitedDrawdown
+func isoDrawdownDebtor(debitDD *AccountDebitedDrawdown) (ISOParty, *ISOAccount) {
+	party := ISOParty{
+		Name: strings.TrimSpace(debitDD.Name),
+		PostalAddress: isoAddressLines(debitDD.Address.AddressLineOne, debitDD.Address.AddressLineTwo,
+			debitDD.Address.AddressLineThree),
+	}
+	var account *ISOAccount
+	if id := strings.TrimSpace(debitDD.Identifier); id != "" {
+		account = isoAccount(id)
+	}
+	return party, account
+}
+
+// isoDrawdownCreditor returns the creditor of a drawdown request or refusal, its Beneficiary or otherwise its
+// sender. The AccountCreditedDrawdown is the creditor account, so the account number of a Beneficiary is an
+// identification of the party.
+func (fwm *FEDWireMessage) isoDrawdownCreditor() ISOParty {
+	if fwm.Beneficiary == nil {
+		return ISOParty{Name: strings.TrimSpace(fwm.SenderDepositoryInstitution.SenderShortName)}
+	}
+	party, account := isoPersonalParty(fwm.Beneficiary.Personal)
+	if account != nil {
+		party.Identification = &ISOPartyIdentification{Organisation: &ISOOrganisationIdentification{
+			Other: []ISOGenericIdentification{{
+				ID:         account.Number(),
+				SchemeName: &ISOCodeOrProprietary{Proprietary: DemandDepositAccountNumber},
+			}},
+		}}
+	}
+	return party
+}
+
+// FEDWireMessage converts doc to a drawdown request with SubTypeCode RequestCredit, a BankDrawDownRequest when its
+// CategoryPurpose is BankDrawDownRequest and otherwise a CustomerCorporateDrawdownRequest. The IMAD is that of its
+// MessageIdentification, and its creditor and debtor agents are the sender and receiver depository institutions,
+// which must be identified by ABA routing numbers. The debtor and its account are the AccountDebitedDrawdown and
+// the creditor account is the AccountCreditedDrawdown.
+//
+// Elements without a corresponding tag, values truncated to fit one, and tags removed as not permitted with the
+// BusinessFunctionCode of the message are returned as warnings.
+func (doc *Pain013Document) FEDWireMessage(opts ISOOpts) (FEDWireMessage, []ConversionWarning, error) {
+	var ws conversionWarnings
+	msg := doc.ActivationRequest
+	pmt := msg.PaymentInfo
+	tx := pmt.CreditTransferTransaction
+	fwm := FEDWireMessage{}
+	if err := fwm.setISOMessageID("GrpHdr.MsgId", msg.GroupHeader.MessageID, opts); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	if err := fwm.setISOAmount("PmtInf.CdtTrfTx.Amt.InstdAmt", tx.Amount.InstructedAmount); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	if err := fwm.setISODepositoryInstitutions("PmtInf.CdtTrfTx.CdtrAgt", &tx.CreditorAgent, "PmtInf.DbtrAgt",
+		&pmt.DebtorAgent, &ws); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	fwm.setISOReferences("PmtInf.CdtTrfTx.PmtId.InstrId", tx.PaymentID.InstructionID,
+		"PmtInf.CdtTrfTx.PmtId.EndToEndId", tx.PaymentID.EndToEndID, &ws)
+	if strings.TrimSpace(tx.PaymentID.UETR) != "" {
+		ws.add("", "PmtInf.CdtTrfTx.PmtId.UETR", "has no corresponding tag")
+	}
+
+	bfc := CustomerCorporateDrawdownRequest
+	if pt := pmt.PaymentType; pt != nil && pt.CategoryPurpose != nil && pt.CategoryPurpose.Proprietary == BankDrawDownRequest {
+		bfc = BankDrawDownRequest
+	}
+	fwm.setISOBusinessFunctionCode(bfc)
+	fwm.TypeSubType.SubTypeCode = RequestCredit
+	if err := fwm.setISODrawdownAccounts("PmtInf", pmt.Debtor, pmt.DebtorAccount, "PmtInf.CdtTrfTx", tx.CreditorAccount,
+		&ws); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	fwm.setISODrawdownBeneficiary("PmtInf.CdtTrfTx.Cdtr", tx.Creditor, &ws)
+	fwm.OriginatorToBeneficiary = fedwireUnstructuredRemittance(tx.Remittance, &ws)
+	if tx.Remittance != nil && tx.Remittance.Structured != nil {
+		ws.add("", "PmtInf.CdtTrfTx.RmtInf.Strd", "is not translated to a drawdown request")
+	}
+	fwm.removeISOUnpermittedTags(&ws)
+	return fwm, ws, nil
+}
+
+// setISODrawdownAccounts sets the AccountDebitedDrawdown of fwm to the debtor of a drawdown and its account, and
+// the AccountCreditedDrawdown to the creditor account
+func (fwm *FEDWireMessage) setISODrawdownAccounts(debtorField string, debtor ISOParty, debtorAccount *ISOAccount, creditorField string, creditorAccount *ISOAccount, ws *conversionWarnings) error {
+	number := strings.TrimSpace(debtorAccount.Number())
+	if number == "" {
+		return fieldError(debtorField+".DbtrAcct", ErrFieldRequired)
+	}
+	fwm.AccountDebitedDrawdown = NewAccountDebitedDrawdown()
+	fwm.AccountDebitedDrawdown.IdentificationCode = DemandDepositAccountNumber
+	fwm.AccountDebitedDrawdown.Identifier = ws.truncate(TagAccountDebitedDrawdown, debtorField+".DbtrAcct", number, 34)
+	fwm.AccountDebitedDrawdown.Name = ws.truncate(TagAccountDebitedDrawdown, debtorField+".Dbtr.Nm", debtor.Name, 35)
+	fwm.AccountDebitedDrawdown.Address = fedwireAddress(TagAccountDebitedDrawdown, debtorField+".Dbtr.PstlAdr",
+		debtor.PostalAddress, ws)
+	if debtor.Identification != nil {
+		ws.add(TagAccountDebitedDrawdown, debtorField+".Dbtr.Id", "is not translated")
+	}
+
+	if number = strings.TrimSpace(creditorAccount.Number()); number == "" {
+		return fieldError(creditorField+".CdtrAcct", ErrFieldRequired)
+	}
+	fwm.AccountCreditedDrawdown = NewAccountCreditedDrawdown()
+	fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber = ws.truncate(TagAccountCreditedDrawdown,
+		creditorField+".CdtrAcct", number, 9)
+	return nil
+}
+
+// setISODrawdownBeneficiary sets the Beneficiary of fwm, a drawdown request or refusal, to the creditor of a
+// drawdown. A creditor without an identification is the sender of a BankDrawDownRequest rather than a Beneficiary.
+func (fwm *FEDWireMessage) setISODrawdownBeneficiary(field string, creditor ISOParty, ws *conversionWarnings) {
+	if fwm.BusinessFunctionCode.BusinessFunctionCode == BankDrawDownRequest && creditor.Identification == nil {
+		return
+	}
+	fwm.Beneficiary = NewBeneficiary()
+	fwm.Beneficiary.Personal = fedwirePersonal(TagBeneficiary, field, creditor, nil, ws)
+}
@@ -0,0 +1,132 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockPain013CustomerCorporateDrawdownRequest creates a CustomerCorporateDrawdownRequest whose Beneficiary is
+// identified by its account
+func mockPain013CustomerCorporateDrawdownRequest() FEDWireMessage {
+	fwm := mockBankDrawdownRequest()
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20201005"
+	fwm.TypeSubType.TypeCode = FundsTransfer
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerCorporateDrawdownRequest
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.IdentificationCode = DemandDepositAccountNumber
+	fwm.Beneficiary.Personal.Identifier = "987654321"
+	fwm.BeneficiaryReference = mockBeneficiaryReference()
+	fwm.OriginatorToBeneficiary = mockOriginatorToBeneficiary()
+	return fwm
+}
+
+func TestToPain013(t *testing.T) {
+	fwm := mockBankDrawdownRequest()
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20201005"
+	created := time.Date(2020, time.October, 5, 14, 30, 0, 0, time.UTC)
+	doc, warnings, err := fwm.ToPain013(ISOOpts{CreationTime: created})
+	require.NoError(t, err)
+
+	hdr := doc.ActivationRequest.GroupHeader
+	require.Equal(t, fwm.InputMessageAccountabilityData.IMAD(), hdr.MessageID)
+	require.Equal(t, "2020-10-05T10:30:00-04:00", hdr.CreationDateTime)
+	require.Equal(t, "1", hdr.NumberOfTxs)
+	require.Equal(t, "Wells Fargo NA", hdr.InitiatingParty.Name)
+	require.Nil(t, hdr.DebtorAgent)
+
+	pmt := doc.ActivationRequest.PaymentInfo
+	require.Equal(t, fwm.InputMessageAccountabilityData.IMAD(), pmt.PaymentInfoID)
+	require.Equal(t, "TRF", pmt.PaymentMethod)
+	require.Equal(t, BankDrawDownRequest, pmt.PaymentType.CategoryPurpose.Proprietary)
+	require.Equal(t, "2020-10-05", pmt.RequestedExecutionDate.Date)
+	require.Equal(t, "debitDD Name", pmt.Debtor.Name)
+	require.Equal(t, []string{"Address One", "Address Two", "Address Three"}, pmt.Debtor.PostalAddress.AddressLines)
+	require.Equal(t, "123456789", pmt.DebtorAccount.Number())
+	require.Equal(t, "231380104", pmt.DebtorAgent.ABA())
+
+	tx := pmt.CreditTransferTransaction
+	require.Equal(t, "Sender Reference", tx.PaymentID.InstructionID)
+	require.Equal(t, isoNotProvided, tx.PaymentID.EndToEndID)
+	require.Equal(t, ISOAmount{Currency: "USD", Value: "12345.67"}, tx.Amount.InstructedAmount)
+	require.Equal(t, "121042882", tx.CreditorAgent.ABA())
+	require.Equal(t, ISOParty{Name: "Wells Fargo NA"}, tx.Creditor)
+	require.Equal(t, "123456780", tx.CreditorAccount.Number())
+	require.Equal(t, []ConversionWarning{{
+		Tag:     TagSenderSupplied,
+		Field:   "SenderSupplied.UserRequestCorrelation",
+		Message: "is not translated to ISO 20022",
+	}}, warnings)
+}
+
+func TestToPain013_invalid(t *testing.T) {
+	fwm := mockPacs008CustomerTransfer()
+	_, _, err := fwm.ToPain013(ISOOpts{})
+	require.EqualError(t, err, fieldError("BusinessFunctionCode.BusinessFunctionCode", ErrISOMessageType,
+		CustomerTransfer).Error())
+
+	fwm = mockBankDrawdownRequest()
+	fwm.TypeSubType.SubTypeCode = RefusalRequestCredit
+	_, _, err = fwm.ToPain013(ISOOpts{})
+	require.EqualError(t, err, fieldError("TypeSubType.SubTypeCode", ErrISOMessageType, RefusalRequestCredit).Error())
+
+	fwm = mockBankDrawdownRequest()
+	fwm.AccountCreditedDrawdown = nil
+	_, _, err = fwm.ToPain013(ISOOpts{})
+	require.EqualError(t, err, fieldError("AccountCreditedDrawdown", ErrFieldRequired).Error())
+}
+
+func TestPain013Document_FEDWireMessage(t *testing.T) {
+	fwm := mockBankDrawdownRequest()
+	doc, _, err := fwm.ToPain013(ISOOpts{})
+	require.NoError(t, err)
+	read, warnings := readISO20022(t, doc)
+
+	require.True(t, read.IsDrawdownRequest())
+	require.Equal(t, fwm.InputMessageAccountabilityData, read.InputMessageAccountabilityData)
+	require.Equal(t, fwm.TypeSubType, read.TypeSubType)
+	require.Equal(t, BankDrawDownRequest, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, fwm.Amount, read.Amount)
+	require.Equal(t, fwm.SenderDepositoryInstitution, read.SenderDepositoryInstitution)
+	require.Equal(t, fwm.ReceiverDepositoryInstitution, read.ReceiverDepositoryInstitution)
+	require.Equal(t, fwm.SenderReference, read.SenderReference)
+	require.Equal(t, fwm.AccountDebitedDrawdown, read.AccountDebitedDrawdown)
+	require.Equal(t, fwm.AccountCreditedDrawdown, read.AccountCreditedDrawdown)
+	require.Nil(t, read.Beneficiary)
+	require.Empty(t, warnings)
+}
+
+func TestPain013Document_FEDWireMessageCustomerCorporate(t *testing.T) {
+	fwm := mockPain013CustomerCorporateDrawdownRequest()
+	require.NoError(t, fwm.verify())
+	doc, _, err := fwm.ToPain013(ISOOpts{UETR: "8a562c67-ca16-48ba-b074-65581be6f011"})
+	require.NoError(t, err)
+	read, warnings := readISO20022(t, doc)
+
+	require.Equal(t, CustomerCorporateDrawdownRequest, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, fwm.TypeSubType, read.TypeSubType)
+	require.Equal(t, fwm.BeneficiaryReference, read.BeneficiaryReference)
+	require.Equal(t, fwm.Beneficiary, read.Beneficiary)
+	require.Equal(t, fwm.AccountDebitedDrawdown, read.AccountDebitedDrawdown)
+	require.Equal(t, fwm.AccountCreditedDrawdown, read.AccountCreditedDrawdown)
+	require.Equal(t, fwm.OriginatorToBeneficiary, read.OriginatorToBeneficiary)
+	require.Equal(t, []ConversionWarning{{Field: "PmtInf.CdtTrfTx.PmtId.UETR", Message: "has no corresponding tag"}}, warnings)
+}
+
+func TestPain013Document_FEDWireMessageInvalid(t *testing.T) {
+	fwm := mockBankDrawdownRequest()
+	doc, _, err := fwm.ToPain013(ISOOpts{})
+	require.NoError(t, err)
+	doc.ActivationRequest.PaymentInfo.DebtorAccount = nil
+	_, _, err = doc.FEDWireMessage(ISOOpts{})
+	require.EqualError(t, err, fieldError("PmtInf.DbtrAcct", ErrFieldRequired).Error())
+
+	doc.ActivationRequest.PaymentInfo.DebtorAgent = ISOAgent{}
+	_, _, err = doc.FEDWireMessage(ISOOpts{})
+	require.EqualError(t, err, fieldError("PmtInf.DbtrAgt", ErrFieldRequired).Error())
+}
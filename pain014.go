@@ -0,0 +1,265 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// pain014Format names the pain.014 message in ConversionWarnings
+const pain014Format = "pain.014"
+
+// The TransactionStatus of a pain.014 response to a drawdown request
+const (
+	// isoStatusAccepted is the status of a drawdown payment
+	isoStatusAccepted = "ACCP"
+	// isoStatusRejected is the status of a drawdown refusal
+	isoStatusRejected = "RJCT"
+)
+
+// pain014Tags are the tags of a FEDWireMessage which ToPain014 translates
+var pain014Tags = []string{
+	TagSenderSupplied, TagTypeSubType, TagInputMessageAccountabilityData, TagAmount, TagSenderDepositoryInstitution,
+	TagReceiverDepositoryInstitution, TagBusinessFunctionCode, TagSenderReference, TagPreviousMessageIdentifier,
+	TagBeneficiary, TagBeneficiaryReference, TagAccountDebitedDrawdown, TagOriginator, TagAccountCreditedDrawdown,
+	TagFIAdditionalFIToFI,
+}
+
+// Pain014Document is a Fedwire Funds Service CreditorPaymentActivationRequestStatusReport, pain.014.001.07, the
+// acceptance or refusal of a drawdown request
+type Pain014Document struct {
+	XMLName      xml.Name       `xml:"urn:iso:std:iso:20022:tech:xsd:pain.014.001.07 Document"`
+	StatusReport Pain014Message `xml:"CdtrPmtActvtnReqStsRpt"`
+}
+
+// Pain014Message is a CreditorPaymentActivationRequestStatusReportV07 of the single transaction of a
+// FEDWireMessage
+type Pain014Message struct {
+	GroupHeader       ISOPainGroupHeader          `xml:"GrpHdr"`
+	OriginalGroupInfo ISOOriginalGroupInformation `xml:"OrgnlGrpInfAndSts"`
+	PaymentStatus     Pain014PaymentStatus        `xml:"OrgnlPmtInfAndSts"`
+}
+
+// Pain014PaymentStatus is an OriginalPaymentInstruction31 of the payment instruction of a drawdown request
+type Pain014PaymentStatus struct {
+	OriginalPaymentInfoID string                   `xml:"OrgnlPmtInfId"`
+	TransactionStatus     Pain014TransactionStatus `xml:"TxInfAndSts"`
+}
+
+// Pain014TransactionStatus is a PaymentTransaction104, the status of the transaction of a drawdown request
+type Pain014TransactionStatus struct {
+	StatusID                     string                       `xml:"StsId,omitempty"`
+	OriginalInstructionID        string                       `xml:"OrgnlInstrId,omitempty"`
+	Status                       string                       `xml:"TxSts"`
+	StatusReason                 []ISOReasonInformation       `xml:"StsRsnInf,omitempty"`
+	OriginalTransactionReference *Pain014TransactionReference `xml:"OrgnlTxRef,omitempty"`
+}
+
+// Pain014TransactionReference is an OriginalTransactionReference29, the amount and parties of a drawdown
+type Pain014TransactionReference struct {
+	Amount                 *ISOAmountChoice           `xml:"Amt,omitempty"`
+	RequestedExecutionDate *ISODateChoice             `xml:"ReqdExctnDt,omitempty"`
+	PaymentType            *ISOPaymentTypeInformation `xml:"PmtTpInf,omitempty"`
+	ISOTransactionParties
+}
+
+// Bytes returns the XML of doc
+func (doc *Pain014Document) Bytes() ([]byte, error) {
+	return marshalISODocument(doc)
+}
+
+// ToPain014 converts fwm, the response to a drawdown request, to a pain.014 message. A DrawdownResponse with
+// SubTypeCode FundsTransferRequestCredit accepts the request, with its Originator as the debtor and Beneficiary as
+// the creditor. A BankDrawDownRequest or CustomerCorporateDrawdownRequest with SubTypeCode RefusalRequestCredit
+// rejects it, with its AccountDebitedDrawdown and AccountCreditedDrawdown as the debtor and creditor accounts and
+// its FIAdditionalFIToFI as the reason.
+//
+// The IMAD of fwm is the MessageIdentification of the message, whose debtor and creditor agents are the sender and
+// receiver depository institutions, and the PreviousMessageIdentifier is the MessageIdentification of the request.
+//
+// Tags without a corresponding element, and values truncated to fit one, are returned as warnings.
+func (fwm *FEDWireMessage) ToPain014(opts ISOOpts) (*Pain014Document, []ConversionWarning, error) {
+	status := ""
+	if fwm.BusinessFunctionCode != nil && fwm.TypeSubType != nil {
+		switch code := fwm.BusinessFunctionCode.BusinessFunctionCode; {
+		case code == DrawdownResponse && fwm.TypeSubType.SubTypeCode == FundsTransferRequestCredit:
+			status = isoStatusAccepted
+		case (code == BankDrawDownRequest || code == CustomerCorporateDrawdownRequest) &&
+			fwm.TypeSubType.SubTypeCode == RefusalRequestCredit:
+			status = isoStatusRejected
+		case code == DrawdownResponse || code == BankDrawDownRequest || code == CustomerCorporateDrawdownRequest:
+			return nil, nil, fieldError("TypeSubType.SubTypeCode", ErrISOMessageType, fwm.TypeSubType.SubTypeCode)
+		default:
+			return nil, nil, fieldError("BusinessFunctionCode.BusinessFunctionCode", ErrISOMessageType, code)
+		}
+	}
+	if err := fwm.mandatoryFields(); err != nil {
+		return nil, nil, err
+	}
+	if fwm.PreviousMessageIdentifier == nil || strings.TrimSpace(fwm.PreviousMessageIdentifier.PreviousMessageIdentifier) == "" {
+		return nil, nil, fieldError("PreviousMessageIdentifier", ErrFieldRequired)
+	}
+	previous := strings.TrimSpace(fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	if status == isoStatusRejected {
+		if fwm.AccountDebitedDrawdown == nil {
+			return nil, nil, fieldError("AccountDebitedDrawdown", ErrFieldRequired)
+		}
+		if fwm.AccountCreditedDrawdown == nil {
+			return nil, nil, fieldError("AccountCreditedDrawdown", ErrFieldRequired)
+		}
+	}
+
+	var ws conversionWarnings
+	imad := fwm.InputMessageAccountabilityData
+	header, err := newISOPainGroupHeader(imad.IMAD(), fwm.SenderDepositoryInstitution.SenderShortName, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	header.DebtorAgent = isoABAAgent(fwm.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderShortName)
+	header.CreditorAgent = isoABAAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+	if code := fwm.TypeSubType.TypeCode; code != isoTypeCode(fwm.BusinessFunctionCode.BusinessFunctionCode) {
+		ws.add(TagTypeSubType, "TypeSubType.TypeCode", "%s is not translated to %s", code, pain014Format)
+	}
+
+	tx := Pain014TransactionStatus{Status: status}
+	if fwm.SenderReference != nil {
+		tx.StatusID = strings.TrimSpace(fwm.SenderReference.SenderReference)
+	}
+	if fwm.BeneficiaryReference != nil {
+		tx.OriginalInstructionID = strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference)
+	}
+	ref := &Pain014TransactionReference{PaymentType: fwm.isoPaymentType()}
+	amt, err := fwm.Amount.Money()
+	if err != nil {
+		return nil, nil, fieldError("Amount.Amount", err, fwm.Amount.Amount)
+	}
+	amount, err := newISOAmount(amt)
+	if err != nil {
+		return nil, nil, fieldError("Amount.Amount", err, fwm.Amount.Amount)
+	}
+	ref.Amount = &ISOAmountChoice{InstructedAmount: amount}
+
+	if status == isoStatusAccepted {
+		date, err := isoDate(imad.InputCycleDate)
+		if err != nil {
+			return nil, nil, fieldError("InputMessageAccountabilityData.InputCycleDate", err, imad.InputCycleDate)
+		}
+		ref.RequestedExecutionDate = &ISODateChoice{Date: date}
+		ref.ISOTransactionParties = fwm.isoTransactionParties(header.DebtorAgent, &ws)
+	} else {
+		debtor, account := isoDrawdownDebtor(fwm.AccountDebitedDrawdown)
+		creditor := fwm.isoDrawdownCreditor()
+		ref.Debtor = &ISOTransactionParty{Party: &debtor}
+		ref.DebtorAccount = account
+		ref.Creditor = &ISOTransactionParty{Party: &creditor}
+		ref.CreditorAccount = isoAccount(strings.TrimSpace(fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber))
+		if fi := fwm.FIAdditionalFIToFI; fi != nil {
+			a := fi.AdditionalFIToFI
+			tx.StatusReason = isoReasonInformation(isoStatusReasons, a.LineOne, a.LineTwo, a.LineThree, a.LineFour,
+				a.LineFive, a.LineSix)
+		}
+	}
+	tx.OriginalTransactionReference = ref
+
+	ws.untranslated(fwm, pain014Tags, pain014Format)
+	fwm.isoSenderSuppliedWarnings(&ws)
+
+	doc := &Pain014Document{StatusReport: Pain014Message{
+		GroupHeader: header,
+		OriginalGroupInfo: ISOOriginalGroupInformation{
+			OriginalMessageID:     previous,
+			OriginalMessageNameID: pain013MessageNameID,
+		},
+		PaymentStatus: Pain014PaymentStatus{
+			OriginalPaymentInfoID: previous,
+			TransactionStatus:     tx,
+		},
+	}}
+	return doc, ws, nil
+}
+
+// FEDWireMessage converts doc to the response to a drawdown request. An accepted request is a DrawdownResponse
+// with SubTypeCode FundsTransferRequestCredit, whose Originator and Beneficiary are the debtor and creditor. A
+// rejected request is a refusal with SubTypeCode RefusalRequestCredit, a BankDrawDownRequest when its
+// CategoryPurpose is BankDrawDownRequest and otherwise a CustomerCorporateDrawdownRequest, whose debtor and
+// creditor accounts are the AccountDebitedDrawdown and AccountCreditedDrawdown and whose reasons are its
+// FIAdditionalFIToFI.
+//
+// The IMAD is that of its MessageIdentification, the debtor and creditor agents of its GroupHeader are the sender
+// and receiver depository institutions, and the PreviousMessageIdentifier is the MessageIdentification of the
+// request.
+//
+// Elements without a corresponding tag, values truncated to fit one, and tags removed as not permitted with the
+// BusinessFunctionCode of the message are returned as warnings.
+func (doc *Pain014Document) FEDWireMessage(opts ISOOpts) (FEDWireMessage, []ConversionWarning, error) {
+	var ws conversionWarnings
+	msg := doc.StatusReport
+	tx := msg.PaymentStatus.TransactionStatus
+	ref := tx.OriginalTransactionReference
+	fwm := FEDWireMessage{}
+	if err := fwm.setISOMessageID("GrpHdr.MsgId", msg.GroupHeader.MessageID, opts); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	if ref == nil || ref.Amount == nil {
+		return FEDWireMessage{}, nil, fieldError("OrgnlPmtInfAndSts.TxInfAndSts.OrgnlTxRef.Amt", ErrFieldRequired)
+	}
+	if err := fwm.setISOAmount("OrgnlPmtInfAndSts.TxInfAndSts.OrgnlTxRef.Amt.InstdAmt", ref.Amount.InstructedAmount); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	if err := fwm.setISODepositoryInstitutions("GrpHdr.DbtrAgt", msg.GroupHeader.DebtorAgent, "GrpHdr.CdtrAgt",
+		msg.GroupHeader.CreditorAgent, &ws); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	fwm.setISOReferences("OrgnlPmtInfAndSts.TxInfAndSts.StsId", tx.StatusID,
+		"OrgnlPmtInfAndSts.TxInfAndSts.OrgnlInstrId", tx.OriginalInstructionID, &ws)
+	previous := strings.TrimSpace(msg.OriginalGroupInfo.OriginalMessageID)
+	if _, err := fedwireIMAD("OrgnlGrpInfAndSts.OrgnlMsgId", previous); err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	fwm.PreviousMessageIdentifier = NewPreviousMessageIdentifier()
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = previous
+
+	field := "OrgnlPmtInfAndSts.TxInfAndSts.OrgnlTxRef"
+	switch tx.Status {
+	case isoStatusAccepted:
+		fwm.setISOBusinessFunctionCode(DrawdownResponse)
+		fwm.TypeSubType.SubTypeCode = FundsTransferRequestCredit
+		fwm.setISOTransactionParties(field, ref.ISOTransactionParties, msg.GroupHeader.DebtorAgent,
+			msg.GroupHeader.CreditorAgent, &ws)
+		if tx.StatusReason != nil {
+			ws.add("", "OrgnlPmtInfAndSts.TxInfAndSts.StsRsnInf", "is not translated to a drawdown payment")
+		}
+	case isoStatusRejected:
+		bfc := CustomerCorporateDrawdownRequest
+		if pt := ref.PaymentType; pt != nil && pt.CategoryPurpose != nil && pt.CategoryPurpose.Proprietary == BankDrawDownRequest {
+			bfc = BankDrawDownRequest
+		}
+		fwm.setISOBusinessFunctionCode(bfc)
+		fwm.TypeSubType.SubTypeCode = RefusalRequestCredit
+		var debtor, creditor ISOParty
+		if ref.Debtor != nil && ref.Debtor.Party != nil {
+			debtor = *ref.Debtor.Party
+		}
+		if err := fwm.setISODrawdownAccounts(field, debtor, ref.DebtorAccount, field, ref.CreditorAccount, &ws); err != nil {
+			return FEDWireMessage{}, nil, err
+		}
+		if ref.Creditor != nil && ref.Creditor.Party != nil {
+			creditor = *ref.Creditor.Party
+		}
+		fwm.setISODrawdownBeneficiary(field+".Cdtr.Pty", creditor, &ws)
+		if texts := fedwireReasonTexts(tx.StatusReason, isoStatusReasons); texts != nil {
+			lines := fedwireTextLines(TagFIAdditionalFIToFI, "OrgnlPmtInfAndSts.TxInfAndSts.StsRsnInf", texts,
+				[]int{35, 35, 35, 35, 35, 35}, &ws)
+			fwm.FIAdditionalFIToFI = NewFIAdditionalFIToFI()
+			a := &fwm.FIAdditionalFIToFI.AdditionalFIToFI
+			setLines(lines, &a.LineOne, &a.LineTwo, &a.LineThree, &a.LineFour, &a.LineFive, &a.LineSix)
+		}
+	default:
+		return FEDWireMessage{}, nil, fieldError("OrgnlPmtInfAndSts.TxInfAndSts.TxSts", ErrISOMessageType, tx.Status)
+	}
+	fwm.removeISOUnpermittedTags(&ws)
+	return fwm, ws, nil
+}
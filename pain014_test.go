@@ -0,0 +1,151 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockPain014DrawdownOpts creates the DrawdownOpts of a response sent on the cycle date of its request
+func mockPain014DrawdownOpts(request FEDWireMessage) DrawdownOpts {
+	opts := DrawdownOpts{InputMessageAccountabilityData: mockInputMessageAccountabilityData(), SenderReference: "Response"}
+	opts.InputMessageAccountabilityData.InputCycleDate = request.InputMessageAccountabilityData.InputCycleDate
+	opts.InputMessageAccountabilityData.InputSequenceNumber = "000002"
+	return opts
+}
+
+func TestToPain014(t *testing.T) {
+	request := mockPain013CustomerCorporateDrawdownRequest()
+	fwm, err := NewDrawdownPayment(request, mockPain014DrawdownOpts(request))
+	require.NoError(t, err)
+	doc, _, err := fwm.ToPain014(ISOOpts{})
+	require.NoError(t, err)
+
+	msg := doc.StatusReport
+	require.Equal(t, fwm.InputMessageAccountabilityData.IMAD(), msg.GroupHeader.MessageID)
+	require.Equal(t, "231380104", msg.GroupHeader.DebtorAgent.ABA())
+	require.Equal(t, "121042882", msg.GroupHeader.CreditorAgent.ABA())
+	require.Equal(t, request.InputMessageAccountabilityData.IMAD(), msg.OriginalGroupInfo.OriginalMessageID)
+	require.Equal(t, pain013MessageNameID, msg.OriginalGroupInfo.OriginalMessageNameID)
+	require.Equal(t, request.InputMessageAccountabilityData.IMAD(), msg.PaymentStatus.OriginalPaymentInfoID)
+
+	tx := msg.PaymentStatus.TransactionStatus
+	require.Equal(t, isoStatusAccepted, tx.Status)
+	require.Equal(t, "Response", tx.StatusID)
+	require.Equal(t, "Sender Reference", tx.OriginalInstructionID)
+	ref := tx.OriginalTransactionReference
+	require.Equal(t, ISOAmount{Currency: "USD", Value: "12345.67"}, ref.Amount.InstructedAmount)
+	require.Equal(t, "2020-10-05", ref.RequestedExecutionDate.Date)
+	require.Equal(t, "debitDD Name", ref.Debtor.Party.Name)
+	require.Equal(t, "123456789", ref.DebtorAccount.Number())
+	require.Equal(t, "Name", ref.Creditor.Party.Name)
+	require.Equal(t, "987654321", ref.CreditorAccount.Number())
+}
+
+func TestToPain014_refusal(t *testing.T) {
+	request := mockBankDrawdownRequest()
+	opts := mockPain014DrawdownOpts(request)
+	opts.Reason = "Insufficient funds"
+	fwm, err := NewDrawdownRefusal(request, opts)
+	require.NoError(t, err)
+	doc, _, err := fwm.ToPain014(ISOOpts{})
+	require.NoError(t, err)
+
+	tx := doc.StatusReport.PaymentStatus.TransactionStatus
+	require.Equal(t, isoStatusRejected, tx.Status)
+	require.Equal(t, []ISOReasonInformation{{
+		Reason:         &ISOCodeOrProprietary{Code: "AM04"},
+		AdditionalInfo: []string{"Insufficient funds"},
+	}}, tx.StatusReason)
+	ref := tx.OriginalTransactionReference
+	require.Equal(t, BankDrawDownRequest, ref.PaymentType.CategoryPurpose.Proprietary)
+	require.Nil(t, ref.RequestedExecutionDate)
+	require.Equal(t, "123456789", ref.DebtorAccount.Number())
+	require.Equal(t, "123456780", ref.CreditorAccount.Number())
+}
+
+func TestToPain014_invalid(t *testing.T) {
+	fwm := mockBankDrawdownRequest()
+	_, _, err := fwm.ToPain014(ISOOpts{})
+	require.EqualError(t, err, fieldError("TypeSubType.SubTypeCode", ErrISOMessageType, RequestCredit).Error())
+
+	fwm = mockPacs008CustomerTransfer()
+	_, _, err = fwm.ToPain014(ISOOpts{})
+	require.EqualError(t, err, fieldError("BusinessFunctionCode.BusinessFunctionCode", ErrISOMessageType,
+		CustomerTransfer).Error())
+
+	request := mockBankDrawdownRequest()
+	fwm, err = NewDrawdownPayment(request, mockPain014DrawdownOpts(request))
+	require.NoError(t, err)
+	fwm.PreviousMessageIdentifier = nil
+	_, _, err = fwm.ToPain014(ISOOpts{})
+	require.EqualError(t, err, fieldError("PreviousMessageIdentifier", ErrFieldRequired).Error())
+}
+
+func TestPain014Document_FEDWireMessage(t *testing.T) {
+	request := mockPain013CustomerCorporateDrawdownRequest()
+	fwm, err := NewDrawdownPayment(request, mockPain014DrawdownOpts(request))
+	require.NoError(t, err)
+	doc, _, err := fwm.ToPain014(ISOOpts{})
+	require.NoError(t, err)
+	read, warnings := readISO20022(t, doc)
+
+	require.True(t, read.RespondsTo(request))
+	require.Equal(t, fwm.InputMessageAccountabilityData, read.InputMessageAccountabilityData)
+	require.Equal(t, DrawdownResponse, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, fwm.TypeSubType, read.TypeSubType)
+	require.Equal(t, fwm.Amount, read.Amount)
+	require.Equal(t, fwm.SenderDepositoryInstitution, read.SenderDepositoryInstitution)
+	require.Equal(t, fwm.ReceiverDepositoryInstitution, read.ReceiverDepositoryInstitution)
+	require.Equal(t, fwm.SenderReference, read.SenderReference)
+	require.Equal(t, fwm.BeneficiaryReference, read.BeneficiaryReference)
+	require.Equal(t, fwm.Originator, read.Originator)
+	require.Equal(t, fwm.Beneficiary, read.Beneficiary)
+	require.Empty(t, warnings)
+}
+
+func TestPain014Document_FEDWireMessageRefusal(t *testing.T) {
+	request := mockBankDrawdownRequest()
+	opts := mockPain014DrawdownOpts(request)
+	opts.Reason = "Insufficient funds"
+	fwm, err := NewDrawdownRefusal(request, opts)
+	require.NoError(t, err)
+	doc, _, err := fwm.ToPain014(ISOOpts{})
+	require.NoError(t, err)
+	read, warnings := readISO20022(t, doc)
+
+	require.True(t, read.RespondsTo(request))
+	require.Equal(t, BankDrawDownRequest, read.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, fwm.TypeSubType, read.TypeSubType)
+	require.Equal(t, fwm.AccountDebitedDrawdown, read.AccountDebitedDrawdown)
+	require.Equal(t, fwm.AccountCreditedDrawdown, read.AccountCreditedDrawdown)
+	require.Nil(t, read.Beneficiary)
+	require.Equal(t, fwm.FIAdditionalFIToFI, read.FIAdditionalFIToFI)
+	require.Empty(t, warnings)
+
+	// a reason code without additional information is described
+	doc.StatusReport.PaymentStatus.TransactionStatus.StatusReason = []ISOReasonInformation{{
+		Reason: &ISOCodeOrProprietary{Code: "MD07"},
+	}}
+	read, _ = readISO20022(t, doc)
+	require.Equal(t, "MD07 END CUSTOMER DECEASED", read.FIAdditionalFIToFI.AdditionalFIToFI.LineOne)
+}
+
+func TestPain014Document_FEDWireMessageInvalid(t *testing.T) {
+	request := mockBankDrawdownRequest()
+	fwm, err := NewDrawdownPayment(request, mockPain014DrawdownOpts(request))
+	require.NoError(t, err)
+	doc, _, err := fwm.ToPain014(ISOOpts{})
+	require.NoError(t, err)
+	doc.StatusReport.PaymentStatus.TransactionStatus.Status = "PDNG"
+	_, _, err = doc.FEDWireMessage(ISOOpts{})
+	require.EqualError(t, err, fieldError("OrgnlPmtInfAndSts.TxInfAndSts.TxSts", ErrISOMessageType, "PDNG").Error())
+
+	doc.StatusReport.PaymentStatus.TransactionStatus.OriginalTransactionReference = nil
+	_, _, err = doc.FEDWireMessage(ISOOpts{})
+	require.EqualError(t, err, fieldError("OrgnlPmtInfAndSts.TxInfAndSts.OrgnlTxRef.Amt", ErrFieldRequired).Error())
+}
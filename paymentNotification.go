@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // PaymentNotification is the PaymentNotification of the wire
@@ -49,6 +52,9 @@ func NewPaymentNotification() *PaymentNotification {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (pn *PaymentNotification) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 7 {
+		return NewTagWrongLengthErr(7, utf8.RuneCountInString(record))
+	}
 	pn.tag = record[:6]
 	pn.PaymentNotificationIndicator = pn.parseStringField(record[6:7])
 
@@ -106,31 +112,37 @@ func (pn *PaymentNotification) String() string {
 // Validate performs WIRE format rule checks on PaymentNotification and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (pn *PaymentNotification) Validate() error {
+	return pn.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on PaymentNotification and returns every error found
+func (pn *PaymentNotification) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if pn.tag != TagPaymentNotification {
-		return fieldError("tag", ErrValidTagForType, pn.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, pn.tag))
 	}
 	if err := pn.isNumeric(pn.PaymentNotificationIndicator); err != nil {
-		return fieldError("PaymentNotificationIndicator", err, pn.PaymentNotificationIndicator)
+		errs.Add(fieldError("PaymentNotificationIndicator", err, pn.PaymentNotificationIndicator))
 	}
 	if err := pn.isAlphanumeric(pn.ContactNotificationElectronicAddress); err != nil {
-		return fieldError("ContactNotificationElectronicAddress", err, pn.ContactNotificationElectronicAddress)
+		errs.Add(fieldError("ContactNotificationElectronicAddress", err, pn.ContactNotificationElectronicAddress))
 	}
 	if err := pn.isAlphanumeric(pn.ContactName); err != nil {
-		return fieldError("ContactName", err, pn.ContactName)
+		errs.Add(fieldError("ContactName", err, pn.ContactName))
 	}
 	if err := pn.isAlphanumeric(pn.ContactPhoneNumber); err != nil {
-		return fieldError("ContactPhoneNumber", err, pn.ContactPhoneNumber)
+		errs.Add(fieldError("ContactPhoneNumber", err, pn.ContactPhoneNumber))
 	}
 	if err := pn.isAlphanumeric(pn.ContactMobileNumber); err != nil {
-		return fieldError("ContactMobileNumber", err, pn.ContactMobileNumber)
+		errs.Add(fieldError("ContactMobileNumber", err, pn.ContactMobileNumber))
 	}
 	if err := pn.isAlphanumeric(pn.ContactFaxNumber); err != nil {
-		return fieldError("FaxNumber", err, pn.ContactFaxNumber)
+		errs.Add(fieldError("FaxNumber", err, pn.ContactFaxNumber))
 	}
 	if err := pn.isAlphanumeric(pn.EndToEndIdentification); err != nil {
-		return fieldError("EndToEndIdentification", err, pn.EndToEndIdentification)
+		errs.Add(fieldError("EndToEndIdentification", err, pn.EndToEndIdentification))
 	}
-	return nil
+	return errs
 }
 
 // PaymentNotificationIndicatorField gets a string of PaymentNotificationIndicator field
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // PreviousMessageIdentifier is the PreviousMessageIdentifier of the wire
@@ -35,6 +38,9 @@ func NewPreviousMessageIdentifier() *PreviousMessageIdentifier {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (pmi *PreviousMessageIdentifier) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 28 {
+		return NewTagWrongLengthErr(28, utf8.RuneCountInString(record))
+	}
 	pmi.tag = record[:6]
 	pmi.PreviousMessageIdentifier = pmi.parseStringField(record[6:28])
 	return nil
@@ -66,13 +72,19 @@ func (pmi *PreviousMessageIdentifier) String() string {
 // Validate performs WIRE format rule checks on PreviousMessageIdentifier and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (pmi *PreviousMessageIdentifier) Validate() error {
+	return pmi.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on PreviousMessageIdentifier and returns every error found
+func (pmi *PreviousMessageIdentifier) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if pmi.tag != TagPreviousMessageIdentifier {
-		return fieldError("tag", ErrValidTagForType, pmi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, pmi.tag))
 	}
 	if err := pmi.isAlphanumeric(pmi.PreviousMessageIdentifier); err != nil {
-		return fieldError("PreviousMessageIdentifier", err, pmi.PreviousMessageIdentifier)
+		errs.Add(fieldError("PreviousMessageIdentifier", err, pmi.PreviousMessageIdentifier))
 	}
-	return nil
+	return errs
 }
 
 // PreviousMessageIdentifierField gets a string of PreviousMessageIdentifier field
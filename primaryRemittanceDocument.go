@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // PrimaryRemittanceDocument is primary remittance document
@@ -41,6 +44,9 @@ func NewPrimaryRemittanceDocument() *PrimaryRemittanceDocument {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (prd *PrimaryRemittanceDocument) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 10 {
+		return NewTagWrongLengthErr(10, utf8.RuneCountInString(record))
+	}
 	prd.tag = record[:6]
 	prd.DocumentTypeCode = record[6:10]
 
@@ -88,44 +94,48 @@ func (prd *PrimaryRemittanceDocument) String() string {
 // Document Type Code and Document Identification Number are mandatory for each set of remittance data.
 // Proprietary Document Type Code is mandatory for Document Type Code PROP; otherwise not permitted.
 func (prd *PrimaryRemittanceDocument) Validate() error {
-	if err := prd.fieldInclusion(); err != nil {
-		return err
-	}
+	return prd.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on PrimaryRemittanceDocument and returns every error found
+func (prd *PrimaryRemittanceDocument) validateAll() base.ErrorList {
+	errs := prd.fieldInclusion()
 	if prd.tag != TagPrimaryRemittanceDocument {
-		return fieldError("tag", ErrValidTagForType, prd.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, prd.tag))
 	}
 	if err := prd.isDocumentTypeCode(prd.DocumentTypeCode); err != nil {
-		return fieldError("DocumentTypeCode", err, prd.DocumentTypeCode)
+		errs.Add(fieldError("DocumentTypeCode", err, prd.DocumentTypeCode))
 	}
 	if err := prd.isAlphanumeric(prd.ProprietaryDocumentTypeCode); err != nil {
-		return fieldError("ProprietaryDocumentTypeCode", err, prd.ProprietaryDocumentTypeCode)
+		errs.Add(fieldError("ProprietaryDocumentTypeCode", err, prd.ProprietaryDocumentTypeCode))
 	}
 	if err := prd.isAlphanumeric(prd.DocumentIdentificationNumber); err != nil {
-		return fieldError("DocumentIdentificationNumber", err, prd.DocumentIdentificationNumber)
+		errs.Add(fieldError("DocumentIdentificationNumber", err, prd.DocumentIdentificationNumber))
 	}
 	if err := prd.isAlphanumeric(prd.Issuer); err != nil {
-		return fieldError("Issuer", err, prd.Issuer)
+		errs.Add(fieldError("Issuer", err, prd.Issuer))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (prd *PrimaryRemittanceDocument) fieldInclusion() error {
+func (prd *PrimaryRemittanceDocument) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if prd.DocumentIdentificationNumber == "" {
-		return fieldError("DocumentIdentificationNumber", ErrFieldRequired)
+		errs.Add(fieldError("DocumentIdentificationNumber", ErrFieldRequired))
 	}
 	switch prd.DocumentTypeCode {
 	case ProprietaryDocumentType:
 		if prd.ProprietaryDocumentTypeCode == "" {
-			return fieldError("ProprietaryDocumentTypeCode", ErrFieldRequired)
+			errs.Add(fieldError("ProprietaryDocumentTypeCode", ErrFieldRequired))
 		}
 	default:
 		if strings.TrimSpace(prd.ProprietaryDocumentTypeCode) != "" {
-			return fieldError("ProprietaryDocumentTypeCode", ErrInvalidProperty, prd.ProprietaryDocumentTypeCode)
+			errs.Add(fieldError("ProprietaryDocumentTypeCode", ErrInvalidProperty, prd.ProprietaryDocumentTypeCode))
 		}
 	}
-	return nil
+	return errs
 }
 
 // DocumentTypeCodeField gets a string of the DocumentTypeCode field
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/moov-io/base"
@@ -22,9 +23,15 @@ type Reader struct {
 	File File
 	// line is the current line being parsed from the input r
 	line string
-	// ToDo:  Do we need a current FEDWireMessage, just use FEDWireMessage
 	// currentFEDWireMessage is the current FEDWireMessage being parsed
 	currentFEDWireMessage FEDWireMessage
+	// currentHasTags is true once any tag has been read into currentFEDWireMessage
+	currentHasTags bool
+	// currentHasSenderTags is true once a tag not appended by the Fed ({1100} - {1130}) has been read
+	// into currentFEDWireMessage
+	currentHasSenderTags bool
+	// pendingLine is true when line holds the first tag of the next FEDWireMessage, which has not been parsed
+	pendingLine bool
 	// lineNum is the line number of the file being parsed
 	lineNum int
 	// tagName holds the current tag name being parsed.
@@ -48,12 +55,14 @@ func (r *Reader) parseError(err error) error {
 	}
 }
 
+// tagBoundaryRegex matches the character preceding each tag after the first
+var tagBoundaryRegex = regexp.MustCompile(`[^^]\{\d{4}\}[^\*\{]`)
+
 func scanTags(data []byte, atEOF bool) (advance int, token []byte, err error) {
 	if atEOF && len(data) == 0 {
 		return 0, nil, nil
 	}
-	re := regexp.MustCompile(`[^^]\{\d{4}\}[^\*\{]`)
-	if loc := re.FindIndex(data); loc != nil {
+	if loc := tagBoundaryRegex.FindIndex(data); loc != nil {
 		return loc[0] + 1, data[0 : loc[0]+1], nil
 	}
 	// If we're at EOF, we have a final tag. Return it.
@@ -73,30 +82,105 @@ func NewReader(r io.Reader) *Reader {
 	}
 }
 
-// addCurrentFEDWireMessage creates the current FEDWireMessage for the file being read. A successful
-// current FEDWireMessage will be added to r.File once parsed.
-/*func (r *Reader) addCurrentFEDWireMessage(fwm FEDWireMessage) {
-	r.currentFEDWireMessage = FEDWireMessage{}
-}*/
+// startsFEDWireMessage returns true if r.line is the first tag of a new FEDWireMessage.
+//
+// Messages received from the Fed begin with MessageDisposition {1100} followed by the remaining
+// tags appended by the Fed and then SenderSupplied {1500}, while all other messages begin with {1500}.
+func (r *Reader) startsFEDWireMessage() bool {
+	if len(r.line) < 6 {
+		return false
+	}
+	switch r.line[:6] {
+	case TagMessageDisposition:
+		return r.currentHasTags
+	case TagSenderSupplied:
+		return r.currentHasSenderTags
+	}
+	return false
+}
 
-// Read reads each line of the FED Wire file and defines which parser to use based
-// on the first character of each line. It also enforces FED Wire formatting rules and returns
-// the appropriate error if issues are found.
-func (r *Reader) Read() (File, error) {
-	r.lineNum = 0
-	// read through the entire file
+// trackTag records that r.line was read into the current FEDWireMessage
+func (r *Reader) trackTag() {
+	r.currentHasTags = true
+	if len(r.line) < 6 {
+		r.currentHasSenderTags = true
+		return
+	}
+	switch r.line[:6] {
+	case TagMessageDisposition, TagReceiptTimeStamp, TagOutputMessageAccountabilityData, TagErrorWire:
+	default:
+		r.currentHasSenderTags = true
+	}
+}
+
+// scanLine advances r.line to the next non-empty tag, returning false once the input is exhausted
+func (r *Reader) scanLine() bool {
 	for r.scanner.Scan() {
-		line := r.scanner.Text()
+		line := strings.TrimRight(r.scanner.Text(), "\r\n")
+		if line == "" {
+			continue
+		}
 		r.lineNum++
 		// ToDo: File length Check?
 		r.line = line
+		return true
+	}
+	return false
+}
+
+// readFEDWireMessage parses tags until the end of the next FEDWireMessage and returns it along with each
+// error encountered while parsing its tags. A nil FEDWireMessage is returned once the input is exhausted.
+//
+// The tag which starts the following message is held in r.line for the next call.
+func (r *Reader) readFEDWireMessage() (*FEDWireMessage, base.ErrorList) {
+	var errs base.ErrorList
+	for {
+		if !r.pendingLine && !r.scanLine() {
+			break
+		}
+		r.pendingLine = false
+		if r.startsFEDWireMessage() {
+			r.pendingLine = true
+			break
+		}
+		r.trackTag()
 		if err := r.parseLine(); err != nil {
-			r.errors.Add(err)
+			errs.Add(err)
 		}
 	}
+	if !r.currentHasTags {
+		return nil, errs
+	}
 
-	r.File.AddFEDWireMessage(r.currentFEDWireMessage)
+	fwm := r.currentFEDWireMessage
 	r.currentFEDWireMessage = FEDWireMessage{}
+	r.currentHasTags = false
+	r.currentHasSenderTags = false
+	return &fwm, errs
+}
+
+// Read reads each line of the FED Wire file and defines which parser to use based
+// on the first character of each line. It also enforces FED Wire formatting rules and returns
+// the appropriate error if issues are found.
+//
+// A file may contain any number of FEDWireMessages back-to-back, optionally separated by line breaks.
+// Each {1100} or {1500} tag that can't belong to the message being read starts a new FEDWireMessage.
+func (r *Reader) Read() (File, error) {
+	r.lineNum = 0
+	// read through the entire file
+	for {
+		fwm, errs := r.readFEDWireMessage()
+		if fwm == nil {
+			break
+		}
+		for i := range errs {
+			r.errors.Add(errs[i])
+		}
+		r.File.AddFEDWireMessage(*fwm)
+	}
+	if err := r.scanner.Err(); err != nil {
+		r.errors.Add(err)
+	}
 
 	if r.errors.Empty() {
 		err := r.File.Validate()
@@ -108,6 +192,31 @@ func (r *Reader) Read() (File, error) {
 	return r.File, r.errors
 }
 
+// Next reads the next FEDWireMessage from the input, allowing files too large to hold in memory to be
+// processed one message at a time. io.EOF is returned once no messages remain.
+//
+// Each message is validated once its tags are parsed. When parsing or validation fails the message is
+// returned along with a base.ErrorList of every error found in it, and later messages can still be read
+// by calling Next again. Messages returned by Next are not added to File.
+func (r *Reader) Next() (*FEDWireMessage, error) {
+	fwm, errs := r.readFEDWireMessage()
+	if fwm == nil {
+		if err := r.scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	if errs.Empty() {
+		if err := fwm.verify(); err != nil {
+			errs.Add(err)
+		}
+	}
+	if !errs.Empty() {
+		return fwm, errs
+	}
+	return fwm, nil
+}
+
 func (r *Reader) parseLine() error { //nolint:gocyclo
 	if n := utf8.RuneCountInString(r.line); n < 6 {
 		return fmt.Errorf("line %q is too short for tag", r.line)
@@ -506,7 +615,9 @@ func (r *Reader) parsePaymentNotification() error {
 func (r *Reader) parseCharges() error {
 	r.tagName = "Charges"
 	c := new(Charges)
-	c.Parse(r.line)
+	if err := c.Parse(r.line); err != nil {
+		return r.parseError(err)
+	}
 	if err := c.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1089,7 +1200,9 @@ func (r *Reader) parseServiceMessage() error {
 func (r *Reader) parseMessageDisposition() error {
 	r.tagName = "MessageDisposition"
 	md := new(MessageDisposition)
-	md.Parse(r.line)
+	if err := md.Parse(r.line); err != nil {
+		return r.parseError(err)
+	}
 	if err := md.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1100,7 +1213,9 @@ func (r *Reader) parseMessageDisposition() error {
 func (r *Reader) parseReceiptTimeStamp() error {
 	r.tagName = "ReceiptTimeStamp"
 	rts := new(ReceiptTimeStamp)
-	rts.Parse(r.line)
+	if err := rts.Parse(r.line); err != nil {
+		return r.parseError(err)
+	}
 	if err := rts.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1111,7 +1226,9 @@ func (r *Reader) parseReceiptTimeStamp() error {
 func (r *Reader) parseOutputMessageAccountabilityData() error {
 	r.tagName = "OutputMessageAccountabilityData"
 	omad := new(OutputMessageAccountabilityData)
-	omad.Parse(r.line)
+	if err := omad.Parse(r.line); err != nil {
+		return r.parseError(err)
+	}
 	if err := omad.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1122,7 +1239,9 @@ func (r *Reader) parseOutputMessageAccountabilityData() error {
 func (r *Reader) parseErrorWire() error {
 	r.tagName = "ErrorWire"
 	ew := new(ErrorWire)
-	ew.Parse(r.line)
+	if err := ew.Parse(r.line); err != nil {
+		return r.parseError(err)
+	}
 	if err := ew.Validate(); err != nil {
 		return r.parseError(err)
 	}
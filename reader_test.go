@@ -1,12 +1,15 @@
 package wire
 
 import (
+	"io"
+	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/moov-io/base"
 	"github.com/stretchr/testify/require"
 )
 
@@ -81,3 +84,107 @@ func TestRead_missingTag(t *testing.T) {
 
 	require.EqualError(t, err, "file validation failed: FIBeneficiaryAdvice <nil> is a required field")
 }
+
+// TestRead_multipleMessages reads a file holding several FEDWireMessages, both back-to-back and on separate lines
+func TestRead_multipleMessages(t *testing.T) {
+	f, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	file, err := NewReader(f).Read()
+	require.NoError(t, err)
+	require.Len(t, file.FEDWireMessages, 4)
+
+	require.Equal(t, CustomerTransfer, file.FEDWireMessages[0].BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, BankTransfer, file.FEDWireMessages[1].BusinessFunctionCode.BusinessFunctionCode)
+	require.Nil(t, file.FEDWireMessages[1].MessageDisposition)
+	require.NotNil(t, file.FEDWireMessages[2].MessageDisposition)
+	require.NotNil(t, file.FEDWireMessages[2].SenderSupplied)
+	require.NotNil(t, file.FEDWireMessages[2].ErrorWire)
+	require.Equal(t, BFCServiceMessage, file.FEDWireMessages[3].BusinessFunctionCode.BusinessFunctionCode)
+}
+
+// TestRead_multipleMessagesInvalid ensures validation errors identify the failing message
+func TestRead_multipleMessagesInvalid(t *testing.T) {
+	ct, err := ioutil.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	missing, err := ioutil.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-MissingRequiredTag.txt"))
+	require.NoError(t, err)
+
+	_, err = NewReader(strings.NewReader(string(ct) + "\n" + string(missing))).Read()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "FEDWireMessage 1: FIBeneficiaryAdvice <nil> is a required field")
+}
+
+// TestReader_Next reads each FEDWireMessage in a file one at a time
+func TestReader_Next(t *testing.T) {
+	f, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt"))
+	require.NoError(t, err)
+	defer f.Close()
+	r := NewReader(f)
+
+	var codes []string
+	for {
+		fwm, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		codes = append(codes, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	}
+
+	require.Equal(t, []string{CustomerTransfer, BankTransfer, CustomerTransfer, BFCServiceMessage}, codes)
+	require.Empty(t, r.File.FEDWireMessages)
+
+	fwm, err := r.Next()
+	require.Nil(t, fwm)
+	require.Equal(t, io.EOF, err)
+}
+
+// TestReader_NextInvalid ensures an invalid FEDWireMessage doesn't stop the following messages from being read
+func TestReader_NextInvalid(t *testing.T) {
+	ct, err := ioutil.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	missing, err := ioutil.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-MissingRequiredTag.txt"))
+	require.NoError(t, err)
+	r := NewReader(strings.NewReader(string(missing) + "\n" + strings.Replace(string(ct), "{2000}000001234567", "{2000}00000123456A", 1) + "\n" + string(ct)))
+
+	fwm, err := r.Next()
+	require.NotNil(t, fwm)
+	require.EqualError(t, err, "FIBeneficiaryAdvice <nil> is a required field")
+
+	fwm, err = r.Next()
+	require.NotNil(t, fwm)
+	require.Error(t, err)
+	require.True(t, base.Has(err, &base.ParseError{}))
+	require.Nil(t, fwm.Amount)
+
+	fwm, err = r.Next()
+	require.NoError(t, err)
+	require.Equal(t, CustomerTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+
+	_, err = r.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+// TestRead_structuredRemittanceOptionalTags ensures the optional {8xxx} tags of a RemittanceInformationStructured
+// message may be omitted
+func TestRead_structuredRemittanceOptionalTags(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransferPlusStructuredRemittance.txt"))
+	require.NoError(t, err)
+	// remove the {8600} Adjustment and {8750} RemittanceFreeText tags
+	var tags []string
+	for _, tag := range strings.SplitAfter(string(bs), "*{") {
+		if !strings.HasPrefix(tag, "8600}") && !strings.HasPrefix(tag, "8750}") {
+			tags = append(tags, tag)
+		}
+	}
+	message := strings.TrimSuffix(strings.Join(tags, ""), "{")
+
+	file, err := NewReader(strings.NewReader(message)).Read()
+	require.NoError(t, err)
+	require.Nil(t, file.FEDWireMessages[0].Adjustment)
+	require.Nil(t, file.FEDWireMessages[0].RemittanceFreeText)
+	require.NoError(t, file.Validate())
+}
@@ -7,6 +7,10 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // ReceiptTimeStamp is the receipt time stamp of the wire
@@ -39,6 +43,9 @@ func NewReceiptTimeStamp() *ReceiptTimeStamp {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (rts *ReceiptTimeStamp) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 18 {
+		return NewTagWrongLengthErr(18, utf8.RuneCountInString(record))
+	}
 	rts.tag = record[:6]
 	rts.ReceiptDate = rts.parseStringField(record[6:10])
 	rts.ReceiptTime = rts.parseStringField(record[10:14])
@@ -74,11 +81,16 @@ func (rts *ReceiptTimeStamp) String() string {
 // Validate performs WIRE format rule checks on ReceiptTimeStamp and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (rts *ReceiptTimeStamp) Validate() error {
-	// Currently no validation as the FED is responsible for the values
+	return rts.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on ReceiptTimeStamp and returns every error found
+func (rts *ReceiptTimeStamp) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if rts.tag != TagReceiptTimeStamp {
-		return fieldError("tag", ErrValidTagForType, rts.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, rts.tag))
 	}
-	return nil
+	return errs
 }
 
 // ReceiptDateField gets a string of the ReceiptDate field
@@ -95,3 +107,24 @@ func (rts *ReceiptTimeStamp) ReceiptTimeField() string {
 func (rts *ReceiptTimeStamp) ReceiptApplicationIdentificationField() string {
 	return rts.alphaField(rts.ReceiptApplicationIdentification, 4)
 }
+
+// Timestamp returns the ReceiptDate and ReceiptTime in America/New_York. They have no year, so it is taken from
+// cycleDate, such as the InputMessageAccountabilityData CycleDate of the message.
+func (rts *ReceiptTimeStamp) Timestamp(cycleDate time.Time) (time.Time, error) {
+	t, err := parseMonthDayTime(rts.ReceiptDate, rts.ReceiptTime, cycleDate)
+	if err != nil {
+		return time.Time{}, fieldError("ReceiptDate", err, rts.ReceiptDate+rts.ReceiptTime)
+	}
+	return t, nil
+}
+
+// SetTimestamp sets the ReceiptDate and ReceiptTime to t in America/New_York
+func (rts *ReceiptTimeStamp) SetTimestamp(t time.Time) error {
+	date, hourMinute, err := formatMonthDayTime(t)
+	if err != nil {
+		return err
+	}
+	rts.ReceiptDate = date
+	rts.ReceiptTime = hourMinute
+	return nil
+}
@@ -3,6 +3,7 @@ package wire
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -56,3 +57,41 @@ func TestReceiptTimeStampTagError(t *testing.T) {
 
 	require.EqualError(t, rts.Validate(), fieldError("tag", ErrValidTagForType, rts.tag).Error())
 }
+
+// TestReceiptTimeStampTimestamp validates ReceiptDate and ReceiptTime convert to and from a time.Time
+func TestReceiptTimeStampTimestamp(t *testing.T) {
+	loc, err := FedwireLocation()
+	require.NoError(t, err)
+	rts := mockReceiptTimeStamp()
+
+	ts, err := rts.Timestamp(time.Date(2019, time.May, 2, 0, 0, 0, 0, loc))
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.May, 2, 12, 30, 0, 0, loc), ts)
+
+	// received on the evening of December 31 for the January 1 cycle date
+	rts.ReceiptDate = "1231"
+	rts.ReceiptTime = "2100"
+	ts, err = rts.Timestamp(time.Date(2021, time.January, 1, 0, 0, 0, 0, loc))
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2020, time.December, 31, 21, 0, 0, 0, loc), ts)
+
+	// received on January 1 for a December 31 cycle date
+	rts.ReceiptDate = "0101"
+	ts, err = rts.Timestamp(time.Date(2020, time.December, 31, 0, 0, 0, 0, loc))
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2021, time.January, 1, 21, 0, 0, 0, loc), ts)
+
+	// February 29 takes the nearest leap year
+	rts.ReceiptDate = "0229"
+	ts, err = rts.Timestamp(time.Date(2021, time.January, 4, 0, 0, 0, 0, loc))
+	require.NoError(t, err)
+	require.Equal(t, 2020, ts.Year())
+
+	require.NoError(t, rts.SetTimestamp(time.Date(2019, time.July, 4, 16, 45, 0, 0, time.UTC)))
+	require.Equal(t, "0704", rts.ReceiptDate)
+	require.Equal(t, "1245", rts.ReceiptTime)
+
+	rts.ReceiptDate = "1332"
+	_, err = rts.Timestamp(time.Date(2019, time.May, 2, 0, 0, 0, 0, loc))
+	require.EqualError(t, err, fieldError("ReceiptDate", ErrValidDate, "13321245").Error())
+}
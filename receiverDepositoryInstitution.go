@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // ReceiverDepositoryInstitution {3400}
@@ -37,13 +40,12 @@ func NewReceiverDepositoryInstitution() *ReceiverDepositoryInstitution {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (rdi *ReceiverDepositoryInstitution) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 15 {
+		return NewTagWrongLengthErr(15, utf8.RuneCountInString(record))
+	}
 	rdi.tag = record[:6]
 	rdi.ReceiverABANumber = rdi.parseStringField(record[6:15])
-	if delim := strings.IndexByte(record, '*'); delim > 0 {
-		rdi.ReceiverShortName = rdi.parseStringField(record[15:delim])
-	} else {
-		rdi.ReceiverShortName = rdi.parseStringField(record[15:])
-	}
+	rdi.ReceiverShortName = rdi.parseVariableStringField(record[15:])
 	return nil
 }
 
@@ -76,28 +78,32 @@ func (rdi *ReceiverDepositoryInstitution) String() string {
 // Validate performs WIRE format rule checks on ReceiverDepositoryInstitution and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (rdi *ReceiverDepositoryInstitution) Validate() error {
-	if err := rdi.fieldInclusion(); err != nil {
-		return err
-	}
+	return rdi.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on ReceiverDepositoryInstitution and returns every error found
+func (rdi *ReceiverDepositoryInstitution) validateAll() base.ErrorList {
+	errs := rdi.fieldInclusion()
 	if rdi.tag != TagReceiverDepositoryInstitution {
-		return fieldError("tag", ErrValidTagForType, rdi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, rdi.tag))
 	}
-	if err := rdi.isNumeric(rdi.ReceiverABANumber); err != nil {
-		return fieldError("ReceiverABANumber", err, rdi.ReceiverABANumber)
+	if err := rdi.isRoutingNumber(rdi.ReceiverABANumber); err != nil {
+		errs.Add(fieldError("ReceiverABANumber", err, rdi.ReceiverABANumber))
 	}
 	if err := rdi.isAlphanumeric(rdi.ReceiverShortName); err != nil {
-		return fieldError("ReceiverShortName", err, rdi.ReceiverShortName)
+		errs.Add(fieldError("ReceiverShortName", err, rdi.ReceiverShortName))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (rdi *ReceiverDepositoryInstitution) fieldInclusion() error {
+func (rdi *ReceiverDepositoryInstitution) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if rdi.ReceiverABANumber == "" {
-		return fieldError("ReceiverABANumber", ErrFieldRequired, rdi.ReceiverABANumber)
+		errs.Add(fieldError("ReceiverABANumber", ErrFieldRequired, rdi.ReceiverABANumber))
 	}
-	return nil
+	return errs
 }
 
 // ReceiverABANumberField gets a string of the ReceiverABANumber field
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // Remittance is the remittance information
@@ -35,6 +38,9 @@ func NewRemittance() *Remittance {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (ri *Remittance) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	ri.tag = record[:6]
 
 	optionalFields := strings.Split(record[6:], "*")
@@ -86,40 +92,44 @@ func (ri *Remittance) String() string {
 // Validate performs WIRE format rule checks on Remittance and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (ri *Remittance) Validate() error {
-	if err := ri.fieldInclusion(); err != nil {
-		return err
-	}
+	return ri.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on Remittance and returns every error found
+func (ri *Remittance) validateAll() base.ErrorList {
+	errs := ri.fieldInclusion()
 	if ri.tag != TagRemittance {
-		return fieldError("tag", ErrValidTagForType, ri.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ri.tag))
 	}
 	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, ri.CoverPayment.SwiftFieldTag)
+		errs.Add(fieldError("SwiftFieldTag", err, ri.CoverPayment.SwiftFieldTag))
 	}
 	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, ri.CoverPayment.SwiftLineOne)
+		errs.Add(fieldError("SwiftLineOne", err, ri.CoverPayment.SwiftLineOne))
 	}
 	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, ri.CoverPayment.SwiftLineTwo)
+		errs.Add(fieldError("SwiftLineTwo", err, ri.CoverPayment.SwiftLineTwo))
 	}
 	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, ri.CoverPayment.SwiftLineThree)
+		errs.Add(fieldError("SwiftLineThree", err, ri.CoverPayment.SwiftLineThree))
 	}
 	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, ri.CoverPayment.SwiftLineFour)
+		errs.Add(fieldError("SwiftLineFour", err, ri.CoverPayment.SwiftLineFour))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ri *Remittance) fieldInclusion() error {
+func (ri *Remittance) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if ri.CoverPayment.SwiftLineFive != "" {
-		return fieldError("SwiftLineFive", ErrInvalidProperty, ri.CoverPayment.SwiftLineFive)
+		errs.Add(fieldError("SwiftLineFive", ErrInvalidProperty, ri.CoverPayment.SwiftLineFive))
 	}
 	if ri.CoverPayment.SwiftLineSix != "" {
-		return fieldError("SwiftLineSix", ErrInvalidProperty, ri.CoverPayment.SwiftLineSix)
+		errs.Add(fieldError("SwiftLineSix", ErrInvalidProperty, ri.CoverPayment.SwiftLineSix))
 	}
-	return nil
+	return errs
 }
 
 // SwiftFieldTagField gets a string of the SwiftFieldTag field
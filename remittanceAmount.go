@@ -11,3 +11,37 @@ type RemittanceAmount struct {
 	// Amount Must contain at least one numeric character and only one decimal period marker (e.g., $1,234.56 should be entered as 1234.56). Can have up to 5 numeric characters following the decimal period marker (e.g., 1234.56789). Amount must be greater than zero (i.e., at least .01).
 	Amount string `json:"amount,omitempty"`
 }
+
+// Money returns the CurrencyCode and Amount, which uses a decimal point. Decimal places beyond the currency's
+// minor units must be zero; DecimalAmount returns an Amount with up to 5 decimal places.
+func (ra *RemittanceAmount) Money() (Money, error) {
+	return parseDecimalMoney(ra.CurrencyCode, ra.Amount, '.')
+}
+
+// SetMoney sets the CurrencyCode and Amount to m, with a decimal point
+func (ra *RemittanceAmount) SetMoney(m Money) error {
+	amount, err := formatDecimalMoney(m, '.', 19)
+	if err != nil {
+		return err
+	}
+	ra.CurrencyCode = m.Currency
+	ra.Amount = amount
+	return nil
+}
+
+// DecimalAmount returns the CurrencyCode and Amount, which uses a decimal point, keeping up to 5 decimal places
+// such as 1234.56789
+func (ra *RemittanceAmount) DecimalAmount() (DecimalAmount, error) {
+	return parseDecimalAmount(ra.CurrencyCode, ra.Amount, '.')
+}
+
+// SetDecimalAmount sets the CurrencyCode and Amount to d, with a decimal point
+func (ra *RemittanceAmount) SetDecimalAmount(d DecimalAmount) error {
+	amount, err := formatDecimalAmount(d, '.', 19)
+	if err != nil {
+		return err
+	}
+	ra.CurrencyCode = d.Currency
+	ra.Amount = amount
+	return nil
+}
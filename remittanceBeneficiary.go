@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // RemittanceBeneficiary is remittance beneficiary
@@ -43,6 +46,9 @@ func NewRemittanceBeneficiary() *RemittanceBeneficiary {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (rb *RemittanceBeneficiary) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	rb.tag = record[:6]
 
 	optionalFields := strings.Split(record[6:], "*")
@@ -174,116 +180,124 @@ func (rb *RemittanceBeneficiary) String() string {
 // * Identification Number Issuer
 //   * Not permitted unless Identification Type, Identification Code and Identification Number are present.
 //   * Not permitted for Identification Code SWBB and PICDateBirthPlace.
-// * Date & Place of Birth is only permitted for Identification Code PICDateBirthPlace.
+// * Date & Place of Birth is only permitted for Identification Code PICDateBirthPlace, and begins with a CCYYMMDD date.
 func (rb *RemittanceBeneficiary) Validate() error {
-	if err := rb.fieldInclusion(); err != nil {
-		return err
-	}
+	return rb.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on RemittanceBeneficiary and returns every error found
+func (rb *RemittanceBeneficiary) validateAll() base.ErrorList {
+	errs := rb.fieldInclusion()
 	if rb.tag != TagRemittanceBeneficiary {
-		return fieldError("tag", ErrValidTagForType, rb.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, rb.tag))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.Name); err != nil {
-		return fieldError("Name", err, rb.RemittanceData.Name)
+		errs.Add(fieldError("Name", err, rb.RemittanceData.Name))
 	}
 	if err := rb.isIdentificationType(rb.IdentificationType); err != nil {
-		return fieldError("IdentificationType", err, rb.IdentificationType)
+		errs.Add(fieldError("IdentificationType", err, rb.IdentificationType))
 	}
 	switch rb.IdentificationType {
 	case OrganizationID:
 		if err := rb.isOrganizationIdentificationCode(rb.IdentificationCode); err != nil {
-			return fieldError("IdentificationCode", err, rb.IdentificationCode)
+			errs.Add(fieldError("IdentificationCode", err, rb.IdentificationCode))
 		}
 	case PrivateID:
 		if err := rb.isPrivateIdentificationCode(rb.IdentificationCode); err != nil {
-			return fieldError("IdentificationCode", err, rb.IdentificationCode)
+			errs.Add(fieldError("IdentificationCode", err, rb.IdentificationCode))
 		}
 	}
 	if err := rb.isAlphanumeric(rb.IdentificationNumber); err != nil {
-		return fieldError("IdentificationNumber", err, rb.IdentificationNumber)
+		errs.Add(fieldError("IdentificationNumber", err, rb.IdentificationNumber))
 	}
 	if err := rb.isAlphanumeric(rb.IdentificationNumberIssuer); err != nil {
-		return fieldError("IdentificationNumberIssuer", err, rb.IdentificationNumberIssuer)
+		errs.Add(fieldError("IdentificationNumberIssuer", err, rb.IdentificationNumberIssuer))
 	}
 	if err := rb.isAddressType(rb.RemittanceData.AddressType); err != nil {
-		return fieldError("AddressType", err, rb.RemittanceData.AddressType)
+		errs.Add(fieldError("AddressType", err, rb.RemittanceData.AddressType))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.Department); err != nil {
-		return fieldError("Department", err, rb.RemittanceData.Department)
+		errs.Add(fieldError("Department", err, rb.RemittanceData.Department))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.SubDepartment); err != nil {
-		return fieldError("SubDepartment", err, rb.RemittanceData.SubDepartment)
+		errs.Add(fieldError("SubDepartment", err, rb.RemittanceData.SubDepartment))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.StreetName); err != nil {
-		return fieldError("StreetName", err, rb.RemittanceData.StreetName)
+		errs.Add(fieldError("StreetName", err, rb.RemittanceData.StreetName))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.BuildingNumber); err != nil {
-		return fieldError("BuildingNumber", err, rb.RemittanceData.BuildingNumber)
+		errs.Add(fieldError("BuildingNumber", err, rb.RemittanceData.BuildingNumber))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.PostCode); err != nil {
-		return fieldError("PostCode", err, rb.RemittanceData.PostCode)
+		errs.Add(fieldError("PostCode", err, rb.RemittanceData.PostCode))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.TownName); err != nil {
-		return fieldError("TownName", err, rb.RemittanceData.TownName)
+		errs.Add(fieldError("TownName", err, rb.RemittanceData.TownName))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.CountrySubDivisionState); err != nil {
-		return fieldError("CountrySubDivisionState", err, rb.RemittanceData.CountrySubDivisionState)
+		errs.Add(fieldError("CountrySubDivisionState", err, rb.RemittanceData.CountrySubDivisionState))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.Country); err != nil {
-		return fieldError("Country", err, rb.RemittanceData.Country)
+		errs.Add(fieldError("Country", err, rb.RemittanceData.Country))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, rb.RemittanceData.AddressLineOne)
+		errs.Add(fieldError("AddressLineOne", err, rb.RemittanceData.AddressLineOne))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, rb.RemittanceData.AddressLineTwo)
+		errs.Add(fieldError("AddressLineTwo", err, rb.RemittanceData.AddressLineTwo))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, rb.RemittanceData.AddressLineThree)
+		errs.Add(fieldError("AddressLineThree", err, rb.RemittanceData.AddressLineThree))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineFour); err != nil {
-		return fieldError("AddressLineFour", err, rb.RemittanceData.AddressLineFour)
+		errs.Add(fieldError("AddressLineFour", err, rb.RemittanceData.AddressLineFour))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineFive); err != nil {
-		return fieldError("AddressLineFive", err, rb.RemittanceData.AddressLineFive)
+		errs.Add(fieldError("AddressLineFive", err, rb.RemittanceData.AddressLineFive))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineSix); err != nil {
-		return fieldError("AddressLineSix", err, rb.RemittanceData.AddressLineSix)
+		errs.Add(fieldError("AddressLineSix", err, rb.RemittanceData.AddressLineSix))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineSeven); err != nil {
-		return fieldError("AddressLineSeven", err, rb.RemittanceData.AddressLineSeven)
+		errs.Add(fieldError("AddressLineSeven", err, rb.RemittanceData.AddressLineSeven))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.CountryOfResidence); err != nil {
-		return fieldError("CountryOfResidence", err, rb.RemittanceData.CountryOfResidence)
+		errs.Add(fieldError("CountryOfResidence", err, rb.RemittanceData.CountryOfResidence))
 	}
 
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (rb *RemittanceBeneficiary) fieldInclusion() error {
+func (rb *RemittanceBeneficiary) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if rb.RemittanceData.Name == "" {
-		return fieldError("Name", ErrFieldRequired)
+		errs.Add(fieldError("Name", ErrFieldRequired))
 	}
 
 	if rb.IdentificationCode == PICDateBirthPlace {
 		if rb.IdentificationNumber != "" {
-			return fieldError("IdentificationNumber", ErrInvalidProperty, rb.IdentificationNumber)
+			errs.Add(fieldError("IdentificationNumber", ErrInvalidProperty, rb.IdentificationNumber))
 		}
 	}
 	if rb.IdentificationNumber == "" || rb.IdentificationCode == OICSWIFTBICORBEI ||
 		rb.IdentificationCode == PICDateBirthPlace {
 		if rb.IdentificationNumberIssuer != "" {
-			return fieldError("IdentificationNumberIssuer", ErrInvalidProperty, rb.IdentificationNumberIssuer)
+			errs.Add(fieldError("IdentificationNumberIssuer", ErrInvalidProperty, rb.IdentificationNumberIssuer))
 		}
 	}
 	if rb.IdentificationCode != PICDateBirthPlace {
 		if rb.RemittanceData.DateBirthPlace != "" {
-			return fieldError("DateBirthPlace", ErrInvalidProperty, rb.RemittanceData.DateBirthPlace)
+			errs.Add(fieldError("DateBirthPlace", ErrInvalidProperty, rb.RemittanceData.DateBirthPlace))
+		}
+	} else if rb.RemittanceData.DateBirthPlace != "" {
+		if err := rb.validateDateBirthPlace(rb.RemittanceData.DateBirthPlace); err != nil {
+			errs.Add(fieldError("DateBirthPlace", err, rb.RemittanceData.DateBirthPlace))
 		}
 	}
 
-	return nil
+	return errs
 }
 
 // NameField gets a string of the Name field
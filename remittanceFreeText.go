@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // RemittanceFreeText is the remittance free text
@@ -39,6 +42,9 @@ func NewRemittanceFreeText() *RemittanceFreeText {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (rft *RemittanceFreeText) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	rft.tag = record[:6]
 
 	optionalFields := strings.Split(record[6:], "*")
@@ -82,19 +88,25 @@ func (rft *RemittanceFreeText) String() string {
 // Validate performs WIRE format rule checks on RemittanceFreeText and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (rft *RemittanceFreeText) Validate() error {
+	return rft.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on RemittanceFreeText and returns every error found
+func (rft *RemittanceFreeText) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if rft.tag != TagRemittanceFreeText {
-		return fieldError("tag", ErrValidTagForType, rft.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, rft.tag))
 	}
 	if err := rft.isAlphanumeric(rft.LineOne); err != nil {
-		return fieldError("LineOne", err, rft.LineOne)
+		errs.Add(fieldError("LineOne", err, rft.LineOne))
 	}
 	if err := rft.isAlphanumeric(rft.LineTwo); err != nil {
-		return fieldError("LineTwo", err, rft.LineTwo)
+		errs.Add(fieldError("LineTwo", err, rft.LineTwo))
 	}
 	if err := rft.isAlphanumeric(rft.LineThree); err != nil {
-		return fieldError("LineThree", err, rft.LineThree)
+		errs.Add(fieldError("LineThree", err, rft.LineThree))
 	}
-	return nil
+	return errs
 }
 
 // LineOneField gets a string of the LineOne field
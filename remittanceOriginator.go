@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // RemittanceOriginator is remittance originator
@@ -55,6 +58,9 @@ func NewRemittanceOriginator() *RemittanceOriginator {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (ro *RemittanceOriginator) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 12 {
+		return NewTagWrongLengthErr(12, utf8.RuneCountInString(record))
+	}
 	ro.tag = record[:6]
 	ro.IdentificationType = ro.parseStringField(record[6:8])
 	ro.IdentificationCode = ro.parseStringField(record[8:12])
@@ -201,136 +207,144 @@ func (ro *RemittanceOriginator) String() string {
 // * Identification Number is mandatory for all Identification Codes except PICDateBirthPlace.
 // * Identification Number is not permitted for Identification Code PICDateBirthPlace.
 // * Identification Number Issuer is not permitted for Identification Code OICSWIFTBICORBEI and PICDateBirthPlace.
-// * Date & Place of Birth is only permitted for Identification Code PICDateBirthPlace.
-func (ro *RemittanceOriginator) Validate() error { //nolint:gocyclo
-	if err := ro.fieldInclusion(); err != nil {
-		return err
-	}
+// * Date & Place of Birth is only permitted for Identification Code PICDateBirthPlace, and begins with a CCYYMMDD date.
+func (ro *RemittanceOriginator) Validate() error {
+	return ro.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on RemittanceOriginator and returns every error found
+func (ro *RemittanceOriginator) validateAll() base.ErrorList { //nolint:gocyclo
+	errs := ro.fieldInclusion()
 	if ro.tag != TagRemittanceOriginator {
-		return fieldError("tag", ErrValidTagForType, ro.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ro.tag))
 	}
 	if err := ro.isIdentificationType(ro.IdentificationType); err != nil {
-		return fieldError("IdentificationType", err, ro.IdentificationType)
+		errs.Add(fieldError("IdentificationType", err, ro.IdentificationType))
 	}
 
 	switch ro.IdentificationType {
 	case OrganizationID:
 		if err := ro.isOrganizationIdentificationCode(ro.IdentificationCode); err != nil {
-			return fieldError("IdentificationCode", err, ro.IdentificationCode)
+			errs.Add(fieldError("IdentificationCode", err, ro.IdentificationCode))
 		}
 
 	case PrivateID:
 		if err := ro.isPrivateIdentificationCode(ro.IdentificationCode); err != nil {
-			return fieldError("IdentificationCode", err, ro.IdentificationCode)
+			errs.Add(fieldError("IdentificationCode", err, ro.IdentificationCode))
 		}
 	}
 
 	if err := ro.isAlphanumeric(ro.IdentificationNumber); err != nil {
-		return fieldError("IdentificationNumber", err, ro.IdentificationNumber)
+		errs.Add(fieldError("IdentificationNumber", err, ro.IdentificationNumber))
 	}
 	if err := ro.isAlphanumeric(ro.IdentificationNumberIssuer); err != nil {
-		return fieldError("IdentificationNumberIssuer", err, ro.IdentificationNumberIssuer)
+		errs.Add(fieldError("IdentificationNumberIssuer", err, ro.IdentificationNumberIssuer))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.Name); err != nil {
-		return fieldError("Name", err, ro.RemittanceData.Name)
+		errs.Add(fieldError("Name", err, ro.RemittanceData.Name))
 	}
 	if err := ro.isAddressType(ro.RemittanceData.AddressType); err != nil {
-		return fieldError("AddressType", err, ro.RemittanceData.AddressType)
+		errs.Add(fieldError("AddressType", err, ro.RemittanceData.AddressType))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.Department); err != nil {
-		return fieldError("Department", err, ro.RemittanceData.Department)
+		errs.Add(fieldError("Department", err, ro.RemittanceData.Department))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.SubDepartment); err != nil {
-		return fieldError("SubDepartment", err, ro.RemittanceData.SubDepartment)
+		errs.Add(fieldError("SubDepartment", err, ro.RemittanceData.SubDepartment))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.StreetName); err != nil {
-		return fieldError("StreetName", err, ro.RemittanceData.StreetName)
+		errs.Add(fieldError("StreetName", err, ro.RemittanceData.StreetName))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.BuildingNumber); err != nil {
-		return fieldError("BuildingNumber", err, ro.RemittanceData.BuildingNumber)
+		errs.Add(fieldError("BuildingNumber", err, ro.RemittanceData.BuildingNumber))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.PostCode); err != nil {
-		return fieldError("PostCode", err, ro.RemittanceData.PostCode)
+		errs.Add(fieldError("PostCode", err, ro.RemittanceData.PostCode))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.TownName); err != nil {
-		return fieldError("TownName", err, ro.RemittanceData.TownName)
+		errs.Add(fieldError("TownName", err, ro.RemittanceData.TownName))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.CountrySubDivisionState); err != nil {
-		return fieldError("CountrySubDivisionState", err, ro.RemittanceData.CountrySubDivisionState)
+		errs.Add(fieldError("CountrySubDivisionState", err, ro.RemittanceData.CountrySubDivisionState))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.Country); err != nil {
-		return fieldError("Country", err, ro.RemittanceData.Country)
+		errs.Add(fieldError("Country", err, ro.RemittanceData.Country))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, ro.RemittanceData.AddressLineOne)
+		errs.Add(fieldError("AddressLineOne", err, ro.RemittanceData.AddressLineOne))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, ro.RemittanceData.AddressLineTwo)
+		errs.Add(fieldError("AddressLineTwo", err, ro.RemittanceData.AddressLineTwo))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, ro.RemittanceData.AddressLineThree)
+		errs.Add(fieldError("AddressLineThree", err, ro.RemittanceData.AddressLineThree))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineFour); err != nil {
-		return fieldError("AddressLineFour", err, ro.RemittanceData.AddressLineFour)
+		errs.Add(fieldError("AddressLineFour", err, ro.RemittanceData.AddressLineFour))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineFive); err != nil {
-		return fieldError("AddressLineFive", err, ro.RemittanceData.AddressLineFive)
+		errs.Add(fieldError("AddressLineFive", err, ro.RemittanceData.AddressLineFive))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineSix); err != nil {
-		return fieldError("AddressLineSix", err, ro.RemittanceData.AddressLineSix)
+		errs.Add(fieldError("AddressLineSix", err, ro.RemittanceData.AddressLineSix))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineSeven); err != nil {
-		return fieldError("AddressLineSeven", err, ro.RemittanceData.AddressLineSeven)
+		errs.Add(fieldError("AddressLineSeven", err, ro.RemittanceData.AddressLineSeven))
 	}
 
 	if err := ro.isAlphanumeric(ro.RemittanceData.CountryOfResidence); err != nil {
-		return fieldError("CountryOfResidence", err, ro.RemittanceData.CountryOfResidence)
+		errs.Add(fieldError("CountryOfResidence", err, ro.RemittanceData.CountryOfResidence))
 	}
 	if err := ro.isAlphanumeric(ro.ContactName); err != nil {
-		return fieldError("ContactName", err, ro.ContactName)
+		errs.Add(fieldError("ContactName", err, ro.ContactName))
 	}
 	if err := ro.isAlphanumeric(ro.ContactPhoneNumber); err != nil {
-		return fieldError("ContactPhoneNumber", err, ro.ContactPhoneNumber)
+		errs.Add(fieldError("ContactPhoneNumber", err, ro.ContactPhoneNumber))
 	}
 	if err := ro.isAlphanumeric(ro.ContactMobileNumber); err != nil {
-		return fieldError("ContactMobileNumber", err, ro.ContactMobileNumber)
+		errs.Add(fieldError("ContactMobileNumber", err, ro.ContactMobileNumber))
 	}
 	if err := ro.isAlphanumeric(ro.ContactFaxNumber); err != nil {
-		return fieldError("ContactFaxNumber", err, ro.ContactFaxNumber)
+		errs.Add(fieldError("ContactFaxNumber", err, ro.ContactFaxNumber))
 	}
 	if err := ro.isAlphanumeric(ro.ContactElectronicAddress); err != nil {
-		return fieldError("ContactElectronicAddress", err, ro.ContactElectronicAddress)
+		errs.Add(fieldError("ContactElectronicAddress", err, ro.ContactElectronicAddress))
 	}
 	if err := ro.isAlphanumeric(ro.ContactOther); err != nil {
-		return fieldError("ContactOther", err, ro.ContactOther)
+		errs.Add(fieldError("ContactOther", err, ro.ContactOther))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ro *RemittanceOriginator) fieldInclusion() error {
+func (ro *RemittanceOriginator) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if ro.RemittanceData.Name == "" {
-		return fieldError("Name", ErrFieldRequired)
+		errs.Add(fieldError("Name", ErrFieldRequired))
 	}
 	if ro.IdentificationCode == PICDateBirthPlace {
 		if ro.IdentificationNumber != "" {
-			return fieldError("IdentificationNumber", ErrInvalidProperty, ro.IdentificationNumber)
+			errs.Add(fieldError("IdentificationNumber", ErrInvalidProperty, ro.IdentificationNumber))
 		}
 	}
 
 	if ro.IdentificationNumber == "" || ro.IdentificationCode == OICSWIFTBICORBEI ||
 		ro.IdentificationCode == PICDateBirthPlace {
 		if ro.IdentificationNumberIssuer != "" {
-			return fieldError("IdentificationNumberIssuer", ErrInvalidProperty, ro.IdentificationNumberIssuer)
+			errs.Add(fieldError("IdentificationNumberIssuer", ErrInvalidProperty, ro.IdentificationNumberIssuer))
 		}
 	}
 	if ro.IdentificationCode != PICDateBirthPlace {
 		if ro.RemittanceData.DateBirthPlace != "" {
-			return fieldError("DateBirthPlace", ErrInvalidProperty, ro.RemittanceData.DateBirthPlace)
+			errs.Add(fieldError("DateBirthPlace", ErrInvalidProperty, ro.RemittanceData.DateBirthPlace))
+		}
+	} else if ro.RemittanceData.DateBirthPlace != "" {
+		if err := ro.validateDateBirthPlace(ro.RemittanceData.DateBirthPlace); err != nil {
+			errs.Add(fieldError("DateBirthPlace", err, ro.RemittanceData.DateBirthPlace))
 		}
 	}
-	return nil
+	return errs
 }
 
 // IdentificationTypeField gets a string of the IdentificationType field
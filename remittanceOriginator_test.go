@@ -408,6 +408,24 @@ func TestRemittanceOriginatorDateBirthPlaceInvalid(t *testing.T) {
 	require.EqualError(t, err, fieldError("DateBirthPlace", ErrInvalidProperty, ro.RemittanceData.DateBirthPlace).Error())
 }
 
+// TestRemittanceOriginatorDateOfBirth validates the date of birth of a RemittanceOriginator DateBirthPlace
+func TestRemittanceOriginatorDateOfBirth(t *testing.T) {
+	ro := mockRemittanceOriginator()
+	ro.IdentificationType = PrivateID
+	ro.IdentificationCode = PICDateBirthPlace
+	ro.IdentificationNumber = ""
+	ro.IdentificationNumberIssuer = ""
+	ro.RemittanceData.DateBirthPlace = "19720229 Pottstown US"
+
+	require.NoError(t, ro.Validate())
+
+	ro.RemittanceData.DateBirthPlace = "19730229 Pottstown US"
+
+	err := ro.Validate()
+
+	require.EqualError(t, err, fieldError("DateBirthPlace", ErrValidDate, ro.RemittanceData.DateBirthPlace).Error())
+}
+
 // TestParseRemittanceOriginatorReaderParseError parses a wrong RemittanceOriginator reader parse error
 func TestParseRemittanceOriginatorReaderParseError(t *testing.T) {
 	var line = "{8300}OICUSTName*111111*Bank**ADDR*Department*Sub-Department*Street Name*16*19405*AnyTown*PA*UA*®ddress Line One*"
@@ -0,0 +1,185 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+)
+
+// ReversalOpts are the fields of a reversal or request for reversal which are not taken from the original message
+type ReversalOpts struct {
+	// InputMessageAccountabilityData is the IMAD of the new message. An InputCycleDate other than the original's
+	// makes it a reversal of a prior day transfer.
+	InputMessageAccountabilityData *InputMessageAccountabilityData `json:"inputMessageAccountabilityData"`
+	// SenderReference, when not empty, is the SenderReference of the new message
+	SenderReference string `json:"senderReference,omitempty"`
+}
+
+// NewReversalOf returns a value reversal of original, with SubTypeCode ReversalTransfer or
+// ReversalPriorDayTransfer. A reversal is sent by the receiver of the original message, so the depository
+// institutions, Originator and Beneficiary, and OriginatorFI and BeneficiaryFI are swapped. Intermediary and
+// other tags of the original message are not carried over.
+func NewReversalOf(original FEDWireMessage, opts ReversalOpts) (FEDWireMessage, error) {
+	fwm, err := newReversal(original, opts, ReversalTransfer, ReversalPriorDayTransfer, "")
+	if err != nil {
+		return FEDWireMessage{}, err
+	}
+	fwm.SenderDepositoryInstitution = NewSenderDepositoryInstitution()
+	fwm.SenderDepositoryInstitution.SenderABANumber = original.ReceiverDepositoryInstitution.ReceiverABANumber
+	fwm.SenderDepositoryInstitution.SenderShortName = original.ReceiverDepositoryInstitution.ReceiverShortName
+	fwm.ReceiverDepositoryInstitution = NewReceiverDepositoryInstitution()
+	fwm.ReceiverDepositoryInstitution.ReceiverABANumber = original.SenderDepositoryInstitution.SenderABANumber
+	fwm.ReceiverDepositoryInstitution.ReceiverShortName = original.SenderDepositoryInstitution.SenderShortName
+
+	if original.Beneficiary != nil {
+		fwm.Originator = NewOriginator()
+		fwm.Originator.Personal = original.Beneficiary.Personal
+	}
+	switch {
+	case original.Originator != nil:
+		fwm.Beneficiary = NewBeneficiary()
+		fwm.Beneficiary.Personal = original.Originator.Personal
+	case original.OriginatorOptionF != nil:
+		ben, err := reversalBeneficiary(original.OriginatorOptionF)
+		if err != nil {
+			return FEDWireMessage{}, err
+		}
+		fwm.Beneficiary = ben
+	}
+	if original.BeneficiaryFI != nil {
+		fwm.OriginatorFI = NewOriginatorFI()
+		fwm.OriginatorFI.FinancialInstitution = original.BeneficiaryFI.FinancialInstitution
+	}
+	if original.OriginatorFI != nil {
+		fwm.BeneficiaryFI = NewBeneficiaryFI()
+		fwm.BeneficiaryFI.FinancialInstitution = original.OriginatorFI.FinancialInstitution
+	}
+	return fwm, nil
+}
+
+// NewRequestForReversalOf returns a non-value request for reversal of original, with SubTypeCode RequestReversal or
+// RequestReversalPriorDayTransfer. A request for reversal is sent by the sender of the original message, so the
+// depository institutions and parties keep their direction. The BusinessFunctionCode is kept when it permits a
+// request for reversal, as CustomerTransferPlus does, and is otherwise BFCServiceMessage.
+func NewRequestForReversalOf(original FEDWireMessage, opts ReversalOpts) (FEDWireMessage, error) {
+	fwm, err := newReversal(original, opts, RequestReversal, RequestReversalPriorDayTransfer, BFCServiceMessage)
+	if err != nil {
+		return FEDWireMessage{}, err
+	}
+	sdi := *original.SenderDepositoryInstitution
+	fwm.SenderDepositoryInstitution = &sdi
+	rdi := *original.ReceiverDepositoryInstitution
+	fwm.ReceiverDepositoryInstitution = &rdi
+
+	if original.Beneficiary != nil {
+		ben := *original.Beneficiary
+		fwm.Beneficiary = &ben
+	}
+	if original.Originator != nil {
+		o := *original.Originator
+		fwm.Originator = &o
+	}
+	if original.OriginatorOptionF != nil && fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus {
+		oof := *original.OriginatorOptionF
+		fwm.OriginatorOptionF = &oof
+	}
+	if original.BeneficiaryFI != nil {
+		bfi := *original.BeneficiaryFI
+		fwm.BeneficiaryFI = &bfi
+	}
+	if original.OriginatorFI != nil {
+		ofi := *original.OriginatorFI
+		fwm.OriginatorFI = &ofi
+	}
+	return fwm, nil
+}
+
+// respondedTags are the tags of a message read to reverse or respond to it
+var respondedTags = []string{
+	TagTypeSubType,
+	TagInputMessageAccountabilityData,
+	TagAmount,
+	TagSenderDepositoryInstitution,
+	TagReceiverDepositoryInstitution,
+	TagBusinessFunctionCode,
+}
+
+// newReversal returns the tags shared by a reversal and a request for reversal of original, with subTypeCode or
+// priorDaySubTypeCode when the InputCycleDate of opts differs from the original's. The BusinessFunctionCode of
+// original is kept when it permits the TypeSubType, and is otherwise fallback when not empty and original is a
+// reversible transfer of value.
+func newReversal(original FEDWireMessage, opts ReversalOpts, subTypeCode, priorDaySubTypeCode, fallback string) (FEDWireMessage, error) {
+	if err := original.requireTags(respondedTags...); err != nil {
+		return FEDWireMessage{}, err
+	}
+	if opts.InputMessageAccountabilityData == nil {
+		return FEDWireMessage{}, fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+	if original.InputMessageAccountabilityData.InputCycleDate != opts.InputMessageAccountabilityData.InputCycleDate {
+		subTypeCode = priorDaySubTypeCode
+	}
+	typeSubType := original.TypeSubType.TypeCode + subTypeCode
+	bfc := *original.BusinessFunctionCode
+	rule, ok := original.businessFunctionCodeRule()
+	if !ok || !associatedTypeSubTypes(rule.TypeSubTypes).Contains(typeSubType) {
+		// only a transfer of value may be reversed
+		reversible := ok && associatedTypeSubTypes(rule.TypeSubTypes).Contains(original.TypeSubType.TypeCode+ReversalTransfer)
+		rule, ok = businessFunctionCodeRule(fallback)
+		if !reversible || !ok || !associatedTypeSubTypes(rule.TypeSubTypes).Contains(typeSubType) {
+			return FEDWireMessage{}, fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType",
+				typeSubType, bfc.BusinessFunctionCode))
+		}
+		bfc.BusinessFunctionCode = fallback
+		bfc.TransactionTypeCode = ""
+	}
+
+	fwm := FEDWireMessage{}
+	// a received original may have a MessageDisposition in place of SenderSupplied
+	fwm.SenderSupplied = NewSenderSupplied()
+	if original.SenderSupplied != nil {
+		ss := *original.SenderSupplied
+		ss.MessageDuplicationCode = MessageDuplicationOriginal
+		fwm.SenderSupplied = &ss
+	}
+	fwm.TypeSubType = NewTypeSubType()
+	fwm.TypeSubType.TypeCode = original.TypeSubType.TypeCode
+	fwm.TypeSubType.SubTypeCode = subTypeCode
+	imad := *opts.InputMessageAccountabilityData
+	fwm.InputMessageAccountabilityData = &imad
+	amt := *original.Amount
+	fwm.Amount = &amt
+	fwm.BusinessFunctionCode = &bfc
+	if opts.SenderReference != "" {
+		fwm.SenderReference = NewSenderReference()
+		fwm.SenderReference.SenderReference = opts.SenderReference
+	}
+	fwm.PreviousMessageIdentifier = NewPreviousMessageIdentifier()
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = original.InputMessageAccountabilityData.IMAD()
+	return fwm, nil
+}
+
+// reversalBeneficiary returns the Beneficiary of a reversal of a message with OriginatorOptionF oof, which must
+// identify the originator by account number. Name and address lines are carried over without their line codes.
+func reversalBeneficiary(oof *OriginatorOptionF) (*Beneficiary, error) {
+	if !strings.HasPrefix(oof.PartyIdentifier, "/") {
+		return nil, fieldError("OriginatorOptionF.PartyIdentifier", ErrReversalBeneficiary, oof.PartyIdentifier)
+	}
+	ben := NewBeneficiary()
+	ben.Personal.IdentificationCode = DemandDepositAccountNumber
+	ben.Personal.Identifier = strings.TrimPrefix(oof.PartyIdentifier, "/")
+	ben.Personal.Name = optionFLine(oof.Name)
+	ben.Personal.Address.AddressLineOne = optionFLine(oof.LineOne)
+	ben.Personal.Address.AddressLineTwo = optionFLine(oof.LineTwo)
+	ben.Personal.Address.AddressLineThree = optionFLine(oof.LineThree)
+	return ben, nil
+}
+
+// optionFLine returns an OriginatorOptionF line without its line code, such as SMITH JOHN for 1/SMITH JOHN
+func optionFLine(line string) string {
+	if i := strings.Index(line, "/"); i >= 0 {
+		return line[i+1:]
+	}
+	return line
+}
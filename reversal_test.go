@@ -0,0 +1,159 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+	"time"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+// mockReversalOriginal creates a CustomerTransfer to reverse
+func mockReversalOriginal() FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.ReceiverDepositoryInstitution.ReceiverABANumber = "231380104"
+	fwm.ReceiverDepositoryInstitution.ReceiverShortName = "Citadel"
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.Originator.Personal.Name = "Originator Name"
+	fwm.OriginatorFI = mockOriginatorFI()
+	fwm.BeneficiaryFI = mockBeneficiaryFI()
+	fwm.BeneficiaryFI.FinancialInstitution.Name = "Beneficiary FI Name"
+	return fwm
+}
+
+func TestNewReversalOf(t *testing.T) {
+	original := mockReversalOriginal()
+	opts := ReversalOpts{
+		InputMessageAccountabilityData: mockInputMessageAccountabilityData(),
+		SenderReference:                "Reversal",
+	}
+	opts.InputMessageAccountabilityData.InputSequenceNumber = "000002"
+
+	fwm, err := NewReversalOf(original, opts)
+	require.NoError(t, err)
+	require.NoError(t, fwm.verify())
+
+	require.Equal(t, ReversalTransfer, fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, original.TypeSubType.TypeCode, fwm.TypeSubType.TypeCode)
+	require.Equal(t, original.InputMessageAccountabilityData.IMAD(), fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, "000002", fwm.InputMessageAccountabilityData.InputSequenceNumber)
+	require.Equal(t, original.Amount.Amount, fwm.Amount.Amount)
+	require.Equal(t, CustomerTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "Reversal", fwm.SenderReference.SenderReference)
+
+	require.Equal(t, original.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, original.ReceiverDepositoryInstitution.ReceiverShortName, fwm.SenderDepositoryInstitution.SenderShortName)
+	require.Equal(t, original.SenderDepositoryInstitution.SenderABANumber, fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	require.Equal(t, original.SenderDepositoryInstitution.SenderShortName, fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+	require.Equal(t, original.Beneficiary.Personal, fwm.Originator.Personal)
+	require.Equal(t, original.Originator.Personal, fwm.Beneficiary.Personal)
+	require.Equal(t, original.BeneficiaryFI.FinancialInstitution, fwm.OriginatorFI.FinancialInstitution)
+	require.Equal(t, original.OriginatorFI.FinancialInstitution, fwm.BeneficiaryFI.FinancialInstitution)
+
+	// the original is unchanged
+	fwm.Amount.Amount = "000000000001"
+	require.NotEqual(t, fwm.Amount.Amount, original.Amount.Amount)
+}
+
+func TestNewReversalOf_priorDay(t *testing.T) {
+	original := mockReversalOriginal()
+	original.InputMessageAccountabilityData.InputCycleDate = time.Now().AddDate(0, 0, -1).Format("20060102")
+
+	fwm, err := NewReversalOf(original, ReversalOpts{InputMessageAccountabilityData: mockInputMessageAccountabilityData()})
+	require.NoError(t, err)
+	require.NoError(t, fwm.verify())
+	require.Equal(t, ReversalPriorDayTransfer, fwm.TypeSubType.SubTypeCode)
+}
+
+func TestNewReversalOf_originatorOptionF(t *testing.T) {
+	original := mockReversalOriginal()
+	original.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	original.Originator = nil
+	original.OriginatorOptionF = mockOriginatorOptionF()
+	original.OriginatorOptionF.PartyIdentifier = "/123456"
+	opts := ReversalOpts{InputMessageAccountabilityData: mockInputMessageAccountabilityData()}
+
+	fwm, err := NewReversalOf(original, opts)
+	require.NoError(t, err)
+	require.NoError(t, fwm.verify())
+	require.Nil(t, fwm.OriginatorOptionF)
+	require.Equal(t, DemandDepositAccountNumber, fwm.Beneficiary.Personal.IdentificationCode)
+	require.Equal(t, "123456", fwm.Beneficiary.Personal.Identifier)
+	require.Equal(t, "Name", fwm.Beneficiary.Personal.Name)
+	require.Equal(t, "1000 Colonial Farm Rd", fwm.Beneficiary.Personal.Address.AddressLineTwo)
+
+	original.OriginatorOptionF.PartyIdentifier = "TXID/123-45-6789"
+	_, err = NewReversalOf(original, opts)
+	require.EqualError(t, err, fieldError("OriginatorOptionF.PartyIdentifier", ErrReversalBeneficiary, "TXID/123-45-6789").Error())
+}
+
+func TestNewRequestForReversalOf(t *testing.T) {
+	original := mockReversalOriginal()
+	original.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	opts := ReversalOpts{InputMessageAccountabilityData: mockInputMessageAccountabilityData()}
+
+	fwm, err := NewRequestForReversalOf(original, opts)
+	require.NoError(t, err)
+	require.NoError(t, fwm.verify())
+	require.Equal(t, RequestReversal, fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, original.InputMessageAccountabilityData.IMAD(), fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, *original.SenderDepositoryInstitution, *fwm.SenderDepositoryInstitution)
+	require.Equal(t, *original.ReceiverDepositoryInstitution, *fwm.ReceiverDepositoryInstitution)
+	require.Equal(t, *original.Originator, *fwm.Originator)
+	require.Equal(t, *original.Beneficiary, *fwm.Beneficiary)
+
+	// a request for reversal of a CustomerTransfer is a service message
+	original.BusinessFunctionCode.BusinessFunctionCode = CustomerTransfer
+	fwm, err = NewRequestForReversalOf(original, opts)
+	require.NoError(t, err)
+	require.NoError(t, fwm.verify())
+	require.Equal(t, BFCServiceMessage, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, *original.Originator, *fwm.Originator)
+}
+
+func TestNewReversalOf_invalid(t *testing.T) {
+	original := mockReversalOriginal()
+
+	_, err := NewReversalOf(original, ReversalOpts{})
+	require.EqualError(t, err, fieldError("InputMessageAccountabilityData", ErrFieldRequired).Error())
+
+	opts := ReversalOpts{InputMessageAccountabilityData: mockInputMessageAccountabilityData()}
+	drawdown := original
+	drawdown.TypeSubType = mockTypeSubType()
+	drawdown.TypeSubType.TypeCode = SettlementTransfer
+	drawdown.TypeSubType.SubTypeCode = RequestCredit
+	drawdown.BusinessFunctionCode = mockBusinessFunctionCode()
+	drawdown.BusinessFunctionCode.BusinessFunctionCode = BankDrawDownRequest
+	drawdown.AccountDebitedDrawdown = mockAccountDebitedDrawdown()
+	drawdown.AccountCreditedDrawdown = mockAccountCreditedDrawdown()
+	_, err = NewReversalOf(drawdown, opts)
+	require.True(t, base.Match(err, ErrBusinessFunctionCodeProperty{}))
+	_, err = NewRequestForReversalOf(drawdown, opts)
+	require.True(t, base.Match(err, ErrBusinessFunctionCodeProperty{}))
+
+	original.InputMessageAccountabilityData = nil
+	_, err = NewReversalOf(original, opts)
+	require.EqualError(t, err, fieldError("InputMessageAccountabilityData", ErrFieldRequired).Error())
+}
+
+// TestNewReversalOf_partialOriginal ensures only the tags read from the original are required
+func TestNewReversalOf_partialOriginal(t *testing.T) {
+	original := mockReversalOriginal()
+	original.SenderSupplied = nil
+	original.Beneficiary = nil
+	opts := ReversalOpts{InputMessageAccountabilityData: mockInputMessageAccountabilityData()}
+
+	fwm, err := NewReversalOf(original, opts)
+	require.NoError(t, err)
+	require.NotNil(t, fwm.SenderSupplied)
+	require.Nil(t, fwm.Originator)
+
+	original.ReceiverDepositoryInstitution = nil
+	_, err = NewReversalOf(original, opts)
+	require.EqualError(t, err, fieldError("ReceiverDepositoryInstitution", ErrFieldRequired).Error())
+}
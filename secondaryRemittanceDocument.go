@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // SecondaryRemittanceDocument is the date of remittance document
@@ -41,6 +44,9 @@ func NewSecondaryRemittanceDocument() *SecondaryRemittanceDocument {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (srd *SecondaryRemittanceDocument) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 10 {
+		return NewTagWrongLengthErr(10, utf8.RuneCountInString(record))
+	}
 	srd.tag = record[:6]
 	srd.DocumentTypeCode = srd.parseStringField(record[6:10])
 
@@ -88,44 +94,48 @@ func (srd *SecondaryRemittanceDocument) String() string {
 // * Document Type Code and Document Identification Number are mandatory.
 // * Proprietary Document Type Code is mandatory for Document Type Code PROP; otherwise not permitted.
 func (srd *SecondaryRemittanceDocument) Validate() error {
-	if err := srd.fieldInclusion(); err != nil {
-		return err
-	}
+	return srd.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on SecondaryRemittanceDocument and returns every error found
+func (srd *SecondaryRemittanceDocument) validateAll() base.ErrorList {
+	errs := srd.fieldInclusion()
 	if srd.tag != TagSecondaryRemittanceDocument {
-		return fieldError("tag", ErrValidTagForType, srd.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, srd.tag))
 	}
 	if err := srd.isDocumentTypeCode(srd.DocumentTypeCode); err != nil {
-		return fieldError("DocumentTypeCode", err, srd.DocumentTypeCode)
+		errs.Add(fieldError("DocumentTypeCode", err, srd.DocumentTypeCode))
 	}
 	if err := srd.isAlphanumeric(srd.ProprietaryDocumentTypeCode); err != nil {
-		return fieldError("ProprietaryDocumentTypeCode", err, srd.ProprietaryDocumentTypeCode)
+		errs.Add(fieldError("ProprietaryDocumentTypeCode", err, srd.ProprietaryDocumentTypeCode))
 	}
 	if err := srd.isAlphanumeric(srd.DocumentIdentificationNumber); err != nil {
-		return fieldError("DocumentIdentificationNumber", err, srd.DocumentIdentificationNumber)
+		errs.Add(fieldError("DocumentIdentificationNumber", err, srd.DocumentIdentificationNumber))
 	}
 	if err := srd.isAlphanumeric(srd.Issuer); err != nil {
-		return fieldError("Issuer", err, srd.Issuer)
+		errs.Add(fieldError("Issuer", err, srd.Issuer))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (srd *SecondaryRemittanceDocument) fieldInclusion() error {
+func (srd *SecondaryRemittanceDocument) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if srd.DocumentIdentificationNumber == "" {
-		return fieldError("DocumentIdentificationNumber", ErrFieldRequired)
+		errs.Add(fieldError("DocumentIdentificationNumber", ErrFieldRequired))
 	}
 	switch srd.DocumentTypeCode {
 	case ProprietaryDocumentType:
 		if srd.ProprietaryDocumentTypeCode == "" {
-			return fieldError("ProprietaryDocumentTypeCode", ErrFieldRequired)
+			errs.Add(fieldError("ProprietaryDocumentTypeCode", ErrFieldRequired))
 		}
 	default:
 		if srd.ProprietaryDocumentTypeCode != "" {
-			return fieldError("ProprietaryDocumentTypeCode", ErrInvalidProperty, srd.ProprietaryDocumentTypeCode)
+			errs.Add(fieldError("ProprietaryDocumentTypeCode", ErrInvalidProperty, srd.ProprietaryDocumentTypeCode))
 		}
 	}
-	return nil
+	return errs
 }
 
 // DocumentTypeCodeField gets a string of the DocumentTypeCode field
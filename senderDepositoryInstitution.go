@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // SenderDepositoryInstitution {3100}
@@ -37,13 +40,12 @@ func NewSenderDepositoryInstitution() *SenderDepositoryInstitution {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (sdi *SenderDepositoryInstitution) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 15 {
+		return NewTagWrongLengthErr(15, utf8.RuneCountInString(record))
+	}
 	sdi.tag = record[:6]
 	sdi.SenderABANumber = sdi.parseStringField(record[6:15])
-	if delim := strings.IndexByte(record, '*'); delim > 0 {
-		sdi.SenderShortName = sdi.parseStringField(record[15:delim])
-	} else {
-		sdi.SenderShortName = sdi.parseStringField(record[15:])
-	}
+	sdi.SenderShortName = sdi.parseVariableStringField(record[15:])
 	return nil
 }
 
@@ -76,28 +78,32 @@ func (sdi *SenderDepositoryInstitution) String() string {
 // Validate performs WIRE format rule checks on SenderDepositoryInstitution and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (sdi *SenderDepositoryInstitution) Validate() error {
-	if err := sdi.fieldInclusion(); err != nil {
-		return err
-	}
+	return sdi.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on SenderDepositoryInstitution and returns every error found
+func (sdi *SenderDepositoryInstitution) validateAll() base.ErrorList {
+	errs := sdi.fieldInclusion()
 	if sdi.tag != TagSenderDepositoryInstitution {
-		return fieldError("tag", ErrValidTagForType, sdi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, sdi.tag))
 	}
-	if err := sdi.isNumeric(sdi.SenderABANumber); err != nil {
-		return fieldError("SenderABANumber", err, sdi.SenderABANumber)
+	if err := sdi.isRoutingNumber(sdi.SenderABANumber); err != nil {
+		errs.Add(fieldError("SenderABANumber", err, sdi.SenderABANumber))
 	}
 	if err := sdi.isAlphanumeric(sdi.SenderShortName); err != nil {
-		return fieldError("SenderShortName", err, sdi.SenderShortName)
+		errs.Add(fieldError("SenderShortName", err, sdi.SenderShortName))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (sdi *SenderDepositoryInstitution) fieldInclusion() error {
+func (sdi *SenderDepositoryInstitution) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if sdi.SenderABANumber == "" {
-		return fieldError("SenderABANumber", ErrFieldRequired, sdi.SenderABANumber)
+		errs.Add(fieldError("SenderABANumber", ErrFieldRequired, sdi.SenderABANumber))
 	}
-	return nil
+	return errs
 }
 
 // SenderABANumberField gets a string of the SenderABANumber field
@@ -3,6 +3,7 @@ package wire
 import (
 	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/moov-io/base"
 	"github.com/stretchr/testify/require"
@@ -35,6 +36,16 @@ func TestSenderABANumberAlphaNumeric(t *testing.T) {
 	}
 }
 
+// TestSenderABANumberCheckDigit validates SenderDepositoryInstitution SenderABANumber has a valid check digit
+func TestSenderABANumberCheckDigit(t *testing.T) {
+	sdi := mockSenderDepositoryInstitution()
+	sdi.SenderABANumber = "121042883"
+
+	err := sdi.Validate()
+
+	require.EqualError(t, err, fieldError("SenderABANumber", ErrRoutingNumberCheckDigit, sdi.SenderABANumber).Error())
+}
+
 // TestSenderShortNameAlphaNumeric validates SenderDepositoryInstitution SenderShortName is alphanumeric
 func TestSenderShortNameAlphaNumeric(t *testing.T) {
 	rdi := mockSenderDepositoryInstitution()
@@ -77,3 +88,18 @@ func TestSenderDepositoryInstitutionTagError(t *testing.T) {
 
 	require.EqualError(t, sdi.Validate(), fieldError("tag", ErrValidTagForType, sdi.tag).Error())
 }
+
+// TestParseSenderDepositoryInstitutionWrongLength parses a truncated SenderDepositoryInstitution
+func TestParseSenderDepositoryInstitutionWrongLength(t *testing.T) {
+	var line = "{3100}1210"
+	r := NewReader(strings.NewReader(line))
+	r.line = line
+
+	err := r.parseSenderDepositoryInstitution()
+
+	require.EqualError(t, err, r.parseError(NewTagWrongLengthErr(15, utf8.RuneCountInString(r.line))).Error())
+
+	_, err = r.Read()
+
+	require.EqualError(t, err, r.parseError(NewTagWrongLengthErr(15, utf8.RuneCountInString(r.line))).Error())
+}
@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // SenderReference is the SenderReference of the wire
@@ -35,12 +38,11 @@ func NewSenderReference() *SenderReference {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (sr *SenderReference) Parse(record string) error {
-	sr.tag = record[:6]
-	if delim := strings.IndexByte(record, '*'); delim > 0 {
-		sr.SenderReference = sr.parseStringField(record[6:delim])
-	} else {
-		sr.SenderReference = sr.parseStringField(record[6:])
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
 	}
+	sr.tag = record[:6]
+	sr.SenderReference = sr.parseVariableStringField(record[6:])
 	return nil
 }
 
@@ -70,13 +72,19 @@ func (sr *SenderReference) String() string {
 // Validate performs WIRE format rule checks on SenderReference and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (sr *SenderReference) Validate() error {
+	return sr.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on SenderReference and returns every error found
+func (sr *SenderReference) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if sr.tag != TagSenderReference {
-		return fieldError("tag", ErrValidTagForType, sr.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, sr.tag))
 	}
 	if err := sr.isAlphanumeric(sr.SenderReference); err != nil {
-		return fieldError("SenderReference", err, sr.SenderReference)
+		errs.Add(fieldError("SenderReference", err, sr.SenderReference))
 	}
-	return nil
+	return errs
 }
 
 // SenderReferenceField gets a string of SenderReference field
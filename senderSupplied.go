@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // SenderSupplied {1500}
@@ -44,6 +47,9 @@ func NewSenderSupplied() *SenderSupplied {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (ss *SenderSupplied) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 18 {
+		return NewTagWrongLengthErr(18, utf8.RuneCountInString(record))
+	}
 	ss.tag = record[0:6]
 	ss.FormatVersion = ss.parseStringField(record[6:8])
 	ss.UserRequestCorrelation = ss.parseStringField(record[8:16])
@@ -81,34 +87,38 @@ func (ss *SenderSupplied) String() string {
 // Validate performs WIRE format rule checks on SenderSupplied and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (ss *SenderSupplied) Validate() error {
-	if err := ss.fieldInclusion(); err != nil {
-		return err
-	}
+	return ss.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on SenderSupplied and returns every error found
+func (ss *SenderSupplied) validateAll() base.ErrorList {
+	errs := ss.fieldInclusion()
 	if ss.tag != TagSenderSupplied {
-		return fieldError("tag", ErrValidTagForType, ss.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ss.tag))
 	}
 	if ss.FormatVersion != FormatVersion {
-		return fieldError("FormatVersion", ErrFormatVersion, ss.FormatVersion)
+		errs.Add(fieldError("FormatVersion", ErrFormatVersion, ss.FormatVersion))
 	}
 	if err := ss.isAlphanumeric(ss.UserRequestCorrelation); err != nil {
-		return fieldError("UserRequestCorrelation", err, ss.UserRequestCorrelation)
+		errs.Add(fieldError("UserRequestCorrelation", err, ss.UserRequestCorrelation))
 	}
 	if err := ss.isTestProductionCode(ss.TestProductionCode); err != nil {
-		return fieldError("TestProductionCode", err, ss.TestProductionCode)
+		errs.Add(fieldError("TestProductionCode", err, ss.TestProductionCode))
 	}
 	if err := ss.isMessageDuplicationCode(ss.MessageDuplicationCode); err != nil {
-		return fieldError("MessageDuplicationCode", err, ss.MessageDuplicationCode)
+		errs.Add(fieldError("MessageDuplicationCode", err, ss.MessageDuplicationCode))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ss *SenderSupplied) fieldInclusion() error {
+func (ss *SenderSupplied) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if ss.UserRequestCorrelation == "" {
-		return fieldError("UserRequestCorrelation", ErrFieldRequired, ss.UserRequestCorrelation)
+		errs.Add(fieldError("UserRequestCorrelation", ErrFieldRequired, ss.UserRequestCorrelation))
 	}
-	return nil
+	return errs
 }
 
 // FormatVersionField gets a string of the FormatVersion field
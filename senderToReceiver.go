@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // SenderToReceiver is the remittance information
@@ -35,6 +38,9 @@ func NewSenderToReceiver() *SenderToReceiver {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (str *SenderToReceiver) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 6 {
+		return NewTagWrongLengthErr(6, utf8.RuneCountInString(record))
+	}
 	str.tag = record[:6]
 
 	optionalFields := strings.Split(record[6:], "*")
@@ -94,31 +100,37 @@ func (str *SenderToReceiver) String() string {
 // Validate performs WIRE format rule checks on SenderToReceiver and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (str *SenderToReceiver) Validate() error {
+	return str.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on SenderToReceiver and returns every error found
+func (str *SenderToReceiver) validateAll() base.ErrorList {
+	var errs base.ErrorList
 	if str.tag != TagSenderToReceiver {
-		return fieldError("tag", ErrValidTagForType, str.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, str.tag))
 	}
 	if err := str.isAlphanumeric(str.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, str.CoverPayment.SwiftFieldTag)
+		errs.Add(fieldError("SwiftFieldTag", err, str.CoverPayment.SwiftFieldTag))
 	}
 	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, str.CoverPayment.SwiftLineOne)
+		errs.Add(fieldError("SwiftLineOne", err, str.CoverPayment.SwiftLineOne))
 	}
 	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, str.CoverPayment.SwiftLineTwo)
+		errs.Add(fieldError("SwiftLineTwo", err, str.CoverPayment.SwiftLineTwo))
 	}
 	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, str.CoverPayment.SwiftLineThree)
+		errs.Add(fieldError("SwiftLineThree", err, str.CoverPayment.SwiftLineThree))
 	}
 	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, str.CoverPayment.SwiftLineFour)
+		errs.Add(fieldError("SwiftLineFour", err, str.CoverPayment.SwiftLineFour))
 	}
 	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineFive); err != nil {
-		return fieldError("SwiftLineFive", err, str.CoverPayment.SwiftLineFive)
+		errs.Add(fieldError("SwiftLineFive", err, str.CoverPayment.SwiftLineFive))
 	}
 	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineSix); err != nil {
-		return fieldError("SwiftLineSix", err, str.CoverPayment.SwiftLineSix)
+		errs.Add(fieldError("SwiftLineSix", err, str.CoverPayment.SwiftLineSix))
 	}
-	return nil
+	return errs
 }
 
 // SwiftFieldTagField gets a string of the SwiftFieldTag field
@@ -183,10 +183,11 @@ func TestInvalidTransactionTypeCodeForServiceMessage(t *testing.T) {
 	sm := mockServiceMessage()
 	fwm.ServiceMessage = sm
 	bfc := mockBusinessFunctionCode()
+	bfc.BusinessFunctionCode = BFCServiceMessage
 	bfc.TransactionTypeCode = "COV"
 	fwm.BusinessFunctionCode = bfc
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedTags()
 
 	require.EqualError(t, err, fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, bfc.TransactionTypeCode).Error())
 }
@@ -196,10 +197,12 @@ func TestInvalidLocalInstrumentForServiceMessage(t *testing.T) {
 	fwm := new(FEDWireMessage)
 	sm := mockServiceMessage()
 	fwm.ServiceMessage = sm
+	fwm.BusinessFunctionCode = mockBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BFCServiceMessage
 	li := mockLocalInstrument()
 	fwm.LocalInstrument = li
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedTags()
 
 	require.EqualError(t, err, fieldError("LocalInstrument", ErrInvalidProperty, li).Error())
 }
@@ -209,10 +212,12 @@ func TestInvalidPaymentNotificationForServiceMessage(t *testing.T) {
 	fwm := new(FEDWireMessage)
 	sm := mockServiceMessage()
 	fwm.ServiceMessage = sm
+	fwm.BusinessFunctionCode = mockBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BFCServiceMessage
 	pn := mockPaymentNotification()
 	fwm.PaymentNotification = pn
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedTags()
 
 	require.EqualError(t, err, fieldError("PaymentNotification", ErrInvalidProperty, pn).Error())
 }
@@ -222,10 +227,12 @@ func TestInvalidChargesForServiceMessage(t *testing.T) {
 	fwm := new(FEDWireMessage)
 	sm := mockServiceMessage()
 	fwm.ServiceMessage = sm
+	fwm.BusinessFunctionCode = mockBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BFCServiceMessage
 	c := mockCharges()
 	fwm.Charges = c
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedTags()
 
 	require.EqualError(t, err, fieldError("Charges", ErrInvalidProperty, c).Error())
 }
@@ -235,10 +242,12 @@ func TestInvalidInstructedAmountForServiceMessage(t *testing.T) {
 	fwm := new(FEDWireMessage)
 	sm := mockServiceMessage()
 	fwm.ServiceMessage = sm
+	fwm.BusinessFunctionCode = mockBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BFCServiceMessage
 	ia := mockInstructedAmount()
 	fwm.InstructedAmount = ia
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedTags()
 
 	require.EqualError(t, err, fieldError("InstructedAmount", ErrInvalidProperty, ia).Error())
 }
@@ -248,10 +257,12 @@ func TestInvalidExchangeRateForServiceMessage(t *testing.T) {
 	fwm := new(FEDWireMessage)
 	sm := mockServiceMessage()
 	fwm.ServiceMessage = sm
+	fwm.BusinessFunctionCode = mockBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BFCServiceMessage
 	eRate := mockExchangeRate()
 	fwm.ExchangeRate = eRate
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedTags()
 
 	require.EqualError(t, err, fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate).Error())
 }
@@ -261,11 +272,13 @@ func TestInvalidBeneficiaryIdentificationCodeForServiceMessage(t *testing.T) {
 	fwm := new(FEDWireMessage)
 	sm := mockServiceMessage()
 	fwm.ServiceMessage = sm
+	fwm.BusinessFunctionCode = mockBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BFCServiceMessage
 	ben := mockBeneficiary()
 	ben.Personal.IdentificationCode = SWIFTBICORBEIANDAccountNumber
 	fwm.Beneficiary = ben
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedTags()
 
 	require.EqualError(t, err, fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty, ben.Personal.IdentificationCode).Error())
 }
@@ -275,11 +288,13 @@ func TestInvalidOriginatorIdentificationCodeForServiceMessage(t *testing.T) {
 	fwm := new(FEDWireMessage)
 	sm := mockServiceMessage()
 	fwm.ServiceMessage = sm
+	fwm.BusinessFunctionCode = mockBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BFCServiceMessage
 	o := mockOriginator()
 	o.Personal.IdentificationCode = SWIFTBICORBEIANDAccountNumber
 	fwm.Originator = o
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedTags()
 
 	require.EqualError(t, err, fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty, o.Personal.IdentificationCode).Error())
 }
@@ -289,10 +304,12 @@ func TestInvalidOriginatorOptionFForServiceMessage(t *testing.T) {
 	fwm := new(FEDWireMessage)
 	sm := mockServiceMessage()
 	fwm.ServiceMessage = sm
+	fwm.BusinessFunctionCode = mockBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BFCServiceMessage
 	off := mockOriginatorOptionF()
 	fwm.OriginatorOptionF = off
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedTags()
 
 	require.EqualError(t, err, fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF).Error())
 }
@@ -302,12 +319,14 @@ func TestInvalidUnstructuredAddendaForServiceMessage(t *testing.T) {
 	fwm := new(FEDWireMessage)
 	sm := mockServiceMessage()
 	fwm.ServiceMessage = sm
+	fwm.BusinessFunctionCode = mockBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BFCServiceMessage
 	ua := mockUnstructuredAddenda()
 	fwm.UnstructuredAddenda = ua
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedTags()
 
-	require.EqualError(t, err, fieldError("BusinessFunctionCode", ErrInvalidProperty, "Unstructured Addenda").Error())
+	require.EqualError(t, err, fieldError("UnstructuredAddenda", ErrInvalidProperty, ua).Error())
 }
 
 // TestInvalidCurrencyInstructedAmountForServiceMessage test an invalid CurrencyInstructedAmount
@@ -315,10 +334,12 @@ func TestInvalidCurrencyInstructedAmountForServiceMessage(t *testing.T) {
 	fwm := new(FEDWireMessage)
 	sm := mockServiceMessage()
 	fwm.ServiceMessage = sm
+	fwm.BusinessFunctionCode = mockBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BFCServiceMessage
 	cia := mockCurrencyInstructedAmount()
 	fwm.CurrencyInstructedAmount = cia
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedTags()
 
 	require.EqualError(t, err, fieldError("CurrencyInstructedAmount", ErrInvalidProperty, fwm.CurrencyInstructedAmount).Error())
 }
@@ -328,10 +349,12 @@ func TestInvalidRelatedRemittanceForServiceMessage(t *testing.T) {
 	fwm := new(FEDWireMessage)
 	sm := mockServiceMessage()
 	fwm.ServiceMessage = sm
+	fwm.BusinessFunctionCode = mockBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BFCServiceMessage
 	rr := mockRelatedRemittance()
 	fwm.RelatedRemittance = rr
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedTags()
 
 	require.EqualError(t, err, fieldError("RelatedRemittance", ErrInvalidProperty, fwm.RelatedRemittance).Error())
 }
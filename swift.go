@@ -0,0 +1,653 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// The SWIFT types model the header blocks and fields of SWIFT MT messages which the Fedwire Funds Service tags
+// carry, and convert between those messages and FEDWireMessage.
+
+const (
+	// SWIFTMT103 is the Type of a SWIFT MT103 Single Customer Credit Transfer
+	SWIFTMT103 = "103"
+	// SWIFTMT202COV is the Type of a SWIFT MT202COV General Financial Institution Transfer covering a customer
+	// credit transfer, an MT202 with the validation flag COV
+	SWIFTMT202COV = "202COV"
+)
+
+const (
+	// swiftValueDateLayout is the YYMMDD layout of a SWIFT value date
+	swiftValueDateLayout = "060102"
+	// swiftLineWidth is the width of a line of a SWIFT name and address or narrative field
+	swiftLineWidth = 35
+	// swiftNoReference is the reference of a message without one
+	swiftNoReference = "NONREF"
+)
+
+var (
+	// swiftFieldRegex matches the first line of a field of a text block, such as :50K:/123456
+	swiftFieldRegex = regexp.MustCompile(`^:([0-9]{2}[A-Z]?):(.*)$`)
+	// swiftInvalidCharacters matches characters outside the SWIFT X character set
+	swiftInvalidCharacters = regexp.MustCompile(`[^a-zA-Z0-9/\-?:().,'+ ]`)
+)
+
+// SWIFTMessage is a SWIFT MT message: its type, the BICs of its sender and receiver and the fields of its text block
+type SWIFTMessage struct {
+	// Type is the message type, such as SWIFTMT103
+	Type string `json:"type"`
+	// Sender is the BIC of the sending institution, from the basic header block
+	Sender string `json:"sender,omitempty"`
+	// Receiver is the BIC of the receiving institution, from the application header block
+	Receiver string `json:"receiver,omitempty"`
+	// UETR is the Unique End-to-end Transaction Reference of the user header block
+	UETR string `json:"uetr,omitempty"`
+	// Fields are the fields of the text block, in order
+	Fields []SWIFTField `json:"fields"`
+}
+
+// SWIFTField is a field of the text block of a SWIFT MT message
+type SWIFTField struct {
+	// Tag is the number of the field and its option letter, such as 50K
+	Tag string `json:"tag"`
+	// Lines are the lines of the value of the field
+	Lines []string `json:"lines"`
+}
+
+// option returns the option letter of f, such as K for 50K, which is empty for a field without one
+func (f SWIFTField) option() string {
+	if len(f.Tag) < 3 {
+		return ""
+	}
+	return f.Tag[2:]
+}
+
+// value returns the first line of f
+func (f SWIFTField) value() string {
+	if len(f.Lines) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(f.Lines[0])
+}
+
+// sanitize returns f with the characters of its lines outside the SWIFT X character set replaced by a full stop,
+// warning when any are replaced
+func (f SWIFTField) sanitize(ws *conversionWarnings) SWIFTField {
+	lines := make([]string, len(f.Lines))
+	replaced := false
+	for i, line := range f.Lines {
+		lines[i] = swiftInvalidCharacters.ReplaceAllString(line, ".")
+		replaced = replaced || lines[i] != line
+	}
+	if replaced {
+		ws.add("", f.Tag, "has characters outside the SWIFT X character set replaced")
+	}
+	return SWIFTField{Tag: f.Tag, Lines: lines}
+}
+
+// SWIFTOpts are the header fields of a SWIFT MT message converted from a FEDWireMessage, and the tags of a
+// FEDWireMessage converted from a SWIFT MT message, which the other message does not hold
+type SWIFTOpts struct {
+	// Sender is the BIC of the institution forwarding a FEDWireMessage as a SWIFT MT message
+	Sender string `json:"sender,omitempty"`
+	// Receiver is the BIC of the next institution of a FEDWireMessage forwarded as a SWIFT MT message
+	Receiver string `json:"receiver,omitempty"`
+	// InputMessageAccountabilityData is the IMAD of the FEDWireMessage
+	InputMessageAccountabilityData *InputMessageAccountabilityData `json:"inputMessageAccountabilityData"`
+	// SenderDepositoryInstitution is the Fedwire Funds Service participant sending the FEDWireMessage
+	SenderDepositoryInstitution *SenderDepositoryInstitution `json:"senderDepositoryInstitution"`
+	// ReceiverDepositoryInstitution is the Fedwire Funds Service participant receiving the FEDWireMessage
+	ReceiverDepositoryInstitution *ReceiverDepositoryInstitution `json:"receiverDepositoryInstitution"`
+	// TestProductionCode is the SenderSupplied TestProductionCode of the FEDWireMessage, which a SWIFT MT message
+	// does not carry. The default is EnvironmentProduction.
+	TestProductionCode string `json:"testProductionCode,omitempty"`
+}
+
+// testProductionCode returns the TestProductionCode of opts, defaulting to EnvironmentProduction
+func (opts SWIFTOpts) testProductionCode() string {
+	if opts.TestProductionCode == "" {
+		return EnvironmentProduction
+	}
+	return opts.TestProductionCode
+}
+
+// ReadSWIFT reads a SWIFT MT message, an MT103 or MT202COV, and converts it to a FEDWireMessage with the tags of opts.
+//
+// Fields without a corresponding tag, values truncated to fit one and characters outside the SWIFT X character set,
+// which are replaced by a full stop, are returned as warnings.
+func ReadSWIFT(r io.Reader, opts SWIFTOpts) (FEDWireMessage, []ConversionWarning, error) {
+	msg, err := ParseSWIFTMessage(r)
+	if err != nil {
+		return FEDWireMessage{}, nil, err
+	}
+	return msg.FEDWireMessage(opts)
+}
+
+// ParseSWIFTMessage parses a SWIFT MT message, its header blocks followed by its text block, or a text block alone.
+// The Type of a text block alone is empty.
+func ParseSWIFTMessage(r io.Reader) (*SWIFTMessage, error) {
+	bs, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	s := strings.TrimSpace(strings.Replace(string(bs), "\r\n", "\n", -1))
+	msg := &SWIFTMessage{}
+	if !strings.HasPrefix(s, "{") {
+		msg.Fields, err = parseSWIFTFields(s)
+		return msg, err
+	}
+	blocks, err := swiftBlocks(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.parseHeaders(blocks); err != nil {
+		return nil, err
+	}
+	text, ok := blocks["4"]
+	if !ok {
+		return nil, fieldError("TextBlock", ErrFieldRequired)
+	}
+	if msg.Fields, err = parseSWIFTFields(text); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// parseHeaders sets the Type, Sender, Receiver and UETR of msg from its basic, application and user header blocks.
+// The sender of an input message is in its basic header and of an output message in its application header.
+func (msg *SWIFTMessage) parseHeaders(blocks map[string]string) error {
+	basic, application := blocks["1"], blocks["2"]
+	if basic != "" {
+		if len(basic) < 15 {
+			return fieldError("BasicHeader", ErrSWIFTMessage, basic)
+		}
+		msg.Sender = swiftBIC(basic[3:15])
+	}
+	if application != "" {
+		switch {
+		case len(application) >= 16 && application[0] == 'I':
+			msg.Receiver = swiftBIC(application[4:16])
+		case len(application) >= 26 && application[0] == 'O':
+			msg.Sender, msg.Receiver = swiftBIC(application[14:26]), msg.Sender
+		default:
+			return fieldError("ApplicationHeader", ErrSWIFTMessage, application)
+		}
+		msg.Type = application[1:4]
+	}
+	if user := blocks["3"]; user != "" {
+		fields, err := swiftBlocks(user)
+		if err != nil {
+			return err
+		}
+		// the validation flag of a cover payment is part of the type of its application header, unlike others
+		// such as STP
+		if flag := fields["119"]; flag == "COV" && msg.Type != "" {
+			msg.Type += flag
+		}
+		msg.UETR = fields["121"]
+	}
+	return nil
+}
+
+// swiftBlocks returns the contents of the blocks of s, such as {1:F01BANKBEBBAXXX0000000000}, by their identifiers
+func swiftBlocks(s string) (map[string]string, error) {
+	blocks := make(map[string]string)
+	for s = strings.TrimSpace(s); s != ""; s = strings.TrimSpace(s) {
+		colon := strings.IndexByte(s, ':')
+		if s[0] != '{' || colon < 0 {
+			return nil, fieldError("Block", ErrSWIFTMessage, s)
+		}
+		depth, end := 0, -1
+		for i := 0; i < len(s) && end < 0; i++ {
+			switch s[i] {
+			case '{':
+				depth++
+			case '}':
+				if depth--; depth == 0 {
+					end = i
+				}
+			}
+		}
+		if end < colon {
+			return nil, fieldError("Block", ErrSWIFTMessage, s)
+		}
+		blocks[s[1:colon]] = s[colon+1 : end]
+		s = s[end+1:]
+	}
+	return blocks, nil
+}
+
+// parseSWIFTFields returns the fields of a text block, which ends with a line holding a hyphen
+func parseSWIFTFields(text string) ([]SWIFTField, error) {
+	var fields []SWIFTField
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, "\r ")
+		if line == "" {
+			continue
+		}
+		if line == "-" {
+			break
+		}
+		if m := swiftFieldRegex.FindStringSubmatch(line); m != nil {
+			fields = append(fields, SWIFTField{Tag: m[1], Lines: []string{m[2]}})
+			continue
+		}
+		if len(fields) == 0 {
+			return nil, fieldError("TextBlock", ErrSWIFTMessage, line)
+		}
+		fields[len(fields)-1].Lines = append(fields[len(fields)-1].Lines, line)
+	}
+	if len(fields) == 0 {
+		return nil, fieldError("TextBlock", ErrFieldRequired)
+	}
+	return fields, nil
+}
+
+// swiftBIC returns the BIC of a logical terminal address, a BIC8, terminal code and branch code, omitting the
+// branch code XXX of a head office
+func swiftBIC(lt string) string {
+	if len(lt) != 12 {
+		return lt
+	}
+	if lt[9:] == "XXX" {
+		return lt[:8]
+	}
+	return lt[:8] + lt[9:]
+}
+
+// swiftLogicalTerminal returns the logical terminal address of a BIC
+func swiftLogicalTerminal(bic string) string {
+	if len(bic) == 11 {
+		return bic[:8] + "A" + bic[8:]
+	}
+	return bic + "AXXX"
+}
+
+// Bytes returns the SWIFT MT message of msg: a basic header when it has a Sender, an application header when it
+// has a Receiver, a user header when it is a cover payment or has a UETR, and its text block. A message with a Type
+// must have a Receiver, as its application header holds the Type.
+func (msg *SWIFTMessage) Bytes() ([]byte, error) {
+	if msg.Type != "" && msg.Receiver == "" {
+		return nil, fieldError("Receiver", ErrFieldRequired)
+	}
+	v := &validator{}
+	var buf bytes.Buffer
+	if msg.Sender != "" {
+		if err := v.isBIC(msg.Sender); err != nil {
+			return nil, fieldError("Sender", ErrBIC, msg.Sender)
+		}
+		buf.WriteString("{1:F01" + swiftLogicalTerminal(msg.Sender) + "0000000000}")
+	}
+	mt, flag := msg.Type, ""
+	if len(mt) > 3 {
+		mt, flag = mt[:3], mt[3:]
+	}
+	if msg.Receiver != "" {
+		if err := v.isBIC(msg.Receiver); err != nil {
+			return nil, fieldError("Receiver", ErrBIC, msg.Receiver)
+		}
+		if len(mt) != 3 {
+			return nil, fieldError("Type", ErrSWIFTMessage, msg.Type)
+		}
+		buf.WriteString("{2:I" + mt + swiftLogicalTerminal(msg.Receiver) + "N}")
+	}
+	if flag != "" || msg.UETR != "" {
+		buf.WriteString("{3:")
+		if flag != "" {
+			buf.WriteString("{119:" + flag + "}")
+		}
+		if msg.UETR != "" {
+			buf.WriteString("{121:" + msg.UETR + "}")
+		}
+		buf.WriteString("}")
+	}
+	buf.WriteString("{4:\r\n")
+	for _, f := range msg.Fields {
+		buf.WriteString(":" + f.Tag + ":" + strings.Join(f.Lines, "\r\n") + "\r\n")
+	}
+	buf.WriteString("-}")
+	return buf.Bytes(), nil
+}
+
+// FEDWireMessage converts msg, an MT103 or MT202COV, to a FEDWireMessage with the tags of opts.
+//
+// Fields without a corresponding tag, values truncated to fit one and characters outside the SWIFT X character set,
+// which are replaced by a full stop, are returned as warnings.
+func (msg *SWIFTMessage) FEDWireMessage(opts SWIFTOpts) (FEDWireMessage, []ConversionWarning, error) {
+	switch msg.Type {
+	case SWIFTMT103:
+		return msg.fedwireMT103(opts)
+	case SWIFTMT202COV:
+		return msg.fedwireMT202COV(opts)
+	}
+	return FEDWireMessage{}, nil, fieldError("Type", ErrSWIFTMessage, msg.Type)
+}
+
+// field returns the first field of msg with one of tags, or nil without one
+func (msg *SWIFTMessage) field(tags ...string) *SWIFTField {
+	for i := range msg.Fields {
+		for _, tag := range tags {
+			if msg.Fields[i].Tag == tag {
+				return &msg.Fields[i]
+			}
+		}
+	}
+	return nil
+}
+
+// add appends a field with the non-empty lines, unless it has none
+func (msg *SWIFTMessage) add(tag string, lines ...string) {
+	var nonEmpty []string
+	for _, line := range lines {
+		if line != "" {
+			nonEmpty = append(nonEmpty, line)
+		}
+	}
+	if len(nonEmpty) > 0 {
+		msg.Fields = append(msg.Fields, SWIFTField{Tag: tag, Lines: nonEmpty})
+	}
+}
+
+// addField appends f, unless it has no lines
+func (msg *SWIFTMessage) addField(f SWIFTField) {
+	msg.add(f.Tag, f.Lines...)
+}
+
+// newSWIFTFEDWireMessage returns the tags of opts shared by the FEDWireMessage of each SWIFT MT message, with a
+// SenderSupplied whose UserRequestCorrelation is the InputSequenceNumber and TestProductionCode is that of opts
+func newSWIFTFEDWireMessage(opts SWIFTOpts) (FEDWireMessage, error) {
+	fwm := FEDWireMessage{}
+	if opts.InputMessageAccountabilityData == nil {
+		return fwm, fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+	if opts.SenderDepositoryInstitution == nil {
+		return fwm, fieldError("SenderDepositoryInstitution", ErrFieldRequired)
+	}
+	if opts.ReceiverDepositoryInstitution == nil {
+		return fwm, fieldError("ReceiverDepositoryInstitution", ErrFieldRequired)
+	}
+	imad := *opts.InputMessageAccountabilityData
+	fwm.InputMessageAccountabilityData = &imad
+	sdi := *opts.SenderDepositoryInstitution
+	fwm.SenderDepositoryInstitution = &sdi
+	rdi := *opts.ReceiverDepositoryInstitution
+	fwm.ReceiverDepositoryInstitution = &rdi
+	fwm.SenderSupplied = NewSenderSupplied()
+	fwm.SenderSupplied.UserRequestCorrelation = imad.InputSequenceNumber
+	fwm.SenderSupplied.TestProductionCode = opts.testProductionCode()
+	return fwm, nil
+}
+
+// swiftSenderSuppliedWarnings warns for SenderSupplied values without a corresponding field of a SWIFT MT message
+// of format
+func (fwm *FEDWireMessage) swiftSenderSuppliedWarnings(format string, ws *conversionWarnings) {
+	if ss := fwm.SenderSupplied; ss != nil && ss.TestProductionCode == EnvironmentTest {
+		ws.add(TagSenderSupplied, "SenderSupplied.TestProductionCode", "test is not translated to %s", format)
+	}
+}
+
+// swiftValueDate returns the YYMMDD value date of the CCYYMMDD cycle date s
+func swiftValueDate(s string) (string, error) {
+	t, err := time.Parse(cycleDateLayout, s)
+	if err != nil {
+		return "", ErrValidDate
+	}
+	return t.Format(swiftValueDateLayout), nil
+}
+
+// swiftAmount returns m with a decimal comma, which SWIFT requires even without minor units, such as 1000,
+func swiftAmount(m Money) (string, error) {
+	s, err := m.decimal(',')
+	if err != nil {
+		return "", err
+	}
+	if !strings.Contains(s, ",") {
+		s += ","
+	}
+	return s, nil
+}
+
+// swiftCurrencyAmount returns the currency code and amount of m, such as USD1234,56
+func swiftCurrencyAmount(m Money) (string, error) {
+	s, err := swiftAmount(m)
+	if err != nil {
+		return "", err
+	}
+	return m.Currency + s, nil
+}
+
+// parseSWIFTCurrencyAmount parses a currency code and amount, such as USD1234,56
+func parseSWIFTCurrencyAmount(s string) (Money, error) {
+	if len(s) < 4 {
+		return Money{}, ErrNonAmount
+	}
+	return parseDecimalMoney(s[:3], s[3:], ',')
+}
+
+// swiftText returns s trimmed, with characters outside the SWIFT X character set replaced by a full stop and cut
+// to max characters, warning for each change
+func swiftText(tag, field, s string, max int, ws *conversionWarnings) string {
+	s = strings.TrimSpace(s)
+	if replaced := swiftInvalidCharacters.ReplaceAllString(s, "."); replaced != s {
+		ws.add(tag, field, "has characters outside the SWIFT X character set replaced")
+		s = replaced
+	}
+	return ws.truncate(tag, field, s, max)
+}
+
+// swiftTextLines returns the non-empty lines as SWIFT text, cut to count lines
+func swiftTextLines(tag, field string, lines []string, count int, ws *conversionWarnings) []string {
+	var out []string
+	for _, line := range lines {
+		if line = swiftText(tag, field, line, swiftLineWidth, ws); line != "" {
+			out = append(out, line)
+		}
+	}
+	if len(out) > count {
+		ws.add(tag, field, "is truncated to %d lines", count)
+		out = out[:count]
+	}
+	return out
+}
+
+// fedwireSWIFTLines returns the lines of a SWIFT field, cut to count lines of width characters
+func fedwireSWIFTLines(tag, field string, lines []string, width, count int, ws *conversionWarnings) []string {
+	if len(lines) > count {
+		ws.add(tag, field, "is truncated to %d lines", count)
+		lines = lines[:count]
+	}
+	out := make([]string, len(lines))
+	for i := range lines {
+		out[i] = ws.truncate(tag, field, lines[i], width)
+	}
+	return out
+}
+
+// swiftPartyIdentifier returns the IdentificationCode and Identifier of the party identifier line of a SWIFT party
+// field: a Fedwire routing number, CHIPS participant or CHIPS identifier clearing code, or an account
+func swiftPartyIdentifier(line string) (string, string) {
+	line = strings.TrimSpace(line)
+	for prefix, code := range map[string]string{"//FW": FEDRoutingNumber, "//CP": CHIPSParticipant, "//CH": CHIPSIdentifier} {
+		if strings.HasPrefix(line, prefix) {
+			if id := strings.TrimSpace(line[len(prefix):]); id != "" {
+				return code, id
+			}
+			return "", ""
+		}
+	}
+	// a debit or credit mark, as in /C/account, precedes the account in some fields
+	account := strings.TrimPrefix(line, "/")
+	if strings.HasPrefix(account, "C/") || strings.HasPrefix(account, "D/") {
+		account = account[2:]
+	}
+	if account = strings.TrimSpace(account); account == "" {
+		return "", ""
+	}
+	return DemandDepositAccountNumber, account
+}
+
+// swiftPartyIdentifierLine returns the party identifier line of an IdentificationCode and Identifier, or "" for an
+// IdentificationCode without one
+func swiftPartyIdentifierLine(code, id string) string {
+	switch code {
+	case FEDRoutingNumber:
+		return "//FW" + id
+	case CHIPSParticipant:
+		return "//CP" + id
+	case CHIPSIdentifier:
+		return "//CH" + id
+	case DemandDepositAccountNumber:
+		return "/" + id
+	}
+	return ""
+}
+
+// fedwireSWIFTFinancialInstitution returns the FinancialInstitution of a SWIFT party field with option A, B, C or D.
+// A clearing code is its identification in place of a BIC, and an account its identification without either.
+func fedwireSWIFTFinancialInstitution(tag string, f SWIFTField, ws *conversionWarnings) FinancialInstitution {
+	var fi FinancialInstitution
+	lines := f.Lines
+	if len(lines) > 0 && strings.HasPrefix(lines[0], "/") {
+		fi.IdentificationCode, fi.Identifier = swiftPartyIdentifier(lines[0])
+		lines = lines[1:]
+	}
+	switch f.option() {
+	case "A":
+		bic := ""
+		if len(lines) > 0 {
+			bic = strings.TrimSpace(lines[0])
+		}
+		switch fi.IdentificationCode {
+		case "", DemandDepositAccountNumber:
+			if fi.Identifier != "" {
+				ws.add(tag, f.Tag, "account %s is not translated with a BIC", fi.Identifier)
+			}
+			fi.IdentificationCode, fi.Identifier = SWIFTBankIdentifierCode, bic
+		default:
+			ws.add(tag, f.Tag, "BIC %s is not translated with identification %s", bic, fi.IdentificationCode)
+		}
+	case "B":
+		// the location of a branch
+		if len(lines) > 0 {
+			fi.Address.AddressLineOne = ws.truncate(tag, f.Tag, lines[0], 35)
+		}
+	case "D":
+		lines = fedwireSWIFTLines(tag, f.Tag, lines, 35, 4, ws)
+		setLines(lines, &fi.Name, &fi.Address.AddressLineOne, &fi.Address.AddressLineTwo, &fi.Address.AddressLineThree)
+	}
+	fi.Identifier = ws.truncate(tag, f.Tag, fi.Identifier, 34)
+	return fi
+}
+
+// swiftFinancialInstitutionField returns fi as the SWIFT party field number: option A for a BIC, option C for a
+// party identifier without a name and address when allowC is true, and otherwise option D
+func swiftFinancialInstitutionField(tag, number string, fi FinancialInstitution, allowC bool, ws *conversionWarnings) SWIFTField {
+	id := strings.TrimSpace(fi.Identifier)
+	names := []string{fi.Name, fi.Address.AddressLineOne, fi.Address.AddressLineTwo, fi.Address.AddressLineThree}
+	if fi.IdentificationCode == SWIFTBankIdentifierCode {
+		if joinNonEmpty("", names...) != "" {
+			ws.add(tag, number+"A", "Name and Address are not translated with a BIC")
+		}
+		return SWIFTField{Tag: number + "A", Lines: []string{id}}
+	}
+	f := SWIFTField{Tag: number + "D"}
+	if line := swiftPartyIdentifierLine(fi.IdentificationCode, id); line != "" {
+		f.Lines = append(f.Lines, swiftText(tag, f.Tag, line, 37, ws))
+	} else if fi.IdentificationCode != "" {
+		ws.add(tag, f.Tag, "IdentificationCode %s is not translated", fi.IdentificationCode)
+	}
+	lines := swiftTextLines(tag, f.Tag, names, 4, ws)
+	if len(lines) == 0 && len(f.Lines) > 0 && allowC {
+		f.Tag = number + "C"
+		return f
+	}
+	f.Lines = append(f.Lines, lines...)
+	return f
+}
+
+// fedwireSWIFTPersonal returns the Personal of a SWIFT customer field: option A, a BIC with an optional account,
+// option F, an account and numbered lines of which the name, address and country and town are translated, or
+// otherwise an optional account followed by a name and address
+func fedwireSWIFTPersonal(tag string, f SWIFTField, ws *conversionWarnings) Personal {
+	var p Personal
+	lines := f.Lines
+	account := ""
+	if len(lines) > 0 && strings.HasPrefix(lines[0], "/") {
+		_, account = swiftPartyIdentifier(lines[0])
+		lines = lines[1:]
+	} else if f.option() == "F" && len(lines) > 0 {
+		ws.add(tag, f.Tag, "party identifier %s is not translated", lines[0])
+		lines = lines[1:]
+	}
+	switch f.option() {
+	case "A":
+		bic := ""
+		if len(lines) > 0 {
+			bic = strings.TrimSpace(lines[0])
+		}
+		p.IdentificationCode, p.Identifier = SWIFTBankIdentifierCode, bic
+		if account != "" {
+			p.IdentificationCode, p.Identifier = SWIFTBICORBEIANDAccountNumber, bic+"/"+account
+		}
+	case "F":
+		var names, address []string
+		for _, line := range lines {
+			code, text := optionFLineCode(line)
+			switch code {
+			case OptionFName:
+				names = append(names, text)
+			case OptionFAddress, OptionFCountryTown:
+				address = append(address, text)
+			default:
+				ws.add(tag, f.Tag, "line %s is not translated", line)
+			}
+		}
+		p.Name = ws.truncate(tag, f.Tag, strings.Join(names, " "), 35)
+		address = fedwireSWIFTLines(tag, f.Tag, address, 35, 3, ws)
+		setLines(address, &p.Address.AddressLineOne, &p.Address.AddressLineTwo, &p.Address.AddressLineThree)
+	default:
+		lines = fedwireSWIFTLines(tag, f.Tag, lines, 35, 4, ws)
+		setLines(lines, &p.Name, &p.Address.AddressLineOne, &p.Address.AddressLineTwo, &p.Address.AddressLineThree)
+	}
+	if account != "" && p.IdentificationCode == "" {
+		p.IdentificationCode, p.Identifier = DemandDepositAccountNumber, account
+	}
+	p.Identifier = ws.truncate(tag, f.Tag, p.Identifier, 34)
+	return p
+}
+
+// swiftPersonalField returns p as the SWIFT customer field number: option A for a BIC, optionally with an account,
+// and otherwise nameOption, an optional account followed by the name and address
+func swiftPersonalField(tag, number, nameOption string, p Personal, ws *conversionWarnings) SWIFTField {
+	id := strings.TrimSpace(p.Identifier)
+	names := []string{p.Name, p.Address.AddressLineOne, p.Address.AddressLineTwo, p.Address.AddressLineThree}
+	switch p.IdentificationCode {
+	case SWIFTBankIdentifierCode, SWIFTBICORBEIANDAccountNumber:
+		if joinNonEmpty("", names...) != "" {
+			ws.add(tag, number+"A", "Name and Address are not translated with a BIC")
+		}
+		if p.IdentificationCode == SWIFTBankIdentifierCode {
+			return SWIFTField{Tag: number + "A", Lines: []string{id}}
+		}
+		bic, account := splitBICAndAccountNumber(id)
+		return SWIFTField{Tag: number + "A", Lines: []string{"/" + account, bic}}
+	}
+	f := SWIFTField{Tag: number + nameOption}
+	switch p.IdentificationCode {
+	case "":
+	case DemandDepositAccountNumber:
+		f.Lines = append(f.Lines, "/"+swiftText(tag, f.Tag, id, 34, ws))
+	default:
+		ws.add(tag, f.Tag, "IdentificationCode %s is not translated", p.IdentificationCode)
+	}
+	f.Lines = append(f.Lines, swiftTextLines(tag, f.Tag, names, 4, ws)...)
+	return f
+}
@@ -0,0 +1,92 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSWIFTMessage(t *testing.T) {
+	msg, err := ParseSWIFTMessage(strings.NewReader(mt103Sample))
+	require.NoError(t, err)
+	require.Equal(t, SWIFTMT103, msg.Type)
+	require.Equal(t, "DEUTDEFF", msg.Sender)
+	require.Equal(t, "CHASUS33", msg.Receiver)
+	require.Equal(t, "8a562c67-ca16-48ba-b074-65581be6f011", msg.UETR)
+	require.Len(t, msg.Fields, 11)
+	require.Equal(t, SWIFTField{Tag: "20", Lines: []string{"REF-4456"}}, msg.Fields[0])
+	require.Equal(t, "F", msg.field("50F").option())
+	require.Len(t, msg.field("59F").Lines, 5)
+}
+
+func TestParseSWIFTMessage_output(t *testing.T) {
+	s := "{1:F01CHASUS33AXXX0000000000}{2:O1031249190502DEUTDEFFAXXX00000000001905021249N}" +
+		"{3:{119:COV}}{4:\r\n:20:REF\r\n-}"
+	msg, err := ParseSWIFTMessage(strings.NewReader(s))
+	require.NoError(t, err)
+	require.Equal(t, "103COV", msg.Type)
+	require.Equal(t, "DEUTDEFF", msg.Sender)
+	require.Equal(t, "CHASUS33", msg.Receiver)
+	require.Equal(t, []SWIFTField{{Tag: "20", Lines: []string{"REF"}}}, msg.Fields)
+}
+
+func TestParseSWIFTMessage_textBlock(t *testing.T) {
+	msg, err := ParseSWIFTMessage(strings.NewReader(":20:REF\n:23B:CRED\n-"))
+	require.NoError(t, err)
+	require.Empty(t, msg.Type)
+	require.Len(t, msg.Fields, 2)
+
+	_, _, err = ReadSWIFT(strings.NewReader(":20:REF\n:23B:CRED\n-"), SWIFTOpts{})
+	require.Error(t, err)
+
+	// without an application header, the validation flag COV is not a type
+	msg, err = ParseSWIFTMessage(strings.NewReader("{3:{119:COV}}{4:\n:20:REF\n-}"))
+	require.NoError(t, err)
+	require.Empty(t, msg.Type)
+}
+
+func TestParseSWIFTMessage_invalid(t *testing.T) {
+	_, err := ParseSWIFTMessage(strings.NewReader("{1:F01DEUTDEFFAXXX0000000000}{2:I103CHASUS33AXXXN}"))
+	require.EqualError(t, err, fieldError("TextBlock", ErrFieldRequired).Error())
+
+	_, err = ParseSWIFTMessage(strings.NewReader("{1:F01DEUTDEFF}{4:\n:20:REF\n-}"))
+	require.Error(t, err)
+}
+
+func TestSWIFTMessage_Bytes(t *testing.T) {
+	msg := &SWIFTMessage{
+		Type:     SWIFTMT103,
+		Sender:   "DEUTDEFF",
+		Receiver: "CHASUS33",
+		UETR:     "8a562c67-ca16-48ba-b074-65581be6f011",
+		Fields: []SWIFTField{
+			{Tag: "20", Lines: []string{"REF"}},
+			{Tag: "59", Lines: []string{"/123", "NAME"}},
+		},
+	}
+	bs, err := msg.Bytes()
+	require.NoError(t, err)
+	require.Equal(t, "{1:F01DEUTDEFFAXXX0000000000}{2:I103CHASUS33AXXXN}"+
+		"{3:{121:8a562c67-ca16-48ba-b074-65581be6f011}}{4:\r\n:20:REF\r\n:59:/123\r\nNAME\r\n-}", string(bs))
+
+	read, err := ParseSWIFTMessage(bytes.NewReader(bs))
+	require.NoError(t, err)
+	require.Equal(t, msg, read)
+}
+
+func TestSWIFTMessage_BytesReceiver(t *testing.T) {
+	msg := &SWIFTMessage{Type: SWIFTMT202COV, Sender: "DEUTDEFF", Fields: []SWIFTField{{Tag: "20", Lines: []string{"REF"}}}}
+	_, err := msg.Bytes()
+	require.EqualError(t, err, fieldError("Receiver", ErrFieldRequired).Error())
+
+	msg.Type = ""
+	bs, err := msg.Bytes()
+	require.NoError(t, err)
+	require.Equal(t, "{1:F01DEUTDEFFAXXX0000000000}{4:\r\n:20:REF\r\n-}", string(bs))
+}
@@ -0,0 +1,112 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	// embed the time zone database, so FedwireLocation does not depend upon the zoneinfo of the host
+	_ "time/tzdata"
+)
+
+const (
+	// cycleDateLayout is the CCYYMMDD layout of a cycle date
+	cycleDateLayout = "20060102"
+	// monthDayLayout is the MMDD layout of a date without its year
+	monthDayLayout = "0102"
+	// hourMinuteLayout is the HHMM layout of a time
+	hourMinuteLayout = "1504"
+)
+
+var (
+	fedwireLocation     *time.Location
+	fedwireLocationErr  error
+	fedwireLocationOnce sync.Once
+)
+
+// FedwireLocation returns the America/New_York time zone, in which the Fedwire Funds Service records dates and times
+func FedwireLocation() (*time.Location, error) {
+	fedwireLocationOnce.Do(func() {
+		fedwireLocation, fedwireLocationErr = loadFedwireLocation()
+	})
+	return fedwireLocation, fedwireLocationErr
+}
+
+// loadFedwireLocation loads the America/New_York time zone
+func loadFedwireLocation() (*time.Location, error) {
+	return time.LoadLocation("America/New_York")
+}
+
+// parseCycleDate returns the CCYYMMDD date s as midnight in America/New_York
+func parseCycleDate(s string) (time.Time, error) {
+	loc, err := FedwireLocation()
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := time.ParseInLocation(cycleDateLayout, s, loc)
+	if err != nil {
+		return time.Time{}, ErrValidDate
+	}
+	return t, nil
+}
+
+// formatCycleDate returns the CCYYMMDD date of t in America/New_York
+func formatCycleDate(t time.Time) (string, error) {
+	loc, err := FedwireLocation()
+	if err != nil {
+		return "", err
+	}
+	return t.In(loc).Format(cycleDateLayout), nil
+}
+
+// parseMonthDayTime returns the MMDD date and HHMM time in America/New_York, in the year placing it nearest to
+// cycleDate. A funds-transfer business day begins the calendar evening before its cycle date, so a message
+// received on December 31 may carry the January 1 cycle date of the following year and the reverse.
+func parseMonthDayTime(monthDay, hourMinute string, cycleDate time.Time) (time.Time, error) {
+	loc, err := FedwireLocation()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(monthDay) != 4 || len(hourMinute) != 4 {
+		return time.Time{}, ErrValidDate
+	}
+	cycleDate = cycleDate.In(loc)
+	var nearest time.Time
+	for year := cycleDate.Year() - 1; year <= cycleDate.Year()+1; year++ {
+		t, err := time.ParseInLocation("2006"+monthDayLayout+hourMinuteLayout,
+			strconv.Itoa(year)+monthDay+hourMinute, loc)
+		if err != nil {
+			// such as February 29 outside of a leap year
+			continue
+		}
+		if nearest.IsZero() || absDuration(t.Sub(cycleDate)) < absDuration(nearest.Sub(cycleDate)) {
+			nearest = t
+		}
+	}
+	if nearest.IsZero() {
+		return time.Time{}, ErrValidDate
+	}
+	return nearest, nil
+}
+
+// formatMonthDayTime returns the MMDD date and HHMM time of t in America/New_York
+func formatMonthDayTime(t time.Time) (string, string, error) {
+	loc, err := FedwireLocation()
+	if err != nil {
+		return "", "", err
+	}
+	t = t.In(loc)
+	return t.Format(monthDayLayout), t.Format(hourMinuteLayout), nil
+}
+
+// absDuration returns the absolute value of d
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,41 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestLoadFedwireLocation_noZoneinfo ensures the America/New_York time zone loads from the embedded time zone
+// database when ZONEINFO is unset or points at no zoneinfo
+func TestLoadFedwireLocation_noZoneinfo(t *testing.T) {
+	zoneinfo, ok := os.LookupEnv("ZONEINFO")
+	defer func() {
+		if ok {
+			os.Setenv("ZONEINFO", zoneinfo)
+		} else {
+			os.Unsetenv("ZONEINFO")
+		}
+	}()
+
+	for _, value := range []string{"", os.DevNull} {
+		if value == "" {
+			require.NoError(t, os.Unsetenv("ZONEINFO"))
+		} else {
+			require.NoError(t, os.Setenv("ZONEINFO", value))
+		}
+		loc, err := loadFedwireLocation()
+		require.NoError(t, err)
+		require.Equal(t, "America/New_York", loc.String())
+
+		summer := time.Date(2020, time.July, 1, 12, 0, 0, 0, time.UTC).In(loc)
+		_, offset := summer.Zone()
+		require.Equal(t, -4*60*60, offset)
+	}
+}
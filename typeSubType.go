@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // TypeSubType {1510}
@@ -37,6 +40,9 @@ func NewTypeSubType() *TypeSubType {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (tst *TypeSubType) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 10 {
+		return NewTagWrongLengthErr(10, utf8.RuneCountInString(record))
+	}
 	tst.tag = tst.parseStringField(record[:6])
 	tst.TypeCode = tst.parseStringField(record[6:8])
 	tst.SubTypeCode = tst.parseStringField(record[8:10])
@@ -70,31 +76,35 @@ func (tst *TypeSubType) String() string {
 // Validate performs WIRE format rule checks on TypeSubType and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (tst *TypeSubType) Validate() error {
-	if err := tst.fieldInclusion(); err != nil {
-		return err
-	}
+	return tst.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on TypeSubType and returns every error found
+func (tst *TypeSubType) validateAll() base.ErrorList {
+	errs := tst.fieldInclusion()
 	if tst.tag != TagTypeSubType {
-		return fieldError("tag", ErrValidTagForType, tst.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, tst.tag))
 	}
 	if err := tst.isTypeCode(tst.TypeCode); err != nil {
-		return fieldError("TypeCode", err, tst.TypeCode)
+		errs.Add(fieldError("TypeCode", err, tst.TypeCode))
 	}
 	if err := tst.isSubTypeCode(tst.SubTypeCode); err != nil {
-		return fieldError("SubTypeCode", err, tst.SubTypeCode)
+		errs.Add(fieldError("SubTypeCode", err, tst.SubTypeCode))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (tst *TypeSubType) fieldInclusion() error {
+func (tst *TypeSubType) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if tst.TypeCode == "" {
-		return fieldError("TypeCode", ErrFieldRequired)
+		errs.Add(fieldError("TypeCode", ErrFieldRequired))
 	}
 	if tst.SubTypeCode == "" {
-		return fieldError("SubTypeCode", ErrFieldRequired)
+		errs.Add(fieldError("SubTypeCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // TypeCodeField gets a string of the TypeCode field
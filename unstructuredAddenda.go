@@ -7,6 +7,9 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // UnstructuredAddenda is the unstructured addenda information
@@ -37,10 +40,20 @@ func NewUnstructuredAddenda() *UnstructuredAddenda {
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
 func (ua *UnstructuredAddenda) Parse(record string) error {
+	if utf8.RuneCountInString(record) < 10 {
+		return NewTagWrongLengthErr(10, utf8.RuneCountInString(record))
+	}
 	ua.tag = record[:6]
 	ua.AddendaLength = record[6:10]
 	al := ua.parseNumField(ua.AddendaLength)
-	ua.Addenda = ua.parseStringField(record[10 : 10+al])
+	if al < 0 {
+		return fieldError("AddendaLength", ErrNonNumeric, ua.AddendaLength)
+	}
+	if utf8.RuneCountInString(record) < 10+al {
+		return NewTagWrongLengthErr(10+al, utf8.RuneCountInString(record))
+	}
+	// Addenda is kept as is, as AddendaLength must equal the length of its content
+	ua.Addenda = record[10 : 10+al]
 	return nil
 }
 
@@ -75,31 +88,40 @@ func (ua *UnstructuredAddenda) String() string {
 //  length of content in Addenda Information (e.g., if content of Addenda Information is 987 characters,
 //  Addenda Length must be 0987).
 func (ua *UnstructuredAddenda) Validate() error {
-	if err := ua.fieldInclusion(); err != nil {
-		return err
-	}
+	return ua.validateAll().Err()
+}
+
+// validateAll performs WIRE format rule checks on UnstructuredAddenda and returns every error found
+func (ua *UnstructuredAddenda) validateAll() base.ErrorList {
+	errs := ua.fieldInclusion()
 	if ua.tag != TagUnstructuredAddenda {
-		return fieldError("tag", ErrValidTagForType, ua.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ua.tag))
 	}
 	if err := ua.isNumeric(ua.AddendaLength); err != nil {
-		return fieldError("AddendaLength", err, ua.AddendaLength)
+		errs.Add(fieldError("AddendaLength", err, ua.AddendaLength))
 	}
 	if err := ua.isAlphanumeric(ua.Addenda); err != nil {
-		return fieldError("Addenda", err, ua.Addenda)
+		errs.Add(fieldError("Addenda", err, ua.Addenda))
+	}
+	if ua.AddendaLength != "" && ua.isNumeric(ua.AddendaLength) == nil {
+		if al, n := ua.parseNumField(ua.AddendaLength), utf8.RuneCountInString(ua.Addenda); n != al {
+			errs.Add(fieldError("Addenda", NewFieldWrongLengthErr(al, n), ua.Addenda))
+		}
 	}
 
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ua *UnstructuredAddenda) fieldInclusion() error {
+func (ua *UnstructuredAddenda) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	// If UnstructuredAddenda is defined, AddendaLength is required, however it could be "0000"), but
 	// I'm not sure of the point
 	if ua.AddendaLength == "" {
-		return fieldError("AddendaLength", ErrFieldRequired)
+		errs.Add(fieldError("AddendaLength", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // AddendaLengthField gets a string of the AddendaLength field
@@ -52,6 +52,25 @@ func TestAddendaLengthRequired(t *testing.T) {
 	require.EqualError(t, err, fieldError("AddendaLength", ErrFieldRequired).Error())
 }
 
+// TestAddendaLengthMismatch validates UnstructuredAddenda AddendaLength equals the length of Addenda
+func TestAddendaLengthMismatch(t *testing.T) {
+	ua := mockUnstructuredAddenda()
+	ua.AddendaLength = "0021"
+
+	err := ua.Validate()
+
+	require.EqualError(t, err, fieldError("Addenda", NewFieldWrongLengthErr(21, 20), ua.Addenda).Error())
+}
+
+// TestParseUnstructuredAddendaPadded parses an UnstructuredAddenda with trailing spaces, which count toward AddendaLength
+func TestParseUnstructuredAddendaPadded(t *testing.T) {
+	ua := NewUnstructuredAddenda()
+
+	require.NoError(t, ua.Parse("{8200}0022Unstructured Addenda  "))
+	require.Equal(t, "Unstructured Addenda  ", ua.Addenda)
+	require.NoError(t, ua.Validate())
+}
+
 // TestParseUnstructuredAddendaReaderParseError parses a wrong Addenda reader parse error
 func TestParseUnstructuredAddendaReaderParseError(t *testing.T) {
 	var line = "{8200}0020®nstructured Addenda"
@@ -0,0 +1,230 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/moov-io/base"
+)
+
+// ValidateOpts contains specific overrides from the default set of validations
+type ValidateOpts struct {
+	// CollectAll continues validating after the first error so every tag and every business function rule is checked.
+	// The error returned is then a base.ErrorList of *FieldError, each named by the full path of its field
+	// (e.g. Beneficiary.Personal.Name) and carrying its tag number.
+	CollectAll bool `json:"collectAll"`
+	// DateRanges limits the dates of each tag, keyed by tag such as TagDateRemittanceDocument. Dates outside of
+	// their tag's DateRange are reported with ErrDateInFuture or ErrDateOutOfRange.
+	DateRanges map[string]DateRange `json:"dateRanges,omitempty"`
+	// Calendar, when set, checks the InputCycleDate is a current or future business day and that the
+	// PreviousMessageIdentifier of a prior day reversal is from an earlier cycle date.
+	Calendar *FedwireCalendar `json:"-"`
+	// Cutoffs, when set, checks the Fedwire Funds Service will accept the message at SendTime
+	Cutoffs *CutoffSchedule `json:"-"`
+	// SendTime is when the message is sent, for the DateRanges, Calendar and Cutoffs checks. The zero value is the current time.
+	SendTime time.Time `json:"sendTime,omitempty"`
+}
+
+// sendTime returns SendTime, or the current time when it is not set
+func (opts *ValidateOpts) sendTime() time.Time {
+	if opts.SendTime.IsZero() {
+		return time.Now()
+	}
+	return opts.SendTime
+}
+
+// fedWireMessageTags maps the name of each FEDWireMessage field to its tag
+var fedWireMessageTags = map[string]string{
+	"MessageDisposition":              TagMessageDisposition,
+	"ReceiptTimeStamp":                TagReceiptTimeStamp,
+	"OutputMessageAccountabilityData": TagOutputMessageAccountabilityData,
+	"ErrorWire":                       TagErrorWire,
+	"SenderSupplied":                  TagSenderSupplied,
+	"TypeSubType":                     TagTypeSubType,
+	"InputMessageAccountabilityData":  TagInputMessageAccountabilityData,
+	"Amount":                          TagAmount,
+	"SenderDepositoryInstitution":     TagSenderDepositoryInstitution,
+	"ReceiverDepositoryInstitution":   TagReceiverDepositoryInstitution,
+	"BusinessFunctionCode":            TagBusinessFunctionCode,
+	"SenderReference":                 TagSenderReference,
+	"PreviousMessageIdentifier":       TagPreviousMessageIdentifier,
+	"LocalInstrument":                 TagLocalInstrument,
+	"PaymentNotification":             TagPaymentNotification,
+	"Charges":                         TagCharges,
+	"InstructedAmount":                TagInstructedAmount,
+	"ExchangeRate":                    TagExchangeRate,
+	"BeneficiaryIntermediaryFI":       TagBeneficiaryIntermediaryFI,
+	"BeneficiaryFI":                   TagBeneficiaryFI,
+	"Beneficiary":                     TagBeneficiary,
+	"BeneficiaryReference":            TagBeneficiaryReference,
+	"AccountDebitedDrawdown":          TagAccountDebitedDrawdown,
+	"Originator":                      TagOriginator,
+	"OriginatorOptionF":               TagOriginatorOptionF,
+	"OriginatorFI":                    TagOriginatorFI,
+	"InstructingFI":                   TagInstructingFI,
+	"AccountCreditedDrawdown":         TagAccountCreditedDrawdown,
+	"OriginatorToBeneficiary":         TagOriginatorToBeneficiary,
+	"FIReceiverFI":                    TagFIReceiverFI,
+	"FIDrawdownDebitAccountAdvice":    TagFIDrawdownDebitAccountAdvice,
+	"FIIntermediaryFI":                TagFIIntermediaryFI,
+	"FIIntermediaryFIAdvice":          TagFIIntermediaryFIAdvice,
+	"FIBeneficiaryFI":                 TagFIBeneficiaryFI,
+	"FIBeneficiaryFIAdvice":           TagFIBeneficiaryFIAdvice,
+	"FIBeneficiary":                   TagFIBeneficiary,
+	"FIBeneficiaryAdvice":             TagFIBeneficiaryAdvice,
+	"FIPaymentMethodToBeneficiary":    TagFIPaymentMethodToBeneficiary,
+	"FIAdditionalFIToFI":              TagFIAdditionalFIToFI,
+	"CurrencyInstructedAmount":        TagCurrencyInstructedAmount,
+	"OrderingCustomer":                TagOrderingCustomer,
+	"OrderingInstitution":             TagOrderingInstitution,
+	"IntermediaryInstitution":         TagIntermediaryInstitution,
+	"InstitutionAccount":              TagInstitutionAccount,
+	"BeneficiaryCustomer":             TagBeneficiaryCustomer,
+	"Remittance":                      TagRemittance,
+	"SenderToReceiver":                TagSenderToReceiver,
+	"UnstructuredAddenda":             TagUnstructuredAddenda,
+	"RelatedRemittance":               TagRelatedRemittance,
+	"RemittanceOriginator":            TagRemittanceOriginator,
+	"RemittanceBeneficiary":           TagRemittanceBeneficiary,
+	"PrimaryRemittanceDocument":       TagPrimaryRemittanceDocument,
+	"ActualAmountPaid":                TagActualAmountPaid,
+	"GrossAmountRemittanceDocument":   TagGrossAmountRemittanceDocument,
+	"AmountNegotiatedDiscount":        TagAmountNegotiatedDiscount,
+	"Adjustment":                      TagAdjustment,
+	"DateRemittanceDocument":          TagDateRemittanceDocument,
+	"SecondaryRemittanceDocument":     TagSecondaryRemittanceDocument,
+	"RemittanceFreeText":              TagRemittanceFreeText,
+	"ServiceMessage":                  TagServiceMessage,
+}
+
+// ValidateWith performs WIRE format rule checks on the FEDWireMessage using opts.
+//
+// Without opts, or unless CollectAll is set, the first error encountered is returned.
+func (fwm *FEDWireMessage) ValidateWith(opts *ValidateOpts) error {
+	if opts == nil {
+		return fwm.verify()
+	}
+	if !opts.CollectAll {
+		if err := fwm.verify(); err != nil {
+			return err
+		}
+		return fwm.checkOpts(opts).Err()
+	}
+	if errs := fwm.collectAll(opts); !errs.Empty() {
+		return errs
+	}
+	return nil
+}
+
+// collectAll returns every error found by validateAll, followed by those of the checks opts enables
+func (fwm *FEDWireMessage) collectAll(opts *ValidateOpts) base.ErrorList {
+	return append(fwm.validateAll(), fwm.checkOpts(opts)...)
+}
+
+// checkOpts returns the dates outside of the DateRanges of opts, followed by the errors of its Calendar and Cutoffs
+func (fwm *FEDWireMessage) checkOpts(opts *ValidateOpts) base.ErrorList {
+	errs := fwm.checkDateRanges(opts.DateRanges, opts.sendTime())
+	var checks base.ErrorList
+	if opts.Calendar != nil {
+		checks = append(checks, opts.Calendar.checkCalendar(fwm, opts.sendTime())...)
+	}
+	if opts.Cutoffs != nil {
+		addErrors(&checks, opts.Cutoffs.Check(fwm, opts.sendTime()))
+	}
+	for _, err := range checks {
+		fe := messageFieldError(err)
+		fe.FieldName = fieldPath(fwm, fe.FieldName)
+		fe.Tag = fedWireMessageTags[strings.Split(fe.FieldName, ".")[0]]
+		errs.Add(fe)
+	}
+	return errs
+}
+
+// validateAll returns a *FieldError for every error found within each tag of the FEDWireMessage,
+// followed by those found by each business function rule.
+func (fwm *FEDWireMessage) validateAll() base.ErrorList {
+	var errs base.ErrorList
+	v := reflect.ValueOf(fwm).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() != reflect.Ptr || field.IsNil() {
+			continue
+		}
+		tag, ok := field.Interface().(interface{ validateAll() base.ErrorList })
+		if !ok {
+			continue
+		}
+		name := v.Type().Field(i).Name
+		for _, err := range tag.validateAll() {
+			fe := messageFieldError(err)
+			if fe.FieldName == "" {
+				fe.FieldName = name
+			} else {
+				fe.FieldName = name + "." + fieldPath(tag, fe.FieldName)
+			}
+			fe.Tag = fedWireMessageTags[name]
+			errs.Add(fe)
+		}
+	}
+	for _, err := range fwm.verifyAll() {
+		fe := messageFieldError(err)
+		fe.FieldName = fieldPath(fwm, fe.FieldName)
+		fe.Tag = fedWireMessageTags[strings.Split(fe.FieldName, ".")[0]]
+		errs.Add(fe)
+	}
+	return errs
+}
+
+// messageFieldError returns a copy of err as a *FieldError, naming errors which concern a property by that property
+func messageFieldError(err error) *FieldError {
+	switch e := err.(type) {
+	case *FieldError:
+		fe := *e
+		return &fe
+	case ErrBusinessFunctionCodeProperty:
+		return &FieldError{FieldName: e.Property, Value: e.PropertyValue, Err: err}
+	case ErrInvalidPropertyForProperty:
+		return &FieldError{FieldName: e.Property, Value: e.PropertyValue, Err: err}
+	}
+	return &FieldError{Err: err}
+}
+
+// fieldPath returns the path to the exported field called name within the struct v points to,
+// such as Personal.Name for the Name of a Beneficiary. Fields of v are preferred over nested fields.
+// name is returned as is when it is already a path or no such field exists.
+func fieldPath(v interface{}, name string) string {
+	if name == "" || strings.ContainsAny(name, ". ") {
+		return name
+	}
+	if path, ok := findFieldPath(reflect.TypeOf(v).Elem(), name); ok {
+		return path
+	}
+	return name
+}
+
+func findFieldPath(t reflect.Type, name string) (string, bool) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return "", false
+	}
+	if f, ok := t.FieldByName(name); ok && f.PkgPath == "" && len(f.Index) == 1 {
+		return name, true
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" || f.Anonymous {
+			continue
+		}
+		if path, ok := findFieldPath(f.Type, name); ok {
+			return f.Name + "." + path, true
+		}
+	}
+	return "", false
+}
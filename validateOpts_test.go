@@ -0,0 +1,101 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+// mockCollectAllData returns a CustomerTransfer with several errors in its tags and business function rules
+func mockCollectAllData() FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.TransactionTypeCode = ""
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.Name = "®"
+	fwm.Beneficiary.Personal.Address.AddressLineThree = "®"
+	fwm.Originator = mockOriginator()
+	fwm.Amount.Amount = "000000000000"
+	fwm.LocalInstrument = mockLocalInstrument()
+	return fwm
+}
+
+func TestFEDWireMessage_ValidateWith(t *testing.T) {
+	fwm := mockCollectAllData()
+
+	require.Equal(t, fwm.verify(), fwm.ValidateWith(nil))
+	require.Equal(t, fwm.verify(), fwm.ValidateWith(&ValidateOpts{}))
+
+	err := fwm.ValidateWith(&ValidateOpts{CollectAll: true})
+	require.IsType(t, base.ErrorList{}, err)
+	errs := err.(base.ErrorList)
+
+	expected := []*FieldError{
+		{Tag: TagBeneficiary, FieldName: "Beneficiary.Personal.Name", Value: "®", Err: ErrNonAlphanumeric},
+		{Tag: TagBeneficiary, FieldName: "Beneficiary.Personal.Address.AddressLineThree", Value: "®", Err: ErrNonAlphanumeric},
+		{Tag: TagAmount, FieldName: "Amount", Value: "000000000000",
+			Err: NewErrInvalidPropertyForProperty("Amount", "000000000000", "SubTypeCode", "00")},
+		{Tag: TagLocalInstrument, FieldName: "LocalInstrument", Value: fwm.LocalInstrument, Err: ErrInvalidProperty},
+	}
+	require.Len(t, errs, len(expected))
+	for i := range expected {
+		require.Equal(t, expected[i], errs[i])
+	}
+	require.EqualError(t, errs[0], "{4200} Beneficiary.Personal.Name ® has non alphanumeric characters")
+}
+
+func TestFEDWireMessage_ValidateWithValid(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.TransactionTypeCode = ""
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+
+	require.NoError(t, fwm.ValidateWith(&ValidateOpts{CollectAll: true}))
+}
+
+func TestFEDWireMessage_ValidateWithMissingTags(t *testing.T) {
+	fwm := FEDWireMessage{}
+	fwm.SenderSupplied = mockSenderSupplied()
+
+	err := fwm.ValidateWith(&ValidateOpts{CollectAll: true})
+
+	require.IsType(t, base.ErrorList{}, err)
+	errs := err.(base.ErrorList)
+	require.Len(t, errs, 6)
+	require.EqualError(t, errs[0], "{1510} TypeSubType <nil> is a required field")
+	require.EqualError(t, errs[5], "{3600} BusinessFunctionCode <nil> is a required field")
+}
+
+func TestFile_ValidateWith(t *testing.T) {
+	file := NewFile()
+	require.Equal(t, ErrFileNoFEDWireMessages, file.ValidateWith(&ValidateOpts{CollectAll: true}))
+
+	valid := mockCustomerTransferData()
+	valid.BusinessFunctionCode.TransactionTypeCode = ""
+	valid.Beneficiary = mockBeneficiary()
+	valid.Originator = mockOriginator()
+	file.AddFEDWireMessage(valid)
+	require.NoError(t, file.ValidateWith(&ValidateOpts{CollectAll: true}))
+
+	file.AddFEDWireMessage(mockCollectAllData())
+	require.Equal(t, file.Validate(), file.ValidateWith(nil))
+
+	err := file.ValidateWith(&ValidateOpts{CollectAll: true})
+	require.IsType(t, base.ErrorList{}, err)
+	errs := err.(base.ErrorList)
+	require.Len(t, errs, 4)
+	require.EqualError(t, errs[0], "{4200} FEDWireMessages[1].Beneficiary.Personal.Name ® has non alphanumeric characters")
+}
+
+func TestFieldPath(t *testing.T) {
+	require.Equal(t, "Personal.Name", fieldPath(&Beneficiary{}, "Name"))
+	require.Equal(t, "FinancialInstitution.Address.AddressLineOne", fieldPath(&BeneficiaryFI{}, "AddressLineOne"))
+	require.Equal(t, "LocalInstrument.ProprietaryCode", fieldPath(&FEDWireMessage{}, "ProprietaryCode"))
+	require.Equal(t, "Amount", fieldPath(&FEDWireMessage{}, "Amount"))
+	require.Equal(t, "Beneficiary.Personal.IdentificationCode", fieldPath(&FEDWireMessage{}, "Beneficiary.Personal.IdentificationCode"))
+	require.Equal(t, "tag", fieldPath(&Beneficiary{}, "tag"))
+}
@@ -7,8 +7,10 @@ package wire
 import (
 	"regexp"
 	"strings"
+	"time"
 	"unicode/utf8"
 
+	"github.com/moov-io/base"
 	"golang.org/x/text/currency"
 )
 
@@ -17,6 +19,8 @@ var (
 	alphanumericRegex = regexp.MustCompile(`[^ \w!"#$%&'()*+,-.\\/:;<>=?@\[\]^_{}|~\x60]+`)
 	numericRegex      = regexp.MustCompile(`[^0-9]`)
 	amountRegex       = regexp.MustCompile("[^0-9,.]")
+	bicRegex          = regexp.MustCompile(`^[A-Z0-9]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+	ibanRegex         = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
 )
 
 // validator is common validation and formatting of golang types to WIRE type strings
@@ -40,6 +44,167 @@ func (v *validator) isNumeric(s string) error {
 	return nil
 }
 
+// isRoutingNumber checks if a string is a nine digit ABA routing number whose last digit is the
+// mod-10 check digit of the first eight, weighted 3, 7 and 1.
+func (v *validator) isRoutingNumber(s string) error {
+	if s == "" {
+		return nil
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return ErrNonNumeric
+		}
+	}
+	if len(s) != 9 {
+		return NewFieldWrongLengthErr(9, len(s))
+	}
+	weights := [3]int{3, 7, 1}
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(s[i]-'0') * weights[i%3]
+	}
+	if sum%10 != 0 {
+		return ErrRoutingNumberCheckDigit
+	}
+	return nil
+}
+
+// isBIC checks if a string is an ISO 9362 Business Identifier Code: a four character party prefix, a two
+// letter country code, a two character location code and an optional three character branch code.
+func (v *validator) isBIC(s string) error {
+	if s == "" {
+		return nil
+	}
+	if !bicRegex.MatchString(s) {
+		return ErrBIC
+	}
+	return nil
+}
+
+// isIBAN checks if a string is an ISO 13616 International Bank Account Number: a two letter country code,
+// two check digits and up to 30 alphanumeric characters, whose ISO 7064 mod-97 remainder is 1.
+func (v *validator) isIBAN(s string) error {
+	if s == "" {
+		return nil
+	}
+	if !ibanRegex.MatchString(s) {
+		return ErrIBAN
+	}
+	// Move the country code and check digits to the end and replace each letter with two digits
+	// (A = 10 ... Z = 35), computing the remainder as we go to avoid big integers.
+	remainder := 0
+	for _, c := range s[4:] + s[:4] {
+		if c >= 'A' && c <= 'Z' {
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		}
+	}
+	if remainder != 1 {
+		return ErrIBAN
+	}
+	return nil
+}
+
+// looksLikeIBAN reports whether an account number is shaped like an IBAN and should be checked as one
+func looksLikeIBAN(s string) bool {
+	return ibanRegex.MatchString(s)
+}
+
+// isBICAndAccountNumber checks an Identifier for IdentificationCode SWIFTBICORBEIANDAccountNumber, which is an
+// 8 or 11 character BIC (or BEI) followed by an account number, optionally separated by a slash or space.
+// An account number shaped like an IBAN must also pass the IBAN check.
+func (v *validator) isBICAndAccountNumber(s string) error {
+	if s == "" {
+		return nil
+	}
+	bic, account := splitBICAndAccountNumber(s)
+	if err := v.isBIC(bic); err != nil {
+		return err
+	}
+	if account == "" {
+		return ErrBICAccountNumber
+	}
+	if looksLikeIBAN(account) {
+		return v.isIBAN(account)
+	}
+	return nil
+}
+
+// splitBICAndAccountNumber returns the BIC and account number of an Identifier for IdentificationCode
+// SWIFTBICORBEIANDAccountNumber
+func splitBICAndAccountNumber(s string) (string, string) {
+	if i := strings.IndexAny(s, "/ "); i >= 0 {
+		return s[:i], strings.TrimLeft(s[i:], "/ ")
+	}
+	if len(s) > 11 && !looksLikeIBAN(s[8:]) {
+		return s[:11], s[11:]
+	}
+	if len(s) > 8 {
+		return s[:8], s[8:]
+	}
+	return s, ""
+}
+
+// validateCoverPaymentParty checks the party identification of a SWIFT cover payment field. A first line
+// starting with a slash holds an account, which must pass the IBAN check when it is shaped like one, and
+// option A fields (such as 52A or 57A) carry a BIC on the line after the optional account.
+func (v *validator) validateCoverPaymentParty(cp CoverPayment) base.ErrorList {
+	var errs base.ErrorList
+	names := []string{"SwiftLineOne", "SwiftLineTwo", "SwiftLineThree", "SwiftLineFour", "SwiftLineFive", "SwiftLineSix"}
+	lines := []string{cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree, cp.SwiftLineFour, cp.SwiftLineFive, cp.SwiftLineSix}
+	line := 0
+	if strings.HasPrefix(lines[0], "/") {
+		// a debit or credit mark, as in /C/account, precedes the account in some fields
+		account := strings.TrimPrefix(lines[0], "/")
+		if strings.HasPrefix(account, "C/") || strings.HasPrefix(account, "D/") {
+			account = account[2:]
+		}
+		if looksLikeIBAN(account) {
+			if err := v.isIBAN(account); err != nil {
+				errs.Add(fieldError(names[0], err, lines[0]))
+			}
+		}
+		line++
+	}
+	tag := strings.TrimSpace(cp.SwiftFieldTag)
+	if len(tag) == 3 && strings.HasSuffix(tag, "A") && lines[line] != "" {
+		if err := v.isBIC(lines[line]); err != nil {
+			errs.Add(fieldError(names[line], err, lines[line]))
+		}
+	}
+	return errs
+}
+
+const (
+	// x12CharacterSet is the name of the ANSI X12 basic and extended character set
+	x12CharacterSet = "X12"
+	// swiftMXCharacterSet is the name of the SWIFT MX ISO 20022 (Latin) character set
+	swiftMXCharacterSet = "SWIFT MX ISO 20022"
+)
+
+// isX12CharacterSet checks if a string only contains characters of the ANSI X12 basic and extended character sets:
+// A-Z, a-z, 0-9, space and !"&'()*+,-./:;?= %@[]_{}\|<>~#$^
+func (v *validator) isX12CharacterSet(s string) error {
+	for i, r := range s {
+		if r < ' ' || r > '~' || r == '`' {
+			return NewCharacterSetErr(x12CharacterSet, r, i)
+		}
+	}
+	return nil
+}
+
+// isSWIFTMXCharacterSet checks if a string only contains characters of the SWIFT MX ISO 20022 Latin character set,
+// which is the printable ASCII characters
+func (v *validator) isSWIFTMXCharacterSet(s string) error {
+	for i, r := range s {
+		if r < ' ' || r > '~' {
+			return NewCharacterSetErr(swiftMXCharacterSet, r, i)
+		}
+	}
+	return nil
+}
+
 // ToDo: Amount Decimal and AmountComma (only 1 per each) ?
 
 // isAmount checks if a string only contains onc comma and ASCII numeric (0-9) characters
@@ -349,95 +514,28 @@ func (v *validator) isCurrencyCode(code string) error {
 	return nil
 }
 
-// isCentury validates a 2 digit century 20-29
-func (v *validator) isCentury(s string) error {
-	if s < "20" || s > "29" {
-		return ErrValidCentury
-	}
-	return nil
-}
+// minDateYear is the earliest year of a date, which allows for dates of birth
+const minDateYear = 1900
 
-// isYear validates a 2 digit year 00-99
-func (v *validator) isYear(s string) error {
-	if s < "00" || s > "99" {
-		return ErrValidYear
-	}
-	return nil
-}
-
-// isMonth validates a 2 digit month 01-12
-func (v *validator) isMonth(s string) error {
-	switch s {
-	case
-		"01", "02", "03", "04", "05", "06",
-		"07", "08", "09", "10", "11", "12":
-		return nil
-	}
-	return ErrValidMonth
-}
-
-// isDay validates a 2 digit day based on a 2 digit month
-// month 01-12 day 01-31 based on month
-func (v *validator) isDay(m string, d string) error {
-	switch m {
-	// February
-	case "02":
-		switch d {
-		case
-			"01", "02", "03", "04", "05", "06",
-			"07", "08", "09", "10", "11", "12",
-			"13", "14", "15", "16", "17", "18",
-			"19", "20", "21", "22", "23", "24",
-			"25", "26", "27", "28", "29":
-			return nil
-		}
-	// April, June, September, November
-	case "04", "06", "09", "11":
-		switch d {
-		case
-			"01", "02", "03", "04", "05", "06",
-			"07", "08", "09", "10", "11", "12",
-			"13", "14", "15", "16", "17", "18",
-			"19", "20", "21", "22", "23", "24",
-			"25", "26", "27", "28", "29", "30":
-			return nil
-		}
-	// January, March, May, July, August, October, December
-	case "01", "03", "05", "07", "08", "10", "12":
-		switch d {
-		case
-			"01", "02", "03", "04", "05", "06",
-			"07", "08", "09", "10", "11", "12",
-			"13", "14", "15", "16", "17", "18",
-			"19", "20", "21", "22", "23", "24",
-			"25", "26", "27", "28", "29", "30", "31":
-			return nil
-		}
-	}
-	return ErrValidDay
-}
-
-// validateDate will return the incoming string only if it matches a valid CCYYMMDD
-// date format. (C=Century, Y=Year, M=Month, D=Day)
+// validateDate returns an error unless s is a CCYYMMDD date (C=Century, Y=Year, M=Month, D=Day) which exists
+// in the calendar, such as 20240229 but not 20230229, from minDateYear onwards
 func (v *validator) validateDate(s string) error {
-	if length := utf8.RuneCountInString(s); length != 8 {
-		return ErrValidDate
-	}
-	cc, yy, mm, dd := s[:2], s[2:4], s[4:6], s[6:8]
-
-	if err := v.isCentury(cc); err != nil {
-		return ErrValidDate
-	}
-	if err := v.isYear(yy); err != nil {
+	if len(s) != 8 || !isDigits(s) {
 		return ErrValidDate
 	}
-	if err := v.isMonth(mm); err != nil {
+	t, err := time.Parse(cycleDateLayout, s)
+	if err != nil || t.Year() < minDateYear {
 		return ErrValidDate
 	}
-	if err := v.isDay(mm, dd); err != nil {
+	return nil
+}
+
+// validateDateBirthPlace validates a Date & Place of Birth, a CCYYMMDD date of birth followed by the place of birth
+func (v *validator) validateDateBirthPlace(s string) error {
+	if len(s) < 8 {
 		return ErrValidDate
 	}
-	return nil
+	return v.validateDate(s[:8])
 }
 
 // validatePartyIdentifier validates OriginatorOptionF PartyIdentifier
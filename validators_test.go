@@ -19,3 +19,104 @@ func TestValidators__validateOptionFName(t *testing.T) {
 	require.Error(t, v.validateOptionFName(""))
 	require.Error(t, v.validateOptionFName(" /"))
 }
+
+func TestValidators__isRoutingNumber(t *testing.T) {
+	v := &validator{}
+
+	require.NoError(t, v.isRoutingNumber(""))
+	require.NoError(t, v.isRoutingNumber("121042882"))
+	require.NoError(t, v.isRoutingNumber("231380104"))
+	require.NoError(t, v.isRoutingNumber("011000015"))
+	require.Equal(t, ErrRoutingNumberCheckDigit, v.isRoutingNumber("121042883"))
+	require.Equal(t, ErrRoutingNumberCheckDigit, v.isRoutingNumber("123456789"))
+	require.Equal(t, ErrNonNumeric, v.isRoutingNumber("1210 4288"))
+	require.Equal(t, ErrNonNumeric, v.isRoutingNumber("12104288A"))
+	require.Equal(t, NewFieldWrongLengthErr(9, 8), v.isRoutingNumber("12104288"))
+}
+
+func TestValidators__isBIC(t *testing.T) {
+	v := &validator{}
+
+	require.NoError(t, v.isBIC(""))
+	require.NoError(t, v.isBIC("DEUTDEFF"))
+	require.NoError(t, v.isBIC("DEUTDEFF500"))
+	require.NoError(t, v.isBIC("CHASUS33XXX"))
+	require.Equal(t, ErrBIC, v.isBIC("DEUTDEF"))
+	require.Equal(t, ErrBIC, v.isBIC("DEUTDEFF5"))
+	require.Equal(t, ErrBIC, v.isBIC("DEUT12FF"))
+	require.Equal(t, ErrBIC, v.isBIC("deutdeff"))
+}
+
+func TestValidators__isIBAN(t *testing.T) {
+	v := &validator{}
+
+	require.NoError(t, v.isIBAN(""))
+	require.NoError(t, v.isIBAN("GB82WEST12345698765432"))
+	require.NoError(t, v.isIBAN("DE89370400440532013000"))
+	require.NoError(t, v.isIBAN("NO9386011117947"))
+	require.Equal(t, ErrIBAN, v.isIBAN("GB82WEST12345698765433"))
+	require.Equal(t, ErrIBAN, v.isIBAN("DE8937040044"))
+	require.Equal(t, ErrIBAN, v.isIBAN("1289370400440532013000"))
+}
+
+func TestValidators__isBICAndAccountNumber(t *testing.T) {
+	v := &validator{}
+
+	require.NoError(t, v.isBICAndAccountNumber(""))
+	require.NoError(t, v.isBICAndAccountNumber("CHASUS33/123456789"))
+	require.NoError(t, v.isBICAndAccountNumber("CHASUS33XXX 123456789"))
+	require.NoError(t, v.isBICAndAccountNumber("CHASUS33XXX123456789"))
+	require.NoError(t, v.isBICAndAccountNumber("DEUTDEFFDE89370400440532013000"))
+	require.Equal(t, ErrIBAN, v.isBICAndAccountNumber("DEUTDEFFDE89370400440532013001"))
+	require.Equal(t, ErrIBAN, v.isBICAndAccountNumber("DEUTDEFF/DE89370400440532013001"))
+	require.Equal(t, ErrBIC, v.isBICAndAccountNumber("755756"))
+	require.Equal(t, ErrBICAccountNumber, v.isBICAndAccountNumber("CHASUS33"))
+}
+
+func TestValidators__validateCoverPaymentParty(t *testing.T) {
+	v := &validator{}
+
+	require.Empty(t, v.validateCoverPaymentParty(CoverPayment{SwiftFieldTag: "Swift", SwiftLineOne: "Swift Line One"}))
+	require.Empty(t, v.validateCoverPaymentParty(CoverPayment{SwiftFieldTag: "57A", SwiftLineOne: "/GB82WEST12345698765432", SwiftLineTwo: "DEUTDEFF"}))
+	require.Empty(t, v.validateCoverPaymentParty(CoverPayment{SwiftFieldTag: "52A", SwiftLineOne: "/C/123456", SwiftLineTwo: "DEUTDEFF500"}))
+	require.Empty(t, v.validateCoverPaymentParty(CoverPayment{SwiftFieldTag: "59", SwiftLineOne: "/123456", SwiftLineTwo: "Name"}))
+
+	errs := v.validateCoverPaymentParty(CoverPayment{SwiftFieldTag: "57A", SwiftLineOne: "/GB82WEST12345698765433", SwiftLineTwo: "DEUT"})
+	require.Len(t, errs, 2)
+	require.EqualError(t, errs[0], fieldError("SwiftLineOne", ErrIBAN, "/GB82WEST12345698765433").Error())
+	require.EqualError(t, errs[1], fieldError("SwiftLineTwo", ErrBIC, "DEUT").Error())
+
+	errs = v.validateCoverPaymentParty(CoverPayment{SwiftFieldTag: "52A", SwiftLineOne: "DEUT"})
+	require.Len(t, errs, 1)
+	require.EqualError(t, errs[0], fieldError("SwiftLineOne", ErrBIC, "DEUT").Error())
+}
+
+func TestValidators__characterSets(t *testing.T) {
+	v := &validator{}
+
+	require.NoError(t, v.isX12CharacterSet("ISA*00*          *00~GS*RA^1~"))
+	require.Equal(t, NewCharacterSetErr("X12", '`', 3), v.isX12CharacterSet("ISA`"))
+	require.Equal(t, NewCharacterSetErr("X12", 'é', 1), v.isX12CharacterSet("aé"))
+
+	require.NoError(t, v.isSWIFTMXCharacterSet(`<Ustrd>Invoice 1 & 2 `+"`"+`</Ustrd>`))
+	require.Equal(t, NewCharacterSetErr("SWIFT MX ISO 20022", '\n', 5), v.isSWIFTMXCharacterSet("line1\nline2"))
+}
+
+func TestValidators__validateDate(t *testing.T) {
+	v := &validator{}
+
+	require.NoError(t, v.validateDate("20240229"))
+	require.NoError(t, v.validateDate("20000229"))
+	require.NoError(t, v.validateDate("19451231"))
+	require.NoError(t, v.validateDate("20301130"))
+
+	require.Equal(t, ErrValidDate, v.validateDate("20230229"))
+	require.Equal(t, ErrValidDate, v.validateDate("21000229"))
+	require.Equal(t, ErrValidDate, v.validateDate("20230431"))
+	require.Equal(t, ErrValidDate, v.validateDate("20231301"))
+	require.Equal(t, ErrValidDate, v.validateDate("20230100"))
+	require.Equal(t, ErrValidDate, v.validateDate("18991231"))
+	require.Equal(t, ErrValidDate, v.validateDate("2023011"))
+	require.Equal(t, ErrValidDate, v.validateDate("2023-1-1"))
+	require.Equal(t, ErrValidDate, v.validateDate(""))
+}
@@ -26,14 +26,21 @@ func NewWriter(w io.Writer) *Writer {
 	}
 }
 
-// Writer writes a single FEDWireMessage record to w
+// Write writes every FEDWireMessage in file to w, each message after the first on a new line
 func (w *Writer) Write(file *File) error {
 	if err := file.Validate(); err != nil {
 		return err
 	}
-	// Iterate over all records in the file
-	if err := w.writeFEDWireMessage(file); err != nil {
-		return err
+	// Iterate over all messages in the file
+	for i := range file.FEDWireMessages {
+		if i > 0 {
+			if _, err := w.w.WriteString("\n"); err != nil {
+				return err
+			}
+		}
+		if err := w.writeFEDWireMessage(file.FEDWireMessages[i]); err != nil {
+			return err
+		}
 	}
 
 	return w.w.Flush()
@@ -46,8 +53,7 @@ func (w *Writer) Flush() error {
 	return w.w.Flush()
 }
 
-func (w *Writer) writeFEDWireMessage(file *File) error {
-	fwm := file.FEDWireMessage
+func (w *Writer) writeFEDWireMessage(fwm FEDWireMessage) error {
 	if err := w.writeTagsAppendedByFed(fwm); err != nil {
 		return err
 	}
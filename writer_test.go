@@ -1299,3 +1299,20 @@ func TestFEDWireMessageWriteCustomerTransferPlusUnstructuredAddenda(t *testing.T
 
 	require.NoError(t, writeFile(file))
 }
+
+// TestFEDWireMessageWriteMultipleMessages writes a File holding more than one FEDWireMessage and reads it back
+func TestFEDWireMessageWriteMultipleMessages(t *testing.T) {
+	file := NewFile()
+	file.AddFEDWireMessage(createCustomerTransferData())
+	file.AddFEDWireMessage(createMockServiceMessageData())
+	file.AddFEDWireMessage(createCustomerTransferData())
+
+	b := &bytes.Buffer{}
+	require.NoError(t, NewWriter(b).Write(file))
+	require.Equal(t, 2, strings.Count(b.String(), "\n"))
+
+	read, err := NewReader(strings.NewReader(b.String())).Read()
+	require.NoError(t, err)
+	require.Len(t, read.FEDWireMessages, 3)
+	require.Equal(t, BFCServiceMessage, read.FEDWireMessages[1].BusinessFunctionCode.BusinessFunctionCode)
+}